@@ -8,8 +8,7 @@ import (
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/vmware/terraform-provider-nsxt/nsxt"
 )
 
@@ -18,19 +17,18 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return nsxt.Provider()
-		},
+	providerServer, err := nsxt.NewMuxProviderServer(context.Background())
+	if err != nil {
+		log.Fatal(err.Error())
 	}
 
+	var serveOpts []tf5server.ServeOpt
 	if debugMode {
-		err := plugin.Debug(context.Background(), "registry.terraform.io/vmware/nsxt", opts)
-		if err != nil {
-			log.Fatal(err.Error())
-		}
-		return
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
 	}
 
-	plugin.Serve(opts)
+	err = tf5server.Serve("registry.terraform.io/vmware/nsxt", providerServer, serveOpts...)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 }
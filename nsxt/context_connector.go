@@ -0,0 +1,71 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+)
+
+// contextConnector binds every API call issued via the connector to the
+// context, so that calls in flight are aborted when terraform cancels the
+// operation (i.e. on Ctrl-C)
+type contextConnector struct {
+	client.Connector
+	ctx context.Context
+}
+
+func newContextConnector(ctx context.Context, connector client.Connector) client.Connector {
+	return contextConnector{Connector: connector, ctx: ctx}
+}
+
+func (c contextConnector) NewExecutionContext() *core.ExecutionContext {
+	executionContext := c.Connector.NewExecutionContext()
+	executionContext.WithContext(c.ctx)
+	return executionContext
+}
+
+// getContextPolicyConnector returns shared policy connector bound to ctx
+func getContextPolicyConnector(ctx context.Context, m interface{}) client.Connector {
+	return newContextConnector(ctx, getPolicyConnector(m))
+}
+
+// withContextPolicyConnector returns provider clients with policy connector
+// bound to ctx, for resources that create API clients from provider meta
+func withContextPolicyConnector(ctx context.Context, m interface{}) interface{} {
+	c := m.(nsxtClients)
+	c.PolicyConnector = getContextPolicyConnector(ctx, m)
+	return c
+}
+
+// handleContextError converts error of an operation into diagnostics, with
+// clear message if the operation was stopped because terraform cancelled it
+func handleContextError(ctx context.Context, operation string, err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	switch ctx.Err() {
+	case context.Canceled:
+		return diag.Errorf("%s was cancelled", operation)
+	case context.DeadlineExceeded:
+		return diag.Errorf("%s timed out: %v", operation, err)
+	}
+	return getAPIErrorDiagnostics(nil, nil, err)
+}
+
+// sleepContext pauses for given interval, and returns error early if ctx is done
+func sleepContext(ctx context.Context, interval time.Duration) error {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,54 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandleContextError(t *testing.T) {
+	if diags := handleContextError(context.Background(), "Wait", nil); diags != nil {
+		t.Errorf("Expected no diagnostics for nil error, got %v", diags)
+	}
+
+	diags := handleContextError(context.Background(), "Wait", errors.New("boom"))
+	if !diags.HasError() || diags[0].Summary != "boom" {
+		t.Errorf("Expected original error, got %v", diags)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	diags = handleContextError(ctx, "Wait", errors.New("boom"))
+	if !diags.HasError() || diags[0].Summary != "Wait was cancelled" {
+		t.Errorf("Expected cancellation error, got %v", diags)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+	diags = handleContextError(ctx, "Wait", errors.New("boom"))
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "Wait timed out") {
+		t.Errorf("Expected timeout error, got %v", diags)
+	}
+}
+
+func TestSleepContext(t *testing.T) {
+	if err := sleepContext(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("Expected sleep to complete, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	if err := sleepContext(ctx, time.Minute); err != context.Canceled {
+		t.Errorf("Expected sleep to be cancelled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected cancelled sleep to return immediately, took %v", elapsed)
+	}
+}
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,7 +20,7 @@ import (
 
 func dataSourceNsxtComputeManagerRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtComputeManagerRealizationRead,
+		ReadContext: dataSourceNsxtComputeManagerRealizationRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -60,21 +62,21 @@ func dataSourceNsxtComputeManagerRealization() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtComputeManagerRealizationRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func dataSourceNsxtComputeManagerRealizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 
 	checkRegistration := d.Get("check_registration").(bool)
 
-	err := dataSourceNsxtComputeManagerRealizationWait(d, connector)
+	err := dataSourceNsxtComputeManagerRealizationWait(ctx, d, connector)
 
-	if !checkRegistration {
-		return err
+	if !checkRegistration || err != nil {
+		return handleContextError(ctx, "Compute Manager realization", err)
 	}
 
-	return dataSourceNsxtComputeManagerRegistrationWait(d, connector)
+	return handleContextError(ctx, "Compute Manager registration", dataSourceNsxtComputeManagerRegistrationWait(ctx, d, connector))
 }
 
-func dataSourceNsxtComputeManagerRealizationWait(d *schema.ResourceData, connector client.Connector) error {
+func dataSourceNsxtComputeManagerRealizationWait(ctx context.Context, d *schema.ResourceData, connector client.Connector) error {
 	id := d.Get("id").(string)
 	delay := d.Get("delay").(int)
 	timeout := d.Get("timeout").(int)
@@ -116,14 +118,14 @@ func dataSourceNsxtComputeManagerRealizationWait(d *schema.ResourceData, connect
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func dataSourceNsxtComputeManagerRegistrationWait(d *schema.ResourceData, connector client.Connector) error {
+func dataSourceNsxtComputeManagerRegistrationWait(ctx context.Context, d *schema.ResourceData, connector client.Connector) error {
 	id := d.Get("id").(string)
 	delay := d.Get("delay").(int)
 	timeout := d.Get("timeout").(int)
@@ -158,7 +160,7 @@ func dataSourceNsxtComputeManagerRegistrationWait(d *schema.ResourceData, connec
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get registration information for %s: %v", id, err)
 	}
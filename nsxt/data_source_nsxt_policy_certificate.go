@@ -14,6 +14,8 @@ func dataSourceNsxtPolicyCertificate() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
+			"exact_match":  getDataSourceExactMatchSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 		},
@@ -0,0 +1,19 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyContextProfiles() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNsxtPolicyContextProfilesRead,
+		Schema: getPolicyItemsDataSourceSchema(nil),
+	}
+}
+
+func dataSourceNsxtPolicyContextProfilesRead(d *schema.ResourceData, m interface{}) error {
+	return policyItemsDataSourceRead(d, m, "PolicyContextProfile", nil)
+}
@@ -0,0 +1,88 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyContextProfiles_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicyContextProfilesBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicyContextProfiles_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicyContextProfilesBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicyContextProfilesBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_context_profiles.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyContextProfilesReadTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.%", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("items.%s-prod", name), "nsxt_policy_context_profile.prod", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyContextProfilesReadTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_context_profile" "prod" {
+%s
+  display_name = "%s-prod"
+  domain_name {
+    value = ["*-myfiles"]
+  }
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+}
+
+resource "nsxt_policy_context_profile" "dev" {
+%s
+  display_name = "%s-dev"
+  domain_name {
+    value = ["*-myfiles"]
+  }
+
+  tag {
+    scope = "env"
+    tag   = "dev"
+  }
+}
+
+data "nsxt_policy_context_profiles" "test" {
+%s
+  display_name_regex = "^%s"
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+
+  depends_on = [nsxt_policy_context_profile.prod, nsxt_policy_context_profile.dev]
+}`, context, name, context, name, context, name)
+}
@@ -14,6 +14,8 @@ func dataSourceNsxtPolicyDhcpServer() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
+			"exact_match":  getDataSourceExactMatchSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"context":      getContextSchema(false, false),
@@ -16,6 +16,8 @@ func dataSourceNsxtPolicyGatewayDNSForwarder() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
+			"exact_match":  getDataSourceExactMatchSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"gateway_path": getPolicyPathSchema(false, false, "Gateway path"),
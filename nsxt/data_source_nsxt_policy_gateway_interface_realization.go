@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,7 +20,7 @@ import (
 
 func dataSourceNsxtPolicyGatewayInterfaceRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayInterfaceRealizationRead,
+		ReadContext: dataSourceNsxtPolicyGatewayInterfaceRealizationRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":      getDataSourceIDSchema(),
@@ -70,8 +72,8 @@ func dataSourceNsxtPolicyGatewayInterfaceRealization() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyGatewayInterfaceRealizationRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func dataSourceNsxtPolicyGatewayInterfaceRealizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 	client := realizedstate.NewRealizedEntitiesClient(getSessionContext(d, m), connector)
 
 	id := d.Get("id").(string)
@@ -154,9 +156,9 @@ func dataSourceNsxtPolicyGatewayInterfaceRealizationRead(d *schema.ResourceData,
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Failed to get gateway interface realization information for %s: %v", gatewayPath, err)
+		return handleContextError(ctx, "Gateway interface realization", fmt.Errorf("Failed to get gateway interface realization information for %s: %v", gatewayPath, err))
 	}
 	return nil
 }
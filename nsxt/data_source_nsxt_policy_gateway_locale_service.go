@@ -19,6 +19,8 @@ func dataSourceNsxtPolicyGatewayLocaleService() *schema.Resource {
 			"gateway_path": getPolicyPathSchema(true, true, "Gateway path"),
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
+			"exact_match":  getDataSourceExactMatchSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"bgp_path":     getComputedPolicyPathSchema("Path for BGP config"),
@@ -18,6 +18,8 @@ func dataSourceNsxtPolicyGatewayPrefixList() *schema.Resource {
 			"gateway_path": getPolicyPathSchema(false, false, "Gateway path"),
 			"path":         getPathSchema(),
 			"display_name": getDisplayNameSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
+			"exact_match":  getDataSourceExactMatchSchema(),
 			"description":  getDescriptionSchema(),
 		},
 	}
@@ -0,0 +1,307 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra/domains/groups/members"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func dataSourceNsxtPolicyGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGroupMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the group",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"context": getContextSchema(false, false),
+			"virtual_machines": {
+				Type:        schema.TypeList,
+				Description: "Effective virtual machine members of the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "External ID of the virtual machine",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the virtual machine",
+							Computed:    true,
+						},
+						"power_state": {
+							Type:        schema.TypeString,
+							Description: "Power state of the virtual machine",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "Effective IP address members of the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mac_addresses": {
+				Type:        schema.TypeList,
+				Description: "MAC addresses of effective VIF members of the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"segment_ports": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of effective segment port members of the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"segments": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of effective segment members of the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"vifs": {
+				Type:        schema.TypeList,
+				Description: "Effective VIF members of the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "External ID of the VIF",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the VIF",
+							Computed:    true,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address of the VIF",
+							Computed:    true,
+						},
+						"ip_addresses": {
+							Type:        schema.TypeList,
+							Description: "IP addresses of the VIF",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"owner_vm_id": {
+							Type:        schema.TypeString,
+							Description: "External ID of the virtual machine that owns the VIF",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// parsePolicyGroupPath returns domain and group ID from group policy path,
+// which might belong to a project
+func parsePolicyGroupPath(groupPath string) (string, string, error) {
+	segments := strings.Split(groupPath, "/")
+	length := len(segments)
+	if length < 5 || segments[length-2] != "groups" || segments[length-4] != "domains" {
+		return "", "", fmt.Errorf("Group path expected, got %s", groupPath)
+	}
+	return segments[length-3], segments[length-1], nil
+}
+
+// listPolicyPaginated collects all pages of list API. Listing stops once
+// result count reported by NSX is reached, or when NSX returns no cursor or
+// an empty page.
+func listPolicyPaginated[T any](list func(cursor *string) ([]T, *string, *int64, error)) ([]T, error) {
+	var results []T
+	var cursor *string
+	total := 0
+
+	for {
+		page, nextCursor, resultCount, err := list(cursor)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, page...)
+		if total == 0 && resultCount != nil {
+			// first response
+			total = int(*resultCount)
+		}
+		if len(results) >= total || len(page) == 0 || nextCursor == nil || *nextCursor == "" {
+			return results, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+func listPolicyGroupVMMembers(context utl.SessionContext, connector client.Connector, domain string, groupID string) ([]model.RealizedVirtualMachine, error) {
+	client := members.NewVirtualMachinesClient(context, connector)
+	if client == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+
+	return listPolicyPaginated(func(cursor *string) ([]model.RealizedVirtualMachine, *string, *int64, error) {
+		vms, err := client.List(domain, groupID, cursor, nil, nil, nil, nil, nil, nil)
+		return vms.Results, vms.Cursor, vms.ResultCount, err
+	})
+}
+
+func listPolicyGroupIPMembers(context utl.SessionContext, connector client.Connector, domain string, groupID string) ([]string, error) {
+	client := members.NewIpAddressesClient(context, connector)
+	if client == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+
+	return listPolicyPaginated(func(cursor *string) ([]string, *string, *int64, error) {
+		ips, err := client.List(domain, groupID, cursor, nil, nil, nil, nil, nil, nil)
+		return ips.Results, ips.Cursor, ips.ResultCount, err
+	})
+}
+
+func listPolicyGroupSegmentPortMembers(context utl.SessionContext, connector client.Connector, domain string, groupID string) ([]model.PolicyGroupMemberDetails, error) {
+	client := members.NewSegmentPortsClient(context, connector)
+	if client == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+
+	return listPolicyPaginated(func(cursor *string) ([]model.PolicyGroupMemberDetails, *string, *int64, error) {
+		ports, err := client.List(domain, groupID, cursor, nil, nil, nil, nil, nil, nil)
+		return ports.Results, ports.Cursor, ports.ResultCount, err
+	})
+}
+
+func listPolicyGroupSegmentMembers(context utl.SessionContext, connector client.Connector, domain string, groupID string) ([]model.PolicyGroupMemberDetails, error) {
+	client := members.NewSegmentsClient(context, connector)
+	if client == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+
+	return listPolicyPaginated(func(cursor *string) ([]model.PolicyGroupMemberDetails, *string, *int64, error) {
+		segments, err := client.List(domain, groupID, cursor, nil, nil, nil, nil, nil, nil)
+		return segments.Results, segments.Cursor, segments.ResultCount, err
+	})
+}
+
+func listPolicyGroupVifMembers(context utl.SessionContext, connector client.Connector, domain string, groupID string) ([]model.VirtualNetworkInterface, error) {
+	client := members.NewVifsClient(context, connector)
+	if client == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+
+	return listPolicyPaginated(func(cursor *string) ([]model.VirtualNetworkInterface, *string, *int64, error) {
+		vifs, err := client.List(domain, groupID, cursor, nil, nil, nil, nil, nil, nil)
+		return vifs.Results, vifs.Cursor, vifs.ResultCount, err
+	})
+}
+
+func getPolicyGroupMemberPaths(details []model.PolicyGroupMemberDetails) []string {
+	paths := make([]string, 0, len(details))
+	for _, detail := range details {
+		if detail.Path != nil {
+			paths = append(paths, *detail.Path)
+		}
+	}
+	return paths
+}
+
+func dataSourceNsxtPolicyGroupMembersRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	groupPath := d.Get("group_path").(string)
+	if projectID := getProjectIDFromResourcePath(groupPath); projectID != "" && getProjectIDFromSchema(d) == "" {
+		// Context is not specified, project is derived from group path
+		context = utl.SessionContext{ProjectID: projectID, ClientType: utl.Multitenancy}
+	}
+
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return err
+	}
+
+	vms, err := listPolicyGroupVMMembers(context, connector, domain, groupID)
+	if err != nil {
+		return handleDataSourceReadError(d, "Group VM Members", groupPath, err)
+	}
+	var vmList []map[string]interface{}
+	for _, vm := range vms {
+		elem := make(map[string]interface{})
+		elem["id"] = vm.Id
+		elem["display_name"] = vm.DisplayName
+		elem["power_state"] = vm.PowerState
+		vmList = append(vmList, elem)
+	}
+
+	ips, err := listPolicyGroupIPMembers(context, connector, domain, groupID)
+	if err != nil {
+		return handleDataSourceReadError(d, "Group IP Members", groupPath, err)
+	}
+
+	ports, err := listPolicyGroupSegmentPortMembers(context, connector, domain, groupID)
+	if err != nil {
+		return handleDataSourceReadError(d, "Group Segment Port Members", groupPath, err)
+	}
+
+	segments, err := listPolicyGroupSegmentMembers(context, connector, domain, groupID)
+	if err != nil {
+		return handleDataSourceReadError(d, "Group Segment Members", groupPath, err)
+	}
+
+	vifs, err := listPolicyGroupVifMembers(context, connector, domain, groupID)
+	if err != nil {
+		return handleDataSourceReadError(d, "Group VIF Members", groupPath, err)
+	}
+	var vifList []map[string]interface{}
+	var macs []string
+	for _, vif := range vifs {
+		elem := make(map[string]interface{})
+		elem["id"] = vif.ExternalId
+		elem["display_name"] = vif.DisplayName
+		elem["mac_address"] = vif.MacAddress
+		elem["owner_vm_id"] = vif.OwnerVmId
+		var vifIPs []string
+		for _, info := range vif.IpAddressInfo {
+			vifIPs = append(vifIPs, info.IpAddresses...)
+		}
+		elem["ip_addresses"] = vifIPs
+		vifList = append(vifList, elem)
+		if vif.MacAddress != nil {
+			macs = append(macs, *vif.MacAddress)
+		}
+	}
+
+	d.SetId(groupPath)
+	d.Set("virtual_machines", vmList)
+	d.Set("ip_addresses", ips)
+	d.Set("mac_addresses", macs)
+	d.Set("segment_ports", getPolicyGroupMemberPaths(ports))
+	d.Set("segments", getPolicyGroupMemberPaths(segments))
+	d.Set("vifs", vifList)
+
+	return nil
+}
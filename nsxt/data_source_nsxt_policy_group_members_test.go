@@ -0,0 +1,185 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestAccDataSourceNsxtPolicyGroupMembers_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupMembersBasic(t, false, func() {
+		testAccPreCheck(t)
+		testAccOnlyLocalManager(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicyGroupMembers_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupMembersBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicyGroupMembersBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_group_members.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupMembersReadTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "id", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_addresses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(testResourceName, "ip_addresses.*", "10.10.10.1"),
+					resource.TestCheckTypeSetElemAttr(testResourceName, "ip_addresses.*", "10.10.20.0/24"),
+					resource.TestCheckResourceAttr(testResourceName, "virtual_machines.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "segments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupMembersReadTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+%s
+  display_name = "%s"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["10.10.10.1", "10.10.20.0/24"]
+    }
+  }
+}
+
+data "nsxt_policy_group_members" "test" {
+%s
+  group_path = nsxt_policy_group.test.path
+}`, context, name, context)
+}
+
+func TestDataSourceNsxtPolicyGroupMembersSimulator(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	groupPath := "/orgs/default/projects/p1/infra/domains/default/groups/web"
+	membersPath := groupPath + "/members/"
+	sim.Put(groupPath, simulator.Object{"resource_type": "Group"})
+	sim.Put(membersPath+"virtual-machines", simulator.Object{"results": []interface{}{
+		map[string]interface{}{"id": "vm-1", "display_name": "web-vm", "power_state": "VM_RUNNING"},
+	}})
+	sim.Put(membersPath+"ip-addresses", simulator.Object{"results": []interface{}{"10.0.0.1", "10.0.1.0/24"}})
+	sim.Put(membersPath+"segment-ports", simulator.Object{"results": []interface{}{
+		map[string]interface{}{"id": "port1", "path": "/infra/segments/web/ports/port1"},
+	}})
+	sim.Put(membersPath+"segments", simulator.Object{"results": []interface{}{}})
+	sim.Put(membersPath+"vifs", simulator.Object{"results": []interface{}{
+		map[string]interface{}{"external_id": "vif-1", "mac_address": "00:50:56:00:00:01", "owner_vm_id": "vm-1",
+			"ip_address_info": []interface{}{map[string]interface{}{"ip_addresses": []interface{}{"10.0.0.1"}}}},
+	}})
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	dataSource := provider.DataSourcesMap["nsxt_policy_group_members"]
+
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"group_path": groupPath,
+		"context":    []interface{}{map[string]interface{}{"project_id": "p1"}},
+	})
+	if diags := testResourceRead(dataSource, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("Failed to read nsxt_policy_group_members: %v", diags)
+	}
+
+	if d.Id() != groupPath {
+		t.Errorf("Expected ID %s, got %s", groupPath, d.Id())
+	}
+	expected := map[string]interface{}{
+		"virtual_machines.0.id":           "vm-1",
+		"virtual_machines.0.display_name": "web-vm",
+		"ip_addresses":                    []interface{}{"10.0.0.1", "10.0.1.0/24"},
+		"mac_addresses":                   []interface{}{"00:50:56:00:00:01"},
+		"segment_ports":                   []interface{}{"/infra/segments/web/ports/port1"},
+		"segments":                        []interface{}{},
+		"vifs.0.owner_vm_id":              "vm-1",
+		"vifs.0.ip_addresses":             []interface{}{"10.0.0.1"},
+	}
+	for key, value := range expected {
+		if actual := d.Get(key); !reflect.DeepEqual(actual, value) {
+			t.Errorf("Expected %s to be %v, got %v", key, value, actual)
+		}
+	}
+
+	// Project is derived from group path when context is not specified
+	d = schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"group_path": groupPath})
+	if diags := testResourceRead(dataSource, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("Failed to read nsxt_policy_group_members without context: %v", diags)
+	}
+	if actual := d.Get("virtual_machines.0.id"); actual != "vm-1" {
+		t.Errorf("Expected members of project group, got VM %v", actual)
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"group_path": "/infra/segments/web"})
+	if diags := testResourceRead(dataSource, d, provider.Meta()); !diags.HasError() {
+		t.Errorf("Expected error for path that does not point to a group")
+	}
+}
+
+func TestListPolicyPaginated(t *testing.T) {
+	cursor := func(value string) *string { return &value }
+	count := func(value int64) *int64 { return &value }
+	type page struct {
+		results []int
+		cursor  *string
+		count   *int64
+	}
+	cases := []struct {
+		name     string
+		pages    []page
+		expected []int
+	}{
+		{"single page", []page{{[]int{1, 2}, nil, count(2)}}, []int{1, 2}},
+		{"result count", []page{{[]int{1}, cursor("c1"), count(2)}, {[]int{2}, cursor("c2"), nil}}, []int{1, 2}},
+		{"no cursor", []page{{[]int{1}, nil, count(5)}}, []int{1}},
+		{"empty cursor", []page{{[]int{1}, cursor(""), count(5)}}, []int{1}},
+		{"empty page", []page{{[]int{1}, cursor("c1"), count(5)}, {nil, cursor("c2"), nil}}, []int{1}},
+		{"no result count", []page{{[]int{1}, cursor("c1"), nil}}, []int{1}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			results, err := listPolicyPaginated(func(requestCursor *string) ([]int, *string, *int64, error) {
+				if calls >= len(tc.pages) {
+					return nil, nil, nil, fmt.Errorf("unexpected request with cursor %v", *requestCursor)
+				}
+				if calls > 0 && requestCursor != tc.pages[calls-1].cursor {
+					return nil, nil, nil, fmt.Errorf("expected cursor of previous page")
+				}
+				current := tc.pages[calls]
+				calls++
+				return current.results, current.cursor, current.count, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(results, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, results)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGroupsRead,
+		Schema: getPolicyItemsDataSourceSchema(map[string]*schema.Schema{
+			"domain": getDomainNameSchema(),
+		}),
+	}
+}
+
+func dataSourceNsxtPolicyGroupsRead(d *schema.ResourceData, m interface{}) error {
+	query := make(map[string]string)
+	query["parent_path"] = "*/" + d.Get("domain").(string)
+	return policyItemsDataSourceRead(d, m, "Group", query)
+}
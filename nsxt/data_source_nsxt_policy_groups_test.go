@@ -0,0 +1,157 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestAccDataSourceNsxtPolicyGroups_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupsBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicyGroups_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupsBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicyGroupsBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_groups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupsReadTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.%", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("items.%s-prod", name), "nsxt_policy_group.prod", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupsReadTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "prod" {
+%s
+  display_name = "%s-prod"
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+}
+
+resource "nsxt_policy_group" "dev" {
+%s
+  display_name = "%s-dev"
+
+  tag {
+    scope = "env"
+    tag   = "dev"
+  }
+}
+
+data "nsxt_policy_groups" "test" {
+%s
+  display_name_regex = "^%s"
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+
+  depends_on = [nsxt_policy_group.prod, nsxt_policy_group.dev]
+}`, context, name, context, name, context, name)
+}
+
+func TestDataSourceNsxtPolicyGroupsSimulator(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+	sim.PageSize = 2
+
+	env := func(value string) []interface{} {
+		return []interface{}{map[string]interface{}{"scope": "env", "tag": value}}
+	}
+	for i := 0; i < 3; i++ {
+		sim.Put(fmt.Sprintf("/infra/domains/default/groups/web%d", i), simulator.Object{"resource_type": "Group", "display_name": fmt.Sprintf("web%d", i), "tags": env("prod")})
+	}
+	sim.Put("/infra/domains/default/groups/db", simulator.Object{"resource_type": "Group", "display_name": "db", "tags": env("dev")})
+	sim.Put("/infra/domains/other/groups/web0", simulator.Object{"resource_type": "Group", "display_name": "web0", "tags": env("prod")})
+	sim.Put("/infra/segments/web0", simulator.Object{"resource_type": "Segment", "display_name": "web0", "tags": env("prod")})
+	sim.Put("/orgs/default/projects/p1/infra/domains/default/groups/web0", simulator.Object{"resource_type": "Group", "display_name": "web0"})
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	dataSource := provider.DataSourcesMap["nsxt_policy_groups"]
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected map[string]interface{}
+		err      string
+	}{
+		{"all", map[string]interface{}{}, map[string]interface{}{
+			"web0": "/infra/domains/default/groups/web0",
+			"web1": "/infra/domains/default/groups/web1",
+			"web2": "/infra/domains/default/groups/web2",
+			"db":   "/infra/domains/default/groups/db",
+		}, ""},
+		{"regex", map[string]interface{}{"display_name_regex": "^web[12]$"}, map[string]interface{}{
+			"web1": "/infra/domains/default/groups/web1",
+			"web2": "/infra/domains/default/groups/web2",
+		}, ""},
+		{"tag", map[string]interface{}{"tag": env("dev")}, map[string]interface{}{
+			"db": "/infra/domains/default/groups/db",
+		}, ""},
+		{"domain", map[string]interface{}{"domain": "other"}, map[string]interface{}{
+			"web0": "/infra/domains/other/groups/web0",
+		}, ""},
+		{"project", map[string]interface{}{"context": []interface{}{map[string]interface{}{"project_id": "p1"}}}, map[string]interface{}{
+			"web0": "/orgs/default/projects/p1/infra/domains/default/groups/web0",
+		}, ""},
+		{"no results", map[string]interface{}{"display_name_regex": "^app"}, map[string]interface{}{}, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSource.Schema, tc.config)
+			if diags := testResourceRead(dataSource, d, provider.Meta()); diags.HasError() {
+				t.Fatalf("Failed to read nsxt_policy_groups: %v", diags)
+			}
+			if items := d.Get("items").(map[string]interface{}); !reflect.DeepEqual(items, tc.expected) {
+				t.Errorf("Expected items %v, got %v", tc.expected, items)
+			}
+		})
+	}
+
+	// Duplicate display name fails with colliding paths, regardless of order
+	sim.Put("/infra/domains/default/groups/web0-copy", simulator.Object{"resource_type": "Group", "display_name": "web0"})
+	sim.Put("/infra/domains/default/groups/a-web0", simulator.Object{"resource_type": "Group", "display_name": "web0"})
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"display_name_regex": "^web0$"})
+	diags := testResourceRead(dataSource, d, provider.Meta())
+	expected := "Found multiple Group with name 'web0': /infra/domains/default/groups/a-web0, /infra/domains/default/groups/web0, /infra/domains/default/groups/web0-copy"
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, expected) {
+		t.Errorf("Expected error for duplicate names, got %v", diags)
+	}
+}
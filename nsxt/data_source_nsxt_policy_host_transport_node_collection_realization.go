@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,7 +19,7 @@ import (
 
 func dataSourceNsxtPolicyHostTransportNodeCollectionRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyHostTransportNodeCollectionRealizationRead,
+		ReadContext: dataSourceNsxtPolicyHostTransportNodeCollectionRealizationRead,
 
 		Schema: map[string]*schema.Schema{
 			"path": {
@@ -49,11 +51,11 @@ func dataSourceNsxtPolicyHostTransportNodeCollectionRealization() *schema.Resour
 	}
 }
 
-func dataSourceNsxtPolicyHostTransportNodeCollectionRealizationRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyHostTransportNodeCollectionRealizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
-		return localManagerOnlyError()
+		return diag.FromErr(localManagerOnlyError())
 	}
-	connector := getPolicyConnector(m)
+	connector := getContextPolicyConnector(ctx, m)
 	client := transport_node_collections.NewStateClient(connector)
 
 	path := d.Get("path").(string)
@@ -70,12 +72,12 @@ func dataSourceNsxtPolicyHostTransportNodeCollectionRealizationRead(d *schema.Re
 
 	site, err := getParameterFromPolicyPath("/sites/", "/enforcement-points/", path)
 	if err != nil {
-		return fmt.Errorf("Invalid transport node collection path %s", path)
+		return diag.FromErr(fmt.Errorf("Invalid transport node collection path %s", path))
 	}
 
 	ep, err1 := getParameterFromPolicyPath("/enforcement-points/", "/transport-node-collections/", path)
 	if err1 != nil {
-		return fmt.Errorf("Invalid transport node collection path %s", path)
+		return diag.FromErr(fmt.Errorf("Invalid transport node collection path %s", path))
 	}
 
 	objID := getPolicyIDFromPath(path)
@@ -103,9 +105,9 @@ func dataSourceNsxtPolicyHostTransportNodeCollectionRealizationRead(d *schema.Re
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return err
+		return handleContextError(ctx, "Host transport node collection realization", err)
 	}
 	return nil
 }
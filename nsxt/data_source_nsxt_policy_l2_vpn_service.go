@@ -18,6 +18,8 @@ func dataSourceNsxtPolicyL2VpnService() *schema.Resource {
 			"gateway_path": getPolicyPathSchema(false, false, "Gateway path"),
 			"path":         getPathSchema(),
 			"display_name": getDisplayNameSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
+			"exact_match":  getDataSourceExactMatchSchema(),
 			"description":  getDescriptionSchema(),
 		},
 	}
@@ -4,11 +4,17 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
 )
 
 func TestAccDataSourceNsxtPolicyRealizationInfo_tier1DataSource(t *testing.T) {
@@ -292,3 +298,25 @@ data "nsxt_policy_realization_info" "realization_info" {
   entity_type = "%s"
 }`, resourceType, context, resourceName, context, resourceType, entityType)
 }
+
+func TestProviderRealizationCancel(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+	sim.Put("/infra/tier-1s/t1", simulator.Object{"display_name": "pending-t1"})
+	sim.RealizationState = "UNREALIZED"
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	ds := provider.DataSourcesMap["nsxt_policy_realization_info"]
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"path": "/infra/tier-1s/t1"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(500*time.Millisecond, cancel)
+	start := time.Now()
+	diags := ds.ReadContext(ctx, d, provider.Meta())
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "cancelled") {
+		t.Errorf("Expected realization wait to be cancelled, got %v", diags)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Expected realization wait to stop on cancellation, took %v", elapsed)
+	}
+}
@@ -0,0 +1,168 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func dataSourceNsxtPolicySearch() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:         schema.TypeString,
+				Description:  "NSX search query",
+				Optional:     true,
+				AtLeastOneOf: []string{"query", "resource_type", "tag"},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Description: "Resource type of objects to search for, for example Segment or Group",
+				Optional:    true,
+			},
+			"tag": {
+				Type:        schema.TypeSet,
+				Description: "Tags that objects should carry, empty scope or tag matches any value",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tag": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"context": getContextSchema(false, false),
+			"results": {
+				Type:        schema.TypeList,
+				Description: "Objects matching the search",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tag": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scope": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tag": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"json": {
+							Type:        schema.TypeString,
+							Description: "Full object as returned by NSX search, in JSON format",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildPolicySearchQuery combines raw query with resource type and tag filters
+func buildPolicySearchQuery(query string, resourceType string, tags []model.Tag) string {
+	terms := []string{"marked_for_delete:false"}
+	if query != "" {
+		terms = append(terms, fmt.Sprintf("(%s)", query))
+	}
+	if resourceType != "" {
+		terms = append(terms, fmt.Sprintf("resource_type:%s", resourceType))
+	}
+	if tagsQuery := buildPolicyTagsQuery(tags); tagsQuery != "" {
+		terms = append(terms, tagsQuery)
+	}
+	return strings.Join(terms, " AND ")
+}
+
+func dataSourceNsxtPolicySearchRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	tags := getPolicyTagFiltersFromSchema(d, "tag")
+	query := buildPolicySearchQuery(d.Get("query").(string), d.Get("resource_type").(string), tags)
+
+	log.Printf("[DEBUG] Searching policy objects with query %s", query)
+	resultValues, err := searchPolicyResourcesInContext(connector, getSessionContext(d, m), query)
+	if err != nil {
+		return handleDataSourceReadError(d, "Search", query, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	encoder := cleanjson.NewDataValueToJsonEncoder()
+	var results []map[string]interface{}
+	for _, result := range resultValues {
+		dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		if !policyResourceMatchesTags(policyResource.Tags, tags) {
+			continue
+		}
+
+		objJSON, err := encoder.Encode(result)
+		if err != nil {
+			return fmt.Errorf("Failed to encode search result: %v", err)
+		}
+		elem := make(map[string]interface{})
+		elem["id"] = policyResource.Id
+		elem["path"] = policyResource.Path
+		elem["display_name"] = policyResource.DisplayName
+		elem["description"] = policyResource.Description
+		elem["resource_type"] = policyResource.ResourceType
+		var tagList []map[string]interface{}
+		for _, tag := range policyResource.Tags {
+			tagList = append(tagList, map[string]interface{}{"scope": tag.Scope, "tag": tag.Tag})
+		}
+		elem["tag"] = tagList
+		elem["json"] = objJSON
+		results = append(results, elem)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("Failed to set search results: %v", err)
+	}
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,195 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestAccDataSourceNsxtPolicySearch_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicySearchBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicySearch_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicySearchBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicySearchBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_search.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySearchReadTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.display_name", name+"-prod"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.resource_type", "Group"),
+					resource.TestCheckResourceAttrPair(testResourceName, "results.0.path", "nsxt_policy_group.prod", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "results.0.json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySearchReadTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "prod" {
+%s
+  display_name = "%s-prod"
+  tag {
+    scope = "env"
+    tag   = "%s"
+  }
+}
+
+resource "nsxt_policy_group" "dev" {
+%s
+  display_name = "%s-dev"
+  tag {
+    scope = "env"
+    tag   = "%s-dev"
+  }
+}
+
+data "nsxt_policy_search" "test" {
+%s
+  resource_type = "Group"
+  query         = "display_name:%s*"
+  tag {
+    scope = "env"
+    tag   = "%s"
+  }
+
+  depends_on = [nsxt_policy_group.prod, nsxt_policy_group.dev]
+}`, context, name, name, context, name, name, context, name, name)
+}
+
+func TestDataSourceNsxtPolicySearchSimulator(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+	sim.PageSize = 2
+
+	tag := func(scope, tag string) []interface{} {
+		return []interface{}{map[string]interface{}{"scope": scope, "tag": tag}}
+	}
+	for i := 0; i < 3; i++ {
+		sim.Put(fmt.Sprintf("/infra/segments/prod%d", i), simulator.Object{"resource_type": "Segment", "display_name": fmt.Sprintf("prod%d", i), "tags": tag("env", "prod")})
+	}
+	sim.Put("/infra/segments/dev", simulator.Object{"resource_type": "Segment", "display_name": "dev", "tags": tag("env", "dev")})
+	// scope and tag match separately, but not as a pair
+	sim.Put("/infra/segments/mixed", simulator.Object{"resource_type": "Segment", "display_name": "mixed", "tags": []interface{}{
+		map[string]interface{}{"scope": "env", "tag": "dev"},
+		map[string]interface{}{"scope": "owner", "tag": "prod"},
+	}})
+	sim.Put("/infra/domains/default/groups/prod", simulator.Object{"resource_type": "Group", "display_name": "prod-group", "tags": tag("env", "prod")})
+	sim.Put("/infra/segments/web-ops", simulator.Object{"resource_type": "Segment", "display_name": "web-ops", "tags": tag("team", "web ops")})
+	sim.Put("/infra/segments/web-ops-quoted", simulator.Object{"resource_type": "Segment", "display_name": "web-ops-quoted", "tags": tag("team", `web "ops" \ eu`)})
+	sim.Put("/orgs/default/projects/p1/infra/segments/prod", simulator.Object{"resource_type": "Segment", "display_name": "project-prod", "tags": tag("env", "prod")})
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	dataSource := provider.DataSourcesMap["nsxt_policy_search"]
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected []string
+	}{
+		{"tag and type", map[string]interface{}{
+			"resource_type": "Segment",
+			"tag":           tag("env", "prod"),
+		}, []string{"/infra/segments/prod0", "/infra/segments/prod1", "/infra/segments/prod2"}},
+		{"raw query", map[string]interface{}{
+			"query": "path:\\/infra\\/domains*",
+			"tag":   tag("", "prod"),
+		}, []string{"/infra/domains/default/groups/prod"}},
+		{"tag with spaces", map[string]interface{}{
+			"resource_type": "Segment",
+			"tag":           tag("team", "web ops"),
+		}, []string{"/infra/segments/web-ops"}},
+		{"tag with quotes", map[string]interface{}{
+			"resource_type": "Segment",
+			"tag":           tag("team", `web "ops" \ eu`),
+		}, []string{"/infra/segments/web-ops-quoted"}},
+		{"project", map[string]interface{}{
+			"resource_type": "Segment",
+			"context":       []interface{}{map[string]interface{}{"project_id": "p1"}},
+		}, []string{"/orgs/default/projects/p1/infra/segments/prod"}},
+		{"no results", map[string]interface{}{
+			"resource_type": "Tier1",
+		}, nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSource.Schema, tc.config)
+			if diags := testResourceRead(dataSource, d, provider.Meta()); diags.HasError() {
+				t.Fatalf("Failed to read nsxt_policy_search: %v", diags)
+			}
+
+			results := d.Get("results").([]interface{})
+			var paths []string
+			for _, result := range results {
+				paths = append(paths, result.(map[string]interface{})["path"].(string))
+			}
+			if fmt.Sprint(paths) != fmt.Sprint(tc.expected) {
+				t.Fatalf("Expected results %v, got %v", tc.expected, paths)
+			}
+			if len(results) == 0 {
+				return
+			}
+
+			first := results[0].(map[string]interface{})
+			var obj map[string]interface{}
+			if err := json.Unmarshal([]byte(first["json"].(string)), &obj); err != nil {
+				t.Fatalf("Expected result in JSON format, got %s: %v", first["json"], err)
+			}
+			if obj["path"] != first["path"] || obj["display_name"] != first["display_name"] {
+				t.Errorf("Expected JSON to describe result %v, got %v", first["path"], obj)
+			}
+			if len(first["tag"].([]interface{})) == 0 {
+				t.Errorf("Expected tags to be set in results")
+			}
+		})
+	}
+}
+
+func TestBuildPolicySearchQuery(t *testing.T) {
+	scope := "env"
+	tag := "prod/eu"
+	query := buildPolicySearchQuery("display_name:web* OR display_name:app*", "Segment", []gm_model.Tag{{Scope: &scope, Tag: &tag}})
+	expected := "marked_for_delete:false AND (display_name:web* OR display_name:app*) AND resource_type:Segment AND tags.scope:env AND tags.tag:prod\\/eu"
+	if query != expected {
+		t.Errorf("Expected query %s, got %s", expected, query)
+	}
+
+	scope = "team"
+	tag = `web "ops" \ eu`
+	query = buildPolicySearchQuery("", "Segment", []gm_model.Tag{{Scope: &scope, Tag: &tag}})
+	expected = `marked_for_delete:false AND resource_type:Segment AND tags.scope:team AND tags.tag:"web \"ops\" \\ eu"`
+	if query != expected {
+		t.Errorf("Expected query %s, got %s", expected, query)
+	}
+}
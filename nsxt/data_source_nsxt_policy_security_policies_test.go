@@ -0,0 +1,84 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySecurityPolicies_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicySecurityPoliciesBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicySecurityPolicies_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicySecurityPoliciesBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicySecurityPoliciesBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_security_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPoliciesReadTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.%", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("items.%s-prod", name), "nsxt_policy_security_policy.prod", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySecurityPoliciesReadTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_security_policy" "prod" {
+%s
+  display_name = "%s-prod"
+  category     = "Application"
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+}
+
+resource "nsxt_policy_security_policy" "dev" {
+%s
+  display_name = "%s-dev"
+  category     = "Application"
+
+  tag {
+    scope = "env"
+    tag   = "dev"
+  }
+}
+
+data "nsxt_policy_security_policies" "test" {
+%s
+  display_name_regex = "^%s"
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+
+  depends_on = [nsxt_policy_security_policy.prod, nsxt_policy_security_policy.dev]
+}`, context, name, context, name, context, name)
+}
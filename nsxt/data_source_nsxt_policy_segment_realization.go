@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -18,7 +20,7 @@ import (
 
 func dataSourceNsxtPolicySegmentRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySegmentRealizationRead,
+		ReadContext: dataSourceNsxtPolicySegmentRealizationRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":      getDataSourceIDSchema(),
@@ -43,10 +45,10 @@ func dataSourceNsxtPolicySegmentRealization() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicySegmentRealizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read the realization info by the path, and wait till it is valid
-	connector := getPolicyConnector(m)
-	context := getSessionContext(d, m)
+	connector := getContextPolicyConnector(ctx, m)
+	sessionContext := getSessionContext(d, m)
 	commonProviderConfig := getCommonProviderConfig(m)
 
 	// Get the realization info of this resource
@@ -61,7 +63,7 @@ func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interf
 	// verifying segment realization on hypervisor
 	segmentID := getPolicyIDFromPath(path)
 	enforcementPointPath := getPolicyEnforcementPointPath(m)
-	client := segments.NewStateClient(context, connector)
+	client := segments.NewStateClient(sessionContext, connector)
 	pendingStates := []string{model.SegmentConfigurationState_STATE_PENDING,
 		model.SegmentConfigurationState_STATE_IN_PROGRESS,
 		model.SegmentConfigurationState_STATE_IN_SYNC,
@@ -91,22 +93,24 @@ func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interf
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
+		return handleContextError(ctx, "Segment realization", fmt.Errorf("Failed to get realization information for %s: %v", path, err))
 	}
 
 	// In some cases success state is returned a moment before VC actually sees the network
 	// Adding a short sleep here prevents vsphere provider from erroring out
-	time.Sleep(1 * time.Second)
+	if err := sleepContext(ctx, 1*time.Second); err != nil {
+		return handleContextError(ctx, "Segment realization", err)
+	}
 
 	// We need to fetch network name to use in vpshere provider. However, state API does not
 	// return it in details yet. For now, we'll use segment display name, since its always
 	// translates to network name
-	segClient := infra.NewSegmentsClient(context, connector)
+	segClient := infra.NewSegmentsClient(sessionContext, connector)
 	obj, err := segClient.Get(segmentID)
 	if err != nil {
-		return handleReadError(d, "Segment", segmentID, err)
+		return diag.FromErr(handleReadError(d, "Segment", segmentID, err))
 	}
 
 	d.Set("network_name", obj.DisplayName)
@@ -5,14 +5,17 @@ package nsxt
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
 	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
 )
 
 func TestAccDataSourceNsxtPolicySegment_basic(t *testing.T) {
@@ -112,3 +115,109 @@ data "nsxt_policy_segment" "test" {
   display_name = "%s"
 }`, context, name)
 }
+
+func TestAccDataSourceNsxtPolicySegment_byTag(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_segment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentReadByTagTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "path", "nsxt_policy_segment.team1", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentReadByTagTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_transport_zone" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_segment" "team1" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+  tag {
+    scope = "owner"
+    tag   = "team1"
+  }
+}
+
+resource "nsxt_policy_segment" "team2" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+  tag {
+    scope = "owner"
+    tag   = "team2"
+  }
+}
+
+data "nsxt_policy_segment" "test" {
+  display_name = "%s"
+  exact_match  = true
+  tag {
+    scope = "owner"
+    tag   = "team1"
+  }
+
+  depends_on = [nsxt_policy_segment.team1, nsxt_policy_segment.team2]
+}`, getOverlayTransportZoneName(), name, name, name)
+}
+
+func TestDataSourceNsxtPolicySegmentTagFilterSimulator(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	owner := func(team string) []interface{} {
+		return []interface{}{map[string]interface{}{"scope": "owner", "tag": team}}
+	}
+	sim.Put("/infra/segments/web1", simulator.Object{"resource_type": "Segment", "display_name": "web", "tags": owner("team1")})
+	sim.Put("/infra/segments/web2", simulator.Object{"resource_type": "Segment", "display_name": "web", "tags": owner("team2")})
+	sim.Put("/infra/segments/web3", simulator.Object{"resource_type": "Segment", "display_name": "web-backup", "tags": owner("team2")})
+	sim.Put("/infra/segments/db", simulator.Object{"resource_type": "Segment", "display_name": "db", "tags": owner("team3")})
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	dataSource := provider.DataSourcesMap["nsxt_policy_segment"]
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected string
+		err      string
+	}{
+		{"name collision", map[string]interface{}{"display_name": "web"}, "", "Found multiple Segment with name 'web'"},
+		{"name and tag", map[string]interface{}{"display_name": "web", "tag": owner("team1")}, "web1", ""},
+		{"prefix and tag", map[string]interface{}{"display_name": "web", "tag": owner("team2")}, "web2", ""},
+		{"prefix collision", map[string]interface{}{"display_name": "we", "tag": owner("team2")}, "", "Found multiple Segment with name starting with 'we' and matching tags"},
+		{"exact match", map[string]interface{}{"display_name": "we", "exact_match": true, "tag": owner("team1")}, "", "Segment with name 'we' and matching tags was not found"},
+		{"tag only", map[string]interface{}{"tag": owner("team3")}, "db", ""},
+		{"tag scope only", map[string]interface{}{"tag": []interface{}{map[string]interface{}{"scope": "owner"}}}, "", "Found multiple Segment"},
+		{"no match", map[string]interface{}{"display_name": "db", "tag": owner("team1")}, "", "was not found"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSource.Schema, tc.config)
+			diags := testResourceRead(dataSource, d, provider.Meta())
+			if tc.err != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.err) {
+					t.Fatalf("Expected error %q, got %v", tc.err, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("Failed to read nsxt_policy_segment: %v", diags)
+			}
+			if d.Id() != tc.expected {
+				t.Errorf("Expected segment %s, got %s", tc.expected, d.Id())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegments_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_segments.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.%", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("items.%s-prod", name), "nsxt_policy_segment.prod", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_transport_zone" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_segment" "prod" {
+  display_name        = "%s-prod"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+}
+
+resource "nsxt_policy_segment" "dev" {
+  display_name        = "%s-dev"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+
+  tag {
+    scope = "env"
+    tag   = "dev"
+  }
+}
+
+data "nsxt_policy_segments" "test" {
+  display_name_regex = "^%s"
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+
+  depends_on = [nsxt_policy_segment.prod, nsxt_policy_segment.dev]
+}`, getOverlayTransportZoneName(), name, name, name)
+}
@@ -0,0 +1,88 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyServices_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicyServicesBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicyServices_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicyServicesBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicyServicesBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_services.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServicesReadTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.%", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("items.%s-prod", name), "nsxt_policy_service.prod", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyServicesReadTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_service" "prod" {
+%s
+  display_name = "%s-prod"
+  icmp_entry {
+    protocol = "ICMPv4"
+  }
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+}
+
+resource "nsxt_policy_service" "dev" {
+%s
+  display_name = "%s-dev"
+  icmp_entry {
+    protocol = "ICMPv4"
+  }
+
+  tag {
+    scope = "env"
+    tag   = "dev"
+  }
+}
+
+data "nsxt_policy_services" "test" {
+%s
+  display_name_regex = "^%s"
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+
+  depends_on = [nsxt_policy_service.prod, nsxt_policy_service.dev]
+}`, context, name, context, name, context, name)
+}
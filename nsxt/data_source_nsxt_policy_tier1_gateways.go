@@ -0,0 +1,19 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyTier1Gateways() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNsxtPolicyTier1GatewaysRead,
+		Schema: getPolicyItemsDataSourceSchema(nil),
+	}
+}
+
+func dataSourceNsxtPolicyTier1GatewaysRead(d *schema.ResourceData, m interface{}) error {
+	return policyItemsDataSourceRead(d, m, "Tier1", nil)
+}
@@ -18,6 +18,8 @@ func dataSourceNsxtVtepHAHostSwitchProfile() *schema.Resource {
 			"id":           getDataSourceIDSchema(),
 			"path":         getPathSchema(),
 			"display_name": getDisplayNameSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
+			"exact_match":  getDataSourceExactMatchSchema(),
 			"description":  getDescriptionSchema(),
 		},
 	}
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,7 +19,7 @@ import (
 
 func dataSourceNsxtTransportNodeRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtTransportNodeRealizationRead,
+		ReadContext: dataSourceNsxtTransportNodeRealizationRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -48,8 +50,8 @@ func dataSourceNsxtTransportNodeRealization() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtTransportNodeRealizationRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func dataSourceNsxtTransportNodeRealizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 	client := transport_nodes.NewStateClient(connector)
 
 	id := d.Get("id").(string)
@@ -90,9 +92,9 @@ func dataSourceNsxtTransportNodeRealizationRead(d *schema.ResourceData, m interf
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return err
+		return handleContextError(ctx, "Transport node realization", err)
 	}
 
 	return nil
@@ -0,0 +1,192 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func getDefaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Tags applied to all objects managed by the provider. Resource tag with same scope takes precedence",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func getTagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "All tags of this resource, including provider default tags",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// getProviderDefaultTags returns tags configured on provider level, to be
+// merged into tags of objects managed by resources that support tags_all
+func getProviderDefaultTags(m interface{}) []interface{} {
+	clients, ok := m.(nsxtClients)
+	if !ok {
+		return nil
+	}
+	return clients.CommonConfig.DefaultTags
+}
+
+// resourceSupportsDefaultTags checks whether default tags apply to the object,
+// which is only the case for resources with tags_all attribute
+func resourceSupportsDefaultTags(d *schema.ResourceData) bool {
+	return d.Get("tags_all") != nil
+}
+
+func tagValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case *string:
+		if v != nil {
+			return *v
+		}
+	}
+	return ""
+}
+
+// mergeDefaultTags adds provider default tags to tags configured on resource,
+// unless resource specifies tag with same scope
+func mergeDefaultTags(tags []interface{}, defaultTags []interface{}) []interface{} {
+	if len(defaultTags) == 0 {
+		return tags
+	}
+
+	scopes := make(map[string]bool)
+	for _, tag := range tags {
+		scopes[tagValue(tag.(map[string]interface{})["scope"])] = true
+	}
+
+	result := append([]interface{}{}, tags...)
+	for _, tag := range defaultTags {
+		if !scopes[tagValue(tag.(map[string]interface{})["scope"])] {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// getTagsWithDefaultsFromSchema returns tags configured in schemaName attribute,
+// merged with provider default tags if resource supports those
+func getTagsWithDefaultsFromSchema(d *schema.ResourceData, schemaName string, m interface{}) []interface{} {
+	tags := d.Get(schemaName).(*schema.Set).List()
+	if !resourceSupportsDefaultTags(d) {
+		return tags
+	}
+	return mergeDefaultTags(tags, getProviderDefaultTags(m))
+}
+
+func isDefaultTag(defaultTags []interface{}, scope string, tag string) bool {
+	for _, defaultTag := range defaultTags {
+		data := defaultTag.(map[string]interface{})
+		if tagValue(data["scope"]) == scope && tagValue(data["tag"]) == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// setTagsWithDefaultsInSchema stores tags read from NSX. Provider default tags
+// that are not configured on the resource itself are only reflected in tags_all,
+// in order to avoid diff on schemaName attribute.
+func setTagsWithDefaultsInSchema(d *schema.ResourceData, tagList []map[string]interface{}, schemaName string, m interface{}) error {
+	if !resourceSupportsDefaultTags(d) {
+		// Not a resource, or resource does not support default tags
+		return d.Set(schemaName, tagList)
+	}
+
+	if schemaName == "tag" {
+		if err := d.Set("tags_all", tagList); err != nil {
+			return err
+		}
+	}
+
+	defaultTags := getProviderDefaultTags(m)
+	if len(defaultTags) == 0 {
+		return d.Set(schemaName, tagList)
+	}
+
+	configured := make(map[string]bool)
+	for _, tag := range d.Get(schemaName).(*schema.Set).List() {
+		data := tag.(map[string]interface{})
+		configured[tagValue(data["scope"])+"/"+tagValue(data["tag"])] = true
+	}
+
+	var filteredList []map[string]interface{}
+	for _, tag := range tagList {
+		scope := tagValue(tag["scope"])
+		value := tagValue(tag["tag"])
+		if isDefaultTag(defaultTags, scope, value) && !configured[scope+"/"+value] {
+			continue
+		}
+		filteredList = append(filteredList, tag)
+	}
+	return d.Set(schemaName, filteredList)
+}
+
+// tagsAllCustomizeDiff computes tags_all at plan time, so that changes to
+// either resource tags or provider default tags are reflected in the plan
+func tagsAllCustomizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("tag") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	tagsAll := d.Get("tags_all").(*schema.Set)
+	merged := mergeDefaultTags(d.Get("tag").(*schema.Set).List(), getProviderDefaultTags(m))
+	if tagsAll.Equal(schema.NewSet(tagsAll.F, merged)) {
+		return nil
+	}
+	return d.SetNew("tags_all", merged)
+}
+
+// addTagsAllToResources extends every resource that supports tag attribute
+// with computed tags_all attribute
+func addTagsAllToResources(resources map[string]*schema.Resource) {
+	for _, resource := range resources {
+		tagSchema, ok := resource.Schema["tag"]
+		if !ok || tagSchema.Type != schema.TypeSet || tagSchema.Computed || tagSchema.ForceNew {
+			continue
+		}
+		if _, ok := resource.Schema["tags_all"]; ok {
+			continue
+		}
+		if resource.Update == nil && resource.UpdateContext == nil && resource.UpdateWithoutTimeout == nil {
+			continue
+		}
+
+		resource.Schema["tags_all"] = getTagsAllSchema()
+		addResourceCustomizeDiff(resource, tagsAllCustomizeDiff)
+	}
+}
@@ -0,0 +1,136 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func testDefaultTagsConfig(scope string, tag string) map[string]interface{} {
+	return map[string]interface{}{
+		"default_tags": []interface{}{
+			map[string]interface{}{"scope": scope, "tag": tag},
+		},
+	}
+}
+
+func TestProviderDefaultTagsPerAlias(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	// Provider aliases are configured concurrently by Terraform
+	providers := make([]*schema.Provider, 2)
+	var wg sync.WaitGroup
+	for i := range providers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			providers[i] = testConfigureSimulatorProvider(t, sim, testDefaultTagsConfig("tenant", fmt.Sprintf("tenant%d", i)))
+		}(i)
+	}
+	wg.Wait()
+
+	for i, provider := range providers {
+		res := provider.ResourcesMap["nsxt_policy_group"]
+		d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"display_name": fmt.Sprintf("group%d", i)})
+		if diags := testResourceCreate(res, d, provider.Meta()); diags.HasError() {
+			t.Fatalf("Failed to create group: %v", diags)
+		}
+
+		obj, _ := sim.Get(d.Get("path").(string))
+		tags := obj["tags"].([]interface{})
+		expected := fmt.Sprintf("tenant%d", i)
+		if len(tags) != 1 || tags[0].(map[string]interface{})["tag"] != expected {
+			t.Errorf("Expected default tag %s of provider alias, got %v", expected, tags)
+		}
+	}
+}
+
+func TestDefaultTagsNotMergedWithoutTagsAll(t *testing.T) {
+	m := nsxtClients{CommonConfig: commonProviderConfig{DefaultTags: testDefaultTagsConfig("owner", "netops")["default_tags"].([]interface{})}}
+	provider := Provider()
+
+	// Tags of this resource are ForceNew, thus default tags are not supported
+	res := provider.ResourcesMap["nsxt_ip_block_subnet"]
+	if _, ok := res.Schema["tags_all"]; ok {
+		t.Fatalf("Expected no tags_all attribute on resource with ForceNew tags")
+	}
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"tag": []interface{}{map[string]interface{}{"scope": "app", "tag": "web"}},
+	})
+	if tags := getTagsFromSchema(d, m); len(tags) != 1 || tags[0].Scope != "app" {
+		t.Errorf("Expected default tags not to be merged, got %v", tags)
+	}
+
+	res = provider.ResourcesMap["nsxt_policy_group"]
+	d = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"tag": []interface{}{map[string]interface{}{"scope": "app", "tag": "web"}},
+	})
+	if tags := getPolicyTagsFromSchema(d, m); len(tags) != 2 {
+		t.Errorf("Expected default tags to be merged, got %v", tags)
+	}
+}
+
+func TestProviderDefaultTags(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	provider := testConfigureSimulatorProvider(t, sim, map[string]interface{}{
+		"default_tags": []interface{}{
+			map[string]interface{}{"scope": "owner", "tag": "netops"},
+			map[string]interface{}{"scope": "env", "tag": "prod"},
+		},
+	})
+
+	res := provider.ResourcesMap["nsxt_policy_group"]
+	config := map[string]interface{}{
+		"display_name": "default-tags-group",
+		"tag": []interface{}{
+			map[string]interface{}{"scope": "env", "tag": "dev"},
+			map[string]interface{}{"scope": "app", "tag": "web"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, res.Schema, config)
+	if diags := testResourceCreate(res, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("Failed to create group: %v", diags)
+	}
+
+	obj, _ := sim.Get(d.Get("path").(string))
+	tags := obj["tags"].([]interface{})
+	if len(tags) != 3 {
+		t.Errorf("Expected resource tags merged with default tags, got %v", tags)
+	}
+	for _, tag := range tags {
+		data := tag.(map[string]interface{})
+		if data["scope"] == "env" && data["tag"] != "dev" {
+			t.Errorf("Expected resource tag to override default tag with same scope, got %v", data)
+		}
+	}
+	if d.Get("tag.#").(int) != 2 || d.Get("tags_all.#").(int) != 3 {
+		t.Errorf("Expected 2 tags and 3 tags_all, got %d and %d", d.Get("tag.#"), d.Get("tags_all.#"))
+	}
+
+	diff, err := res.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), provider.Meta())
+	if err != nil || (diff != nil && !diff.Empty()) {
+		t.Errorf("Expected no diff with default tags, got %v (%v)", diff, err)
+	}
+
+	config["tag"] = append(config["tag"].([]interface{}), map[string]interface{}{"scope": "team", "tag": "a"})
+	diff, err = res.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), provider.Meta())
+	if err != nil || diff == nil || diff.Attributes["tags_all.#"] == nil || diff.Attributes["tags_all.#"].New != "4" {
+		t.Errorf("Expected tags_all to be updated in plan, got %v (%v)", diff, err)
+	}
+
+	if diags := testResourceDelete(res, d, provider.Meta()); diags.HasError() {
+		t.Errorf("Failed to delete group: %v", diags)
+	}
+}
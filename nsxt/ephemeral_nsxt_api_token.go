@@ -0,0 +1,174 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	apiTokenTypeBearer  = "bearer"
+	apiTokenTypeSession = "session"
+)
+
+// apiTokenEphemeralResource obtains short-lived credentials for NSX API,
+// which are never stored in plan or state. With VMC authentication, a fresh
+// API token is obtained. Otherwise, a new NSX session is created with the
+// provider credentials, and destroyed once Terraform no longer needs it.
+type apiTokenEphemeralResource struct {
+	clients *nsxtClients
+}
+
+type apiTokenEphemeralResourceModel struct {
+	Type          types.String `tfsdk:"type"`
+	AccessToken   types.String `tfsdk:"access_token"`
+	SessionCookie types.String `tfsdk:"session_cookie"`
+	XsrfToken     types.String `tfsdk:"xsrf_token"`
+	Headers       types.Map    `tfsdk:"headers"`
+}
+
+// apiTokenSession is kept in private data between open and close
+type apiTokenSession struct {
+	Cookie string `json:"cookie"`
+	Xsrf   string `json:"xsrf"`
+}
+
+const apiTokenSessionKey = "session"
+
+var (
+	_ ephemeral.EphemeralResource              = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &apiTokenEphemeralResource{}
+)
+
+func newAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenEphemeralResource{}
+}
+
+func (r *apiTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *apiTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Short-lived credentials for NSX API, based on provider authentication settings",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Type of the credentials, bearer for VMC API token or session for NSX session",
+				Computed:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "VMC API token",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"session_cookie": schema.StringAttribute{
+				Description: "NSX session cookie",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"xsrf_token": schema.StringAttribute{
+				Description: "XSRF token of NSX session",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "HTTP headers that authenticate NSX API calls",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *apiTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	clients, err := getFrameworkClients(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to configure nsxt_api_token", err.Error())
+		return
+	}
+	r.clients = clients
+}
+
+func (r *apiTokenEphemeralResource) Open(ctx context.Context, _ ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.clients == nil {
+		resp.Diagnostics.AddError("Provider is not configured", "NSX API token can not be obtained before the provider is configured")
+		return
+	}
+
+	model := apiTokenEphemeralResourceModel{
+		AccessToken:   types.StringValue(""),
+		SessionCookie: types.StringValue(""),
+		XsrfToken:     types.StringValue(""),
+	}
+	var headers map[string]string
+
+	if vmcInfo := r.clients.VmcAuthInfo; vmcInfo != nil && !vmcInfo.IsZero() {
+		token, err := vmcInfo.getAPIToken()
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to obtain VMC API token", err.Error())
+			return
+		}
+		model.Type = types.StringValue(apiTokenTypeBearer)
+		model.AccessToken = types.StringValue(token)
+		headers = map[string]string{"Authorization": "Bearer " + token}
+	} else {
+		session := newNsxtSession(r.clients.Host, r.clients.CommonConfig.Username, r.clients.CommonConfig.Password, r.clients.CommonConfig.RemoteAuth, r.clients.PolicyTransport)
+		cookie, xsrf, err := session.create()
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create NSX session", err.Error())
+			return
+		}
+		cookie = strings.TrimSuffix(cookie, ";")
+
+		private, err := json.Marshal(apiTokenSession{Cookie: cookie, Xsrf: xsrf})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to store NSX session", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiTokenSessionKey, private)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		model.Type = types.StringValue(apiTokenTypeSession)
+		model.SessionCookie = types.StringValue(cookie)
+		model.XsrfToken = types.StringValue(xsrf)
+		headers = map[string]string{"Cookie": cookie, "X-XSRF-TOKEN": xsrf}
+	}
+
+	headersValue, diags := types.MapValueFrom(ctx, types.StringType, headers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Headers = headersValue
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+}
+
+// Close destroys NSX session created on open
+func (r *apiTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, apiTokenSessionKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil || r.clients == nil {
+		return
+	}
+
+	var session apiTokenSession
+	if err := json.Unmarshal(private, &session); err != nil {
+		resp.Diagnostics.AddError("Failed to read NSX session", err.Error())
+		return
+	}
+	nsxSession := newNsxtSession(r.clients.Host, "", "", false, r.clients.PolicyTransport)
+	if err := nsxSession.destroy(session.Cookie, session.Xsrf); err != nil {
+		resp.Diagnostics.AddWarning("Failed to destroy NSX session", err.Error())
+	}
+}
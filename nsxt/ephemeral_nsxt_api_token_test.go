@@ -0,0 +1,114 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+// testNullDynamicValue returns configuration with all attributes of the
+// schema set to null
+func testNullDynamicValue(t *testing.T, block *tfprotov5.SchemaBlock) *tfprotov5.DynamicValue {
+	objType := block.ValueType()
+	value, err := tfprotov5.NewDynamicValue(objType, tftypes.NewValue(objType, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &value
+}
+
+func testSimulatorSessionStatus(t *testing.T, sim *simulator.Server, cookie string) int {
+	req, err := http.NewRequest("GET", sim.URL()+"/policy/api/v1/infra/domains/default/groups", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Cookie", cookie)
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestAPITokenEphemeralResourceSession(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	ctx := context.Background()
+	sdkProvider := testConfigureSimulatorProvider(t, sim, nil)
+	server := providerserver.NewProtocol5(newFrameworkProvider(sdkProvider))()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenSchema, ok := schemaResp.EphemeralResourceSchemas["nsxt_api_token"]
+	if !ok {
+		t.Fatalf("Expected nsxt_api_token ephemeral resource to be served")
+	}
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config: testNullDynamicValue(t, schemaResp.Provider.Block),
+	})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("Failed to configure provider: %v %v", err, configureResp.Diagnostics)
+	}
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "nsxt_api_token",
+		Config:   testNullDynamicValue(t, tokenSchema.Block),
+	})
+	if err != nil || len(openResp.Diagnostics) > 0 {
+		t.Fatalf("Failed to open nsxt_api_token: %v %v", err, openResp.Diagnostics)
+	}
+
+	value, err := openResp.Result.Unmarshal(tokenSchema.ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := value.As(&attrs); err != nil {
+		t.Fatal(err)
+	}
+	var tokenType, cookie, xsrf string
+	var headers map[string]tftypes.Value
+	for name, target := range map[string]interface{}{"type": &tokenType, "session_cookie": &cookie, "xsrf_token": &xsrf, "headers": &headers} {
+		if err := attrs[name].As(target); err != nil {
+			t.Fatalf("Failed to read %s: %v", name, err)
+		}
+	}
+	if tokenType != apiTokenTypeSession || cookie == "" || xsrf == "" {
+		t.Fatalf("Expected NSX session, got type %q cookie %q xsrf %q", tokenType, cookie, xsrf)
+	}
+	if _, ok := headers["Cookie"]; !ok {
+		t.Errorf("Expected Cookie header, got %v", headers)
+	}
+
+	if status := testSimulatorSessionStatus(t, sim, cookie); status != http.StatusOK {
+		t.Fatalf("Expected session to be valid, got status %d", status)
+	}
+
+	closeResp, err := server.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "nsxt_api_token",
+		Private:  openResp.Private,
+	})
+	if err != nil || len(closeResp.Diagnostics) > 0 {
+		t.Fatalf("Failed to close nsxt_api_token: %v %v", err, closeResp.Diagnostics)
+	}
+
+	if status := testSimulatorSessionStatus(t, sim, cookie); status != http.StatusForbidden {
+		t.Errorf("Expected session to be destroyed on close, got status %d", status)
+	}
+}
@@ -0,0 +1,88 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type normalizeIPAddressFunction struct{}
+
+var _ function.Function = &normalizeIPAddressFunction{}
+
+func newNormalizeIPAddressFunction() function.Function {
+	return &normalizeIPAddressFunction{}
+}
+
+func (f *normalizeIPAddressFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_ip_address"
+}
+
+func (f *normalizeIPAddressFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize IP address, CIDR or IP range",
+		Description: "Converts IP address, CIDR or IP range to the canonical form NSX reports it in, i.e. 10.0.0.5/24 to 10.0.0.0/24 and 2001:DB8::0001 to 2001:db8::1, so that configured values do not differ from the ones NSX returns.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "IP address, CIDR or IP range",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizeIPAddressFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var address string
+	resp.Error = req.Arguments.Get(ctx, &address)
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeIPAddress(address)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
+
+func normalizeSingleIP(value string) (string, error) {
+	ip := net.ParseIP(strings.TrimSpace(value))
+	if ip == nil {
+		return "", fmt.Errorf("%q is not a valid IP address", value)
+	}
+	return ip.String(), nil
+}
+
+func normalizeIPAddress(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		// NSX reports network address of the CIDR
+		_, ipnet, err := net.ParseCIDR(value)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a valid CIDR", value)
+		}
+		return ipnet.String(), nil
+	}
+
+	if s := strings.Split(value, "-"); len(s) == 2 {
+		start, err := normalizeSingleIP(s[0])
+		if err != nil {
+			return "", err
+		}
+		end, err := normalizeSingleIP(s[1])
+		if err != nil {
+			return "", err
+		}
+		return start + "-" + end, nil
+	}
+
+	return normalizeSingleIP(value)
+}
@@ -0,0 +1,40 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeIPAddressFunction(t *testing.T) {
+	cases := map[string]string{
+		"10.0.0.1":                   "10.0.0.1",
+		" 10.0.0.5/24 ":              "10.0.0.0/24",
+		"10.0.0.1/32":                "10.0.0.1/32",
+		"2001:DB8::0001":             "2001:db8::1",
+		"2001:db8:0:0:0:0:0:1/64":    "2001:db8::/64",
+		"10.0.0.1 - 10.0.0.9":        "10.0.0.1-10.0.0.9",
+		"2001:DB8::1-2001:db8::00ff": "2001:db8::1-2001:db8::ff",
+	}
+
+	for address, expected := range cases {
+		value, funcErr := testRunFunction(t, newNormalizeIPAddressFunction(), types.StringUnknown(), types.StringValue(address))
+		if funcErr != nil {
+			t.Errorf("Unexpected error for %s: %v", address, funcErr)
+			continue
+		}
+		if actual := value.(types.String).ValueString(); actual != expected {
+			t.Errorf("Expected %s to be normalized to %s, got %s", address, expected, actual)
+		}
+	}
+
+	for _, address := range []string{"", "10.0.0", "10.0.0.1/33", "10.0.0.1-10.0.0.2-10.0.0.3", "host"} {
+		_, funcErr := testRunFunction(t, newNormalizeIPAddressFunction(), types.StringUnknown(), types.StringValue(address))
+		if funcErr == nil {
+			t.Errorf("Expected error for %q", address)
+		}
+	}
+}
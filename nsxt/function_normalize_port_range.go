@@ -0,0 +1,86 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type normalizePortRangeFunction struct{}
+
+var _ function.Function = &normalizePortRangeFunction{}
+
+func newNormalizePortRangeFunction() function.Function {
+	return &normalizePortRangeFunction{}
+}
+
+func (f *normalizePortRangeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_port_range"
+}
+
+func (f *normalizePortRangeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize port or port range",
+		Description: "Converts port or port range to the canonical form NSX reports it in, i.e. \"0080\" to \"80\" and \"1000 - 2000\" to \"1000-2000\", so that configured values do not differ from the ones NSX returns.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "port",
+				Description: "Single port or port range",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizePortRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var port string
+	resp.Error = req.Arguments.Get(ctx, &port)
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizePortRange(port)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
+
+func normalizeSinglePort(value string) (uint64, error) {
+	value = strings.TrimSpace(value)
+	if !isSinglePort(value) {
+		return 0, fmt.Errorf("%q is not a valid port", value)
+	}
+	return strconv.ParseUint(value, 10, 32)
+}
+
+func normalizePortRange(value string) (string, error) {
+	s := strings.Split(value, "-")
+	if len(s) > 2 {
+		return "", fmt.Errorf("%q is not a valid port range", value)
+	}
+
+	start, err := normalizeSinglePort(s[0])
+	if err != nil {
+		return "", err
+	}
+	if len(s) == 1 {
+		return strconv.FormatUint(start, 10), nil
+	}
+
+	end, err := normalizeSinglePort(s[1])
+	if err != nil {
+		return "", err
+	}
+	if start > end {
+		return "", fmt.Errorf("%q is not a valid port range, start port is greater than end port", value)
+	}
+	return fmt.Sprintf("%d-%d", start, end), nil
+}
@@ -0,0 +1,38 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizePortRangeFunction(t *testing.T) {
+	cases := map[string]string{
+		"80":          "80",
+		" 0080 ":      "80",
+		"1000-2000":   "1000-2000",
+		"1000 - 2000": "1000-2000",
+		"0443-0443":   "443-443",
+	}
+
+	for port, expected := range cases {
+		value, funcErr := testRunFunction(t, newNormalizePortRangeFunction(), types.StringUnknown(), types.StringValue(port))
+		if funcErr != nil {
+			t.Errorf("Unexpected error for %s: %v", port, funcErr)
+			continue
+		}
+		if actual := value.(types.String).ValueString(); actual != expected {
+			t.Errorf("Expected %s to be normalized to %s, got %s", port, expected, actual)
+		}
+	}
+
+	for _, port := range []string{"", "http", "70000", "2000-1000", "1-2-3"} {
+		_, funcErr := testRunFunction(t, newNormalizePortRangeFunction(), types.StringUnknown(), types.StringValue(port))
+		if funcErr == nil {
+			t.Errorf("Expected error for %q", port)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var parsedPolicyPathAttrTypes = map[string]attr.Type{
+	"project_id":        types.StringType,
+	"domain":            types.StringType,
+	"gateway_id":        types.StringType,
+	"gateway_type":      types.StringType,
+	"locale_service_id": types.StringType,
+	"id":                types.StringType,
+	"type":              types.StringType,
+}
+
+type parsePolicyPathFunction struct{}
+
+var _ function.Function = &parsePolicyPathFunction{}
+
+func newParsePolicyPathFunction() function.Function {
+	return &parsePolicyPathFunction{}
+}
+
+func (f *parsePolicyPathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_policy_path"
+}
+
+func (f *parsePolicyPathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse NSX policy path",
+		Description: "Given NSX policy path, returns project, domain, gateway, locale service, ID and type of the object. Components that are not part of the path are empty.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "path",
+				Description: "Policy path of the object, for example /infra/tier-1s/gw1/segments/seg1",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedPolicyPathAttrTypes,
+		},
+	}
+}
+
+func (f *parsePolicyPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path string
+	resp.Error = req.Arguments.Get(ctx, &path)
+	if resp.Error != nil {
+		return
+	}
+
+	values, err := parsePolicyPath(path)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	attrValues := make(map[string]attr.Value)
+	for name := range parsedPolicyPathAttrTypes {
+		attrValues[name] = types.StringValue(values[name])
+	}
+	result, diags := types.ObjectValue(parsedPolicyPathAttrTypes, attrValues)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// parsePolicyPath splits policy path into its components, i.e.
+// /orgs/default/projects/dev/infra/domains/default/groups/g1 has project
+// dev, domain default, id g1 and type group
+func parsePolicyPath(path string) (map[string]string, error) {
+	if !isPolicyPath(path) {
+		return nil, fmt.Errorf("%s is not a valid policy path", path)
+	}
+
+	segments := strings.Split(path, "/")
+	isProjectPath := len(segments) == 5 && segments[1] == "orgs"
+	if len(segments)%2 != 0 && !isProjectPath {
+		// Object paths consist of collection and ID pairs, i.e.
+		// /infra/tier-1s/gw1/segments/seg1
+		return nil, fmt.Errorf("%s is not a valid policy path", path)
+	}
+
+	values := map[string]string{
+		"project_id":        getProjectIDFromResourcePath(path),
+		"domain":            getDomainFromResourcePath(path),
+		"locale_service_id": getResourceIDFromResourcePath(path, "locale-services"),
+		"id":                getPolicyIDFromPath(path),
+		"type":              getPolicyPathType(segments[len(segments)-2]),
+	}
+	if gwID := getResourceIDFromResourcePath(path, "tier-0s"); gwID != "" {
+		values["gateway_id"] = gwID
+		values["gateway_type"] = "tier0"
+	} else if gwID := getResourceIDFromResourcePath(path, "tier-1s"); gwID != "" {
+		values["gateway_id"] = gwID
+		values["gateway_type"] = "tier1"
+	}
+	return values, nil
+}
+
+// Collections whose object type can not be derived from the collection name
+var policyPathCollectionTypes = map[string]string{
+	"tier-0s":             "tier0",
+	"tier-1s":             "tier1",
+	"spoofguard-profiles": "spoof_guard_profile",
+	"dhcp-server-configs": "dhcp_server",
+	"dhcp-relay-configs":  "dhcp_relay",
+}
+
+// getPolicyPathType returns object type for the collection in policy path,
+// i.e. security_policy for security-policies
+func getPolicyPathType(collection string) string {
+	if objType, ok := policyPathCollectionTypes[collection]; ok {
+		return objType
+	}
+	objType := strings.ReplaceAll(collection, "-", "_")
+	if strings.HasSuffix(objType, "ies") {
+		return strings.TrimSuffix(objType, "ies") + "y"
+	}
+	return strings.TrimSuffix(objType, "s")
+}
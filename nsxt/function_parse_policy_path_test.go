@@ -0,0 +1,58 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRunFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData(args)}
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParsePolicyPathFunction(t *testing.T) {
+	cases := []struct {
+		path     string
+		expected map[string]string
+	}{
+		{"/infra/domains/default/groups/g1", map[string]string{"domain": "default", "id": "g1", "type": "group"}},
+		{"/infra/tier-1s/gw1/segments/seg1", map[string]string{"gateway_id": "gw1", "gateway_type": "tier1", "id": "seg1", "type": "segment"}},
+		{"/infra/tier-0s/gw0/locale-services/default/interfaces/if1", map[string]string{"gateway_id": "gw0", "gateway_type": "tier0", "locale_service_id": "default", "id": "if1", "type": "interface"}},
+		{"/orgs/default/projects/dev/infra/domains/default/security-policies/sp1", map[string]string{"project_id": "dev", "domain": "default", "id": "sp1", "type": "security_policy"}},
+		{"/orgs/default/projects/dev", map[string]string{"project_id": "dev", "id": "dev", "type": "project"}},
+		{"/global-infra/tier-0s/gw0", map[string]string{"gateway_id": "gw0", "gateway_type": "tier0", "id": "gw0", "type": "tier0"}},
+		{"/infra/spoofguard-profiles/p1", map[string]string{"id": "p1", "type": "spoof_guard_profile"}},
+	}
+
+	for _, tc := range cases {
+		value, funcErr := testRunFunction(t, newParsePolicyPathFunction(), types.ObjectUnknown(parsedPolicyPathAttrTypes), types.StringValue(tc.path))
+		if funcErr != nil {
+			t.Errorf("Unexpected error for %s: %v", tc.path, funcErr)
+			continue
+		}
+		attrs := value.(types.Object).Attributes()
+		for name := range parsedPolicyPathAttrTypes {
+			if actual := attrs[name].(types.String).ValueString(); actual != tc.expected[name] {
+				t.Errorf("Expected %s %q for %s, got %q", name, tc.expected[name], tc.path, actual)
+			}
+		}
+	}
+}
+
+func TestParsePolicyPathFunctionInvalid(t *testing.T) {
+	for _, path := range []string{"", "group1", "/api/v1/logical-ports/p1", "/infra/tier-1s", "/infra/domains/default/groups/"} {
+		_, funcErr := testRunFunction(t, newParsePolicyPathFunction(), types.ObjectUnknown(parsedPolicyPathAttrTypes), types.StringValue(path))
+		if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+			t.Errorf("Expected argument error for %q, got %v", path, funcErr)
+		}
+	}
+}
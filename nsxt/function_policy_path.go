@@ -0,0 +1,134 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Object types supported by policy_path function, mapped to their collection
+// under infra root
+var policyPathTypes = map[string]string{
+	"tier0":                    "tier-0s",
+	"tier1":                    "tier-1s",
+	"segment":                  "segments",
+	"group":                    "domains/default/groups",
+	"security_policy":          "domains/default/security-policies",
+	"gateway_policy":           "domains/default/gateway-policies",
+	"service":                  "services",
+	"context_profile":          "context-profiles",
+	"ip_pool":                  "ip-pools",
+	"ip_block":                 "ip-blocks",
+	"dhcp_server":              "dhcp-server-configs",
+	"dhcp_relay":               "dhcp-relay-configs",
+	"dns_forwarder_zone":       "dns-forwarder-zones",
+	"qos_profile":              "qos-profiles",
+	"gateway_qos_profile":      "gateway-qos-profiles",
+	"ip_discovery_profile":     "ip-discovery-profiles",
+	"mac_discovery_profile":    "mac-discovery-profiles",
+	"segment_security_profile": "segment-security-profiles",
+	"spoof_guard_profile":      "spoofguard-profiles",
+	"transport_zone":           "sites/default/enforcement-points/default/transport-zones",
+	"edge_cluster":             "sites/default/enforcement-points/default/edge-clusters",
+}
+
+// Object types that only exist under default infra, and can not be built for
+// a project
+var policyPathInfraOnlyTypes = map[string]bool{
+	"transport_zone": true,
+	"edge_cluster":   true,
+}
+
+type policyPathFunction struct{}
+
+var _ function.Function = &policyPathFunction{}
+
+func newPolicyPathFunction() function.Function {
+	return &policyPathFunction{}
+}
+
+func (f *policyPathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_path"
+}
+
+func (f *policyPathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build NSX policy path",
+		Description: "Builds policy path of the object with given type and ID, either under default infra or under a project. Groups and policies are placed in default domain.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "type",
+				Description: fmt.Sprintf("Object type, one of %s, or project", strings.Join(getPolicyPathTypeNames(), ", ")),
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "ID of the object",
+			},
+			function.StringParameter{
+				Name:           "project",
+				Description:    "ID of the project, empty or null for default infra",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *policyPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var objType, id string
+	var projectID *string
+	resp.Error = req.Arguments.Get(ctx, &objType, &id, &projectID)
+	if resp.Error != nil {
+		return
+	}
+
+	project := ""
+	if projectID != nil {
+		project = *projectID
+	}
+	path, funcErr := buildPolicyPath(objType, id, project)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, path)
+}
+
+// buildPolicyPath returns error against the offending function argument
+func buildPolicyPath(objType string, id string, projectID string) (string, *function.FuncError) {
+	if objType != "project" {
+		if _, ok := policyPathTypes[objType]; !ok {
+			return "", function.NewArgumentFuncError(0, fmt.Sprintf("unsupported object type %s, expected one of %s", objType, strings.Join(getPolicyPathTypeNames(), ", ")))
+		}
+	}
+	if id == "" || strings.Contains(id, "/") {
+		return "", function.NewArgumentFuncError(1, fmt.Sprintf("invalid ID %q", id))
+	}
+	if objType == "project" {
+		return fmt.Sprintf("/orgs/%s/projects/%s", defaultOrgID, id), nil
+	}
+
+	root := "/infra"
+	if projectID != "" {
+		if policyPathInfraOnlyTypes[objType] {
+			return "", function.NewArgumentFuncError(2, fmt.Sprintf("object type %s is not supported under a project", objType))
+		}
+		root = fmt.Sprintf("/orgs/%s/projects/%s/infra", defaultOrgID, projectID)
+	}
+	return fmt.Sprintf("%s/%s/%s", root, policyPathTypes[objType], id), nil
+}
+
+func getPolicyPathTypeNames() []string {
+	var names []string
+	for name := range policyPathTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,77 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPolicyPathFunction(t *testing.T) {
+	cases := []struct {
+		objType  string
+		id       string
+		project  types.String
+		expected string
+	}{
+		{"group", "g1", types.StringNull(), "/infra/domains/default/groups/g1"},
+		{"tier1", "gw1", types.StringValue(""), "/infra/tier-1s/gw1"},
+		{"segment", "seg1", types.StringValue("dev"), "/orgs/default/projects/dev/infra/segments/seg1"},
+		{"transport_zone", "tz1", types.StringNull(), "/infra/sites/default/enforcement-points/default/transport-zones/tz1"},
+		{"project", "dev", types.StringNull(), "/orgs/default/projects/dev"},
+	}
+
+	for _, tc := range cases {
+		value, funcErr := testRunFunction(t, newPolicyPathFunction(), types.StringUnknown(), types.StringValue(tc.objType), types.StringValue(tc.id), tc.project)
+		if funcErr != nil {
+			t.Errorf("Unexpected error for %s %s: %v", tc.objType, tc.id, funcErr)
+			continue
+		}
+		if actual := value.(types.String).ValueString(); actual != tc.expected {
+			t.Errorf("Expected path %s, got %s", tc.expected, actual)
+		}
+	}
+
+	errorCases := []struct {
+		objType  string
+		id       string
+		project  types.String
+		argument int64
+	}{
+		{"unknown", "id1", types.StringNull(), 0},
+		{"group", "", types.StringNull(), 1},
+		{"group", "a/b", types.StringNull(), 1},
+		{"transport_zone", "tz1", types.StringValue("dev"), 2},
+		{"edge_cluster", "ec1", types.StringValue("dev"), 2},
+	}
+	for _, tc := range errorCases {
+		_, funcErr := testRunFunction(t, newPolicyPathFunction(), types.StringUnknown(), types.StringValue(tc.objType), types.StringValue(tc.id), tc.project)
+		if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != tc.argument {
+			t.Errorf("Expected error for argument %d for %s %s, got %v", tc.argument, tc.objType, tc.id, funcErr)
+		}
+	}
+}
+
+func TestPolicyPathTypesRoundTrip(t *testing.T) {
+	// Paths built by policy_path are parsed back to the same type and ID
+	for objType := range policyPathTypes {
+		for _, project := range []string{"", "dev"} {
+			if project != "" && policyPathInfraOnlyTypes[objType] {
+				continue
+			}
+			path, funcErr := buildPolicyPath(objType, "obj1", project)
+			if funcErr != nil {
+				t.Fatal(funcErr)
+			}
+			values, err := parsePolicyPath(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if values["type"] != objType || values["id"] != "obj1" || values["project_id"] != project {
+				t.Errorf("Path %s parsed to %v", path, values)
+			}
+		}
+	}
+}
@@ -7,7 +7,6 @@ import (
 
 	nsx_policy "github.com/vmware/terraform-provider-nsxt/api"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -485,6 +484,19 @@ func getRedistributionConfigRuleSchema() *schema.Schema {
 	}
 }
 
+// OSPF redistribution is supported from 3.1.0 onwards
+var policyGatewayOspfRedistributionGate = newAttributeVersionGate("3.1.0", map[string][]string{
+	"nsxt_policy_tier0_gateway": {
+		"redistribution_config.ospf_enabled",
+		"redistribution_config.rule.bgp",
+		"redistribution_config.rule.ospf",
+		"locale_service.redistribution_config.ospf_enabled",
+		"locale_service.redistribution_config.rule.bgp",
+		"locale_service.redistribution_config.rule.ospf",
+	},
+	"nsxt_policy_gateway_redistribution_config": {"ospf_enabled", "rule.bgp", "rule.ospf"},
+})
+
 func getRedistributionConfigSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
@@ -535,7 +547,7 @@ func setLocaleServiceRedistributionRulesConfig(rulesConfig []interface{}, config
 			rule.RouteMapPath = &routeMapPath
 		}
 
-		if util.NsxVersionHigherOrEqual("3.1.0") {
+		if policyGatewayOspfRedistributionGate.isSupported() {
 			if bgp {
 				rule.Destinations = append(rule.Destinations, model.Tier0RouteRedistributionRule_DESTINATIONS_BGP)
 			}
@@ -566,7 +578,7 @@ func setLocaleServiceRedistributionConfig(redistributionConfigs []interface{}, s
 		BgpEnabled: &bgpEnabled,
 	}
 
-	if util.NsxVersionHigherOrEqual("3.1.0") {
+	if policyGatewayOspfRedistributionGate.isSupported() {
 		redistributionStruct.OspfEnabled = &ospfEnabled
 	}
 
@@ -581,7 +593,7 @@ func getLocaleServiceRedistributionRuleConfig(config *model.Tier0RouteRedistribu
 		rule["name"] = ruleConfig.Name
 		rule["route_map_path"] = ruleConfig.RouteMapPath
 		rule["types"] = ruleConfig.RouteRedistributionTypes
-		if util.NsxVersionHigherOrEqual("3.1.0") {
+		if policyGatewayOspfRedistributionGate.isSupported() {
 			bgp := false
 			ospf := false
 			for _, destination := range ruleConfig.Destinations {
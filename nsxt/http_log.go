@@ -0,0 +1,226 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Setting TF_LOG_PROVIDER_NSX_HTTP to this value switches HTTP logging from
+// full request/response dumps to a single JSON line per API call
+const httpLogModeJSON = "json"
+
+const redactedValue = "<redacted>"
+
+// Headers carrying credentials or session tokens
+var sensitiveHeaders = []string{"Authorization", "Csp-Auth-Token", "Cookie", "Set-Cookie", "X-XSRF-TOKEN"}
+
+// Body attributes are redacted if their name, lowercase and stripped of "_"
+// and "-", contains any of these
+var sensitiveKeyFragments = []string{
+	"password",
+	"passwd",
+	"passphrase",
+	"privatekey",
+	"secret",
+	"psk",
+	"presharedkey",
+	"token",
+	"apikey",
+	"sessionid",
+	"credentialkey",
+	"credentialverifier",
+}
+
+// Fallback for bodies that can not be parsed as JSON, i.e. truncated ones
+var sensitiveJSONValueRegexp = regexp.MustCompile(`(?i)("[\w-]*(?:password|passwd|passphrase|private_?key|secret|psk|token|api_?key|session_?id)[\w-]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+var privateKeyPEMRegexp = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+
+func isSensitiveKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if item != nil && isSensitiveKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactJSONValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSONValue(item)
+		}
+	case string:
+		return privateKeyPEMRegexp.ReplaceAllString(v, redactedValue)
+	}
+	return value
+}
+
+// redactBody masks sensitive attributes in JSON or form encoded body
+func redactBody(body []byte, contentType string) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err == nil {
+			for key := range values {
+				if isSensitiveKey(key) {
+					values.Set(key, redactedValue)
+				}
+			}
+			return []byte(values.Encode())
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err == nil {
+		var redacted bytes.Buffer
+		encoder := json.NewEncoder(&redacted)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(redactJSONValue(value)); err == nil {
+			return bytes.TrimSuffix(redacted.Bytes(), []byte("\n"))
+		}
+	}
+
+	redacted := sensitiveJSONValueRegexp.ReplaceAll(body, []byte(`$1"`+redactedValue+`"`))
+	return privateKeyPEMRegexp.ReplaceAll(redacted, []byte(redactedValue))
+}
+
+func redactHeaders(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, redactedValue)
+		}
+	}
+	return redacted
+}
+
+// readBody reads the body and replaces it with a copy, so that it can be
+// consumed again by the SDK
+func readBody(body *io.ReadCloser) []byte {
+	if *body == nil || *body == http.NoBody {
+		return nil
+	}
+	content, err := io.ReadAll(*body)
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(content))
+	if err != nil {
+		log.Printf("[WARNING]: Failed to read HTTP body for logging: %v", err)
+	}
+	return content
+}
+
+type logRequestProcessor struct{}
+
+func newLogRequestProcessor() *logRequestProcessor {
+	return &logRequestProcessor{}
+}
+
+func (processor logRequestProcessor) Process(req *http.Request) error {
+	body := readBody(&req.Body)
+	dumpReq := req.Clone(req.Context())
+	dumpReq.Header = redactHeaders(req.Header)
+	dumpReq.Body = io.NopCloser(bytes.NewReader(body))
+	reqDump, err := httputil.DumpRequestOut(dumpReq, false)
+	if err != nil {
+		log.Printf("[WARNING]: Failed to dump NSX request: %v", err)
+		return nil
+	}
+
+	log.Printf("Issuing request towards NSX:\n%s%s", reqDump, redactBody(body, req.Header.Get("Content-Type")))
+	return nil
+}
+
+type logResponseAcceptor struct{}
+
+func newLogResponseAcceptor() *logResponseAcceptor {
+	return &logResponseAcceptor{}
+}
+
+func (processor logResponseAcceptor) Accept(res *http.Response) {
+	body := readBody(&res.Body)
+	dumpRes := *res
+	dumpRes.Header = redactHeaders(res.Header)
+	dumpRes.Body = nil
+	resDump, err := httputil.DumpResponse(&dumpRes, false)
+	if err != nil {
+		log.Printf("[WARNING]: Failed to dump NSX response: %v", err)
+		return
+	}
+	log.Printf("Received NSX response:\n%s%s", resDump, redactBody(body, res.Header.Get("Content-Type")))
+}
+
+// httpLogEntry is a single line of structured HTTP log
+type httpLogEntry struct {
+	Method       string `json:"method"`
+	URL          string `json:"url"`
+	Status       int    `json:"status"`
+	LatencyMs    int64  `json:"latency_ms"`
+	ErrorCode    int64  `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// structuredLogRoundTripper logs a single JSON line per API call. Latency is
+// measured around the whole call, including session renewal and batching.
+type structuredLogRoundTripper struct {
+	transport http.RoundTripper
+}
+
+func newStructuredLogRoundTripper(transport http.RoundTripper) *structuredLogRoundTripper {
+	return &structuredLogRoundTripper{transport: transport}
+}
+
+func (rt *structuredLogRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := rt.transport.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	entry := httpLogEntry{
+		Method:    req.Method,
+		URL:       req.URL.String(),
+		Status:    res.StatusCode,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		var apiError struct {
+			ErrorCode    int64  `json:"error_code"`
+			ErrorMessage string `json:"error_message"`
+		}
+		if json.Unmarshal(readBody(&res.Body), &apiError) == nil {
+			entry.ErrorCode = apiError.ErrorCode
+			entry.ErrorMessage = apiError.ErrorMessage
+		}
+	}
+
+	if line, err := json.Marshal(entry); err == nil {
+		log.Print(string(line))
+	}
+	return res, nil
+}
@@ -11,9 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
 )
 
 // Helpers for common LB monitor schema settings
@@ -285,19 +288,20 @@ func resourceNsxtPolicyLBMonitorProfileExistsWrapper(id string, connector client
 	return false, logAPIError(msg, err)
 }
 
-func resourceNsxtPolicyLBMonitorProfileDelete(d *schema.ResourceData, m interface{}) error {
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining LBMonitorProfile ID")
-	}
-	connector := getPolicyConnector(m)
+func getPolicyLBMonitorProfile(sessionContext utl.SessionContext, connector client.Connector, id string) (interface{}, error) {
+	client := infra.NewLbMonitorProfilesClient(connector)
+	return client.Get(id)
+}
+
+func patchPolicyLBMonitorProfile(sessionContext utl.SessionContext, connector client.Connector, id string, obj interface{}) error {
+	client := infra.NewLbMonitorProfilesClient(connector)
+	return client.Patch(id, obj.(*data.StructValue))
+}
+
+func deletePolicyLBMonitorProfile(sessionContext utl.SessionContext, connector client.Connector, id string) error {
 	forceParam := true
 	client := infra.NewLbMonitorProfilesClient(connector)
-	err := client.Delete(id, &forceParam)
-	if err != nil {
-		return handleDeleteError("LBMonitorProfile", id, err)
-	}
-	return nil
+	return client.Delete(id, &forceParam)
 }
 
 func getLbServerSslSchema() *schema.Schema {
@@ -5,20 +5,35 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"strings"
 
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 )
 
 // package level logger to include log.Lshortfile context
 var logger = log.New(os.Stderr, "", log.LstdFlags|log.Lshortfile)
 
+// Discriminator field of polymorphic NSX structs
+const polymorphicTypeField = "resource_type"
+
+// Placeholder for sensitive values in logs
+const sensitiveValue = "<sensitive>"
+
 type Metadata struct {
 	// we need a separate schema type, in addition to terraform SDK type,
-	// in order to distinguish between single subclause and a list of entries
-	SchemaType   string
-	ReadOnly     bool
+	// in order to distinguish between single subclause and a list of entries.
+	// Supported types are string, bool, int, float, map, struct, list, set,
+	// and polymorphic-struct, polymorphic-list, polymorphic-set for attributes
+	// that are represented as *data.StructValue in the SDK model
+	SchemaType string
+	ReadOnly   bool
+	// Name of the field in SDK model struct. Attribute that is flattened from
+	// nested struct specifies path to the field, separated by "."
 	SdkFieldName string
 	// This attribute is parent path for the object
 	IsParentPath        bool
@@ -26,7 +41,16 @@ type Metadata struct {
 	// skip handling of this attribute - it will be done manually
 	Skip        bool
 	ReflectType reflect.Type
-	TestData    Testdata
+	// Zero value of string, bool, int or float attribute is not sent to NSX
+	OmitEmpty bool
+	// Allowed values for string attribute, usually taken from SDK model
+	// constants. Validation is added to terraform schema automatically.
+	EnumValues []string
+	// For concrete types of polymorphic attribute - value of resource_type
+	// discriminator, and binding type used to convert to/from *data.StructValue
+	ResourceType string
+	BindingType  bindings.BindingType
+	TestData     Testdata
 }
 
 type ExtendedSchema struct {
@@ -54,28 +78,97 @@ func GetExtendedSchema(sch *schema.Schema) *ExtendedSchema {
 	}
 }
 
+// GetExtendedSchemaForSdkField is a helper to convert terraform sdk schema to
+// extended schema that maps attribute to sdkFieldName of the SDK model struct.
+// Only attributes of primitive types and lists or sets of primitive types are
+// supported.
+func GetExtendedSchemaForSdkField(sch *schema.Schema, sdkFieldName string) *ExtendedSchema {
+	shallowCopy := *sch
+	if elem, ok := sch.Elem.(*schema.Schema); ok && sch.Type != schema.TypeMap {
+		shallowCopy.Elem = &ExtendedSchema{
+			Schema: *elem,
+			Metadata: Metadata{
+				SchemaType: getSchemaTypeName(elem.Type),
+			},
+		}
+	}
+	return &ExtendedSchema{
+		Schema: shallowCopy,
+		Metadata: Metadata{
+			SchemaType:   getSchemaTypeName(sch.Type),
+			SdkFieldName: sdkFieldName,
+		},
+	}
+}
+
+func getSchemaTypeName(valueType schema.ValueType) string {
+	switch valueType {
+	case schema.TypeBool:
+		return "bool"
+	case schema.TypeInt:
+		return "int"
+	case schema.TypeFloat:
+		return "float"
+	case schema.TypeMap:
+		return "map"
+	case schema.TypeList:
+		return "list"
+	case schema.TypeSet:
+		return "set"
+	}
+	return "string"
+}
+
 // GetSchemaFromExtendedSchema gets terraform sdk schema from extended schema definition
 func GetSchemaFromExtendedSchema(ext map[string]*ExtendedSchema) map[string]*schema.Schema {
 	result := make(map[string]*schema.Schema)
 
 	for key, value := range ext {
 		logger.Printf("[TRACE] inspecting schema key %s, value %v", key, value)
-		shallowCopy := value.Schema
-		if (value.Schema.Type == schema.TypeList) || (value.Schema.Type == schema.TypeSet) {
-			elem, ok := shallowCopy.Elem.(*ExtendedSchema)
+		result[key] = getSchemaFromExtendedSchemaItem(value)
+	}
+
+	return result
+}
+
+func getSchemaFromExtendedSchemaItem(value *ExtendedSchema) *schema.Schema {
+	shallowCopy := value.Schema
+	if len(value.Metadata.EnumValues) > 0 && shallowCopy.ValidateFunc == nil {
+		shallowCopy.ValidateFunc = validation.StringInSlice(value.Metadata.EnumValues, false)
+	}
+	if (value.Schema.Type == schema.TypeList) || (value.Schema.Type == schema.TypeSet) || (value.Schema.Type == schema.TypeMap) {
+		elem, ok := shallowCopy.Elem.(*ExtendedSchema)
+		if ok {
+			shallowCopy.Elem = getSchemaFromExtendedSchemaItem(elem)
+		} else {
+			elem, ok := shallowCopy.Elem.(*ExtendedResource)
 			if ok {
-				shallowCopy.Elem = &elem.Schema
-			} else {
-				elem, ok := shallowCopy.Elem.(*ExtendedResource)
-				if ok {
-					shallowCopy.Elem = &schema.Resource{
-						Schema: GetSchemaFromExtendedSchema(elem.Schema),
-					}
+				shallowCopy.Elem = &schema.Resource{
+					Schema: GetSchemaFromExtendedSchema(elem.Schema),
+				}
+			}
+		}
+	}
+	// TODO: deepcopy needed?
+	return &shallowCopy
+}
+
+// GetIntroducedInVersions returns NSX versions that introduced attributes of the
+// extended schema, keyed by attribute path with nested keys separated by "."
+func GetIntroducedInVersions(ext map[string]*ExtendedSchema) map[string]string {
+	result := make(map[string]string)
+
+	for key, value := range ext {
+		if value.Metadata.IntroducedInVersion != "" {
+			result[key] = value.Metadata.IntroducedInVersion
+		}
+		if elem, ok := value.Schema.Elem.(*ExtendedResource); ok {
+			for nestedKey, version := range GetIntroducedInVersions(elem.Schema) {
+				if _, ok := result[key]; !ok {
+					result[key+"."+nestedKey] = version
 				}
 			}
 		}
-		// TODO: deepcopy needed?
-		result[key] = &shallowCopy
 	}
 
 	return result
@@ -89,9 +182,82 @@ func getContextString(prefix, parent string, elemType reflect.Type) string {
 	return fmt.Sprintf("[%s %s]", prefix, ctx)
 }
 
+func getLogValue(item *ExtendedSchema, value interface{}) interface{} {
+	if item.Schema.Sensitive {
+		return sensitiveValue
+	}
+	return value
+}
+
+func getNestedStatePath(statePath, key string) string {
+	if len(statePath) == 0 {
+		return key
+	}
+	return fmt.Sprintf("%s.%s", statePath, key)
+}
+
+func assignSchemaValue(d *schema.ResourceData, parent, key string, parentMap map[string]interface{}, value interface{}) {
+	if len(parent) > 0 {
+		parentMap[key] = value
+	} else {
+		d.Set(key, value)
+	}
+}
+
+// getStructField returns field of the struct by its SDK name. Fields of nested
+// structs are separated by ".". If allocate is set, nil nested structs are
+// allocated, otherwise nil value of the field is returned for them.
+func getStructField(elem reflect.Value, name string, allocate bool) reflect.Value {
+	names := strings.Split(name, ".")
+	for i, fieldName := range names[:len(names)-1] {
+		field := elem.FieldByName(fieldName)
+		if !field.IsValid() || field.Kind() != reflect.Ptr || field.Type().Elem().Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+		if field.IsNil() {
+			if !allocate {
+				return getNilStructField(field.Type().Elem(), names[i+1:])
+			}
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		elem = field.Elem()
+	}
+	return elem.FieldByName(names[len(names)-1])
+}
+
+func getNilStructField(elemType reflect.Type, names []string) reflect.Value {
+	for i, fieldName := range names {
+		field, ok := elemType.FieldByName(fieldName)
+		if !ok {
+			return reflect.Value{}
+		}
+		if i == len(names)-1 {
+			return reflect.Zero(field.Type)
+		}
+		if field.Type.Kind() != reflect.Ptr {
+			return reflect.Value{}
+		}
+		elemType = field.Type.Elem()
+	}
+	return reflect.Value{}
+}
+
 // StructToSchema converts NSX model struct to terraform schema
 // currently supports nested subtype and trivial types
 func StructToSchema(elem reflect.Value, d *schema.ResourceData, metadata map[string]*ExtendedSchema, parent string, parentMap map[string]interface{}) (err error) {
+	statePath := ""
+	if len(parent) > 0 {
+		// state path of nested values is unknown for external callers
+		statePath = "-"
+	}
+	return structToSchema(elem, d, metadata, parent, parentMap, statePath)
+}
+
+// structToSchema converts NSX model struct to terraform schema. statePath is the
+// path of the struct in terraform state, and is used to preserve sensitive values
+// that NSX does not return. Empty statePath denotes the root, and "-" denotes
+// unknown path, i.e. struct within a set.
+func structToSchema(elem reflect.Value, d *schema.ResourceData, metadata map[string]*ExtendedSchema, parent string, parentMap map[string]interface{}, statePath string) (err error) {
 	ctx := getContextString("from", parent, elem.Type())
 	defer func() {
 		if r := recover(); r != nil {
@@ -109,7 +275,8 @@ func StructToSchema(elem reflect.Value, d *schema.ResourceData, metadata map[str
 		if len(parent) > 0 {
 			logger.Printf("[TRACE] %s parent %s key %s", ctx, parent, key)
 		}
-		if !elem.FieldByName(item.Metadata.SdkFieldName).IsValid() {
+		field := getStructField(elem, item.Metadata.SdkFieldName, false)
+		if !field.IsValid() {
 			// FieldByName can't find the field by name
 			logger.Printf("[ERROR] %s skip key %s as %s not found in struct",
 				ctx, key, item.Metadata.SdkFieldName)
@@ -117,69 +284,152 @@ func StructToSchema(elem reflect.Value, d *schema.ResourceData, metadata map[str
 				ctx, key, item.Metadata.SdkFieldName)
 			return
 		}
-		if elem.FieldByName(item.Metadata.SdkFieldName).IsNil() {
+		if item.Schema.Sensitive {
+			// NSX does not return sensitive values, hence the value is preserved
+			// from terraform state
+			if len(parent) == 0 {
+				logger.Printf("[TRACE] %s preserving sensitive key %s", ctx, key)
+				continue
+			}
+			if statePath != "-" {
+				logger.Printf("[TRACE] %s preserving sensitive nested key %s", ctx, key)
+				parentMap[key] = d.Get(getNestedStatePath(statePath, key))
+				continue
+			}
+		}
+		if field.IsNil() {
 			logger.Printf("[TRACE] %s skip key %s with nil value", ctx, key)
+			if len(parent) == 0 {
+				// Clear the value, so that removal on NSX side is detected
+				d.Set(key, nil)
+			}
 			continue
 		}
+		nestedPath := "-"
+		if statePath != "-" {
+			nestedPath = getNestedStatePath(statePath, key)
+		}
 		if item.Metadata.SchemaType == "struct" {
-			nestedObj := elem.FieldByName(item.Metadata.SdkFieldName)
+			nestedObj := field
 			nestedSchema := make(map[string]interface{})
 			childElem := item.Schema.Elem.(*ExtendedResource)
-			if err = StructToSchema(nestedObj.Elem(), d, childElem.Schema, key, nestedSchema); err != nil {
+			if err = structToSchema(nestedObj.Elem(), d, childElem.Schema, key, nestedSchema, getNestedStatePath(nestedPath, "0")); err != nil {
 				return
 			}
 			logger.Printf("[TRACE] %s assigning struct %+v to %s", ctx, nestedObj, key)
 			var nestedSlice []map[string]interface{}
 			nestedSlice = append(nestedSlice, nestedSchema)
-			if len(parent) > 0 {
-				parentMap[key] = nestedSlice
-			} else {
-				d.Set(key, nestedSlice)
+			assignSchemaValue(d, parent, key, parentMap, nestedSlice)
+		} else if item.Metadata.SchemaType == "polymorphic-struct" {
+			structValue := field.Interface().(*data.StructValue)
+			childElem := item.Schema.Elem.(*ExtendedResource)
+			var nestedSchema map[string]interface{}
+			if nestedSchema, err = polymorphicStructToSchema(ctx, d, structValue, childElem.Schema, getNestedStatePath(nestedPath, "0")); err != nil {
+				return
+			}
+			if nestedSchema == nil {
+				continue
+			}
+			logger.Printf("[TRACE] %s assigning polymorphic struct %+v to %s", ctx, nestedSchema, key)
+			assignSchemaValue(d, parent, key, parentMap, []map[string]interface{}{nestedSchema})
+		} else if item.Metadata.SchemaType == "polymorphic-list" || item.Metadata.SchemaType == "polymorphic-set" {
+			structValues := field.Interface().([]*data.StructValue)
+			childElem := item.Schema.Elem.(*ExtendedResource)
+			var nestedSlice []map[string]interface{}
+			for i, structValue := range structValues {
+				itemPath := "-"
+				if item.Metadata.SchemaType == "polymorphic-list" && nestedPath != "-" {
+					itemPath = getNestedStatePath(nestedPath, fmt.Sprintf("%d", i))
+				}
+				var nestedSchema map[string]interface{}
+				if nestedSchema, err = polymorphicStructToSchema(ctx, d, structValue, childElem.Schema, itemPath); err != nil {
+					return
+				}
+				if nestedSchema == nil {
+					continue
+				}
+				nestedSlice = append(nestedSlice, nestedSchema)
+				logger.Printf("[TRACE] %s appending polymorphic slice item %+v to %s", ctx, nestedSchema, key)
 			}
+			assignSchemaValue(d, parent, key, parentMap, nestedSlice)
 		} else if item.Metadata.SchemaType == "list" || item.Metadata.SchemaType == "set" {
 			if _, ok := item.Schema.Elem.(*ExtendedSchema); ok {
-				// List of string, bool, int
-				nestedSlice := elem.FieldByName(item.Metadata.SdkFieldName)
-				logger.Printf("[TRACE] %s assigning slice %v to %s", ctx, nestedSlice.Interface(), key)
-				if len(parent) > 0 {
-					parentMap[key] = nestedSlice.Interface()
-				} else {
-					d.Set(key, nestedSlice.Interface())
-				}
+				// List of string, bool, int, float
+				nestedSlice := field
+				logger.Printf("[TRACE] %s assigning slice %v to %s", ctx, getLogValue(item, nestedSlice.Interface()), key)
+				assignSchemaValue(d, parent, key, parentMap, nestedSlice.Interface())
 			} else if childElem, ok := item.Schema.Elem.(*ExtendedResource); ok {
 				// List of struct
-				sliceElem := elem.FieldByName(item.Metadata.SdkFieldName)
+				sliceElem := field
 				var nestedSlice []map[string]interface{}
 				for i := 0; i < sliceElem.Len(); i++ {
+					itemPath := "-"
+					if item.Metadata.SchemaType == "list" && nestedPath != "-" {
+						itemPath = getNestedStatePath(nestedPath, fmt.Sprintf("%d", i))
+					}
 					nestedSchema := make(map[string]interface{})
-					if err = StructToSchema(sliceElem.Index(i), d, childElem.Schema, key, nestedSchema); err != nil {
+					if err = structToSchema(sliceElem.Index(i), d, childElem.Schema, key, nestedSchema, itemPath); err != nil {
 						return
 					}
 					nestedSlice = append(nestedSlice, nestedSchema)
 					logger.Printf("[TRACE] %s appending slice item %+v to %s", ctx, nestedSchema, key)
 				}
-				if len(parent) > 0 {
-					parentMap[key] = nestedSlice
-				} else {
-					d.Set(key, nestedSlice)
-				}
+				assignSchemaValue(d, parent, key, parentMap, nestedSlice)
 			}
 		} else {
+			value := field.Interface()
 			if len(parent) > 0 {
-				logger.Printf("[TRACE] %s assigning nested value %+v to %s",
-					ctx, elem.FieldByName(item.Metadata.SdkFieldName).Interface(), key)
-				parentMap[key] = elem.FieldByName(item.Metadata.SdkFieldName).Interface()
+				logger.Printf("[TRACE] %s assigning nested value %+v to %s", ctx, getLogValue(item, value), key)
 			} else {
-				logger.Printf("[TRACE] %s assigning value %+v to %s",
-					ctx, elem.FieldByName(item.Metadata.SdkFieldName).Interface(), key)
-				d.Set(key, elem.FieldByName(item.Metadata.SdkFieldName).Interface())
+				logger.Printf("[TRACE] %s assigning value %+v to %s", ctx, getLogValue(item, value), key)
 			}
+			assignSchemaValue(d, parent, key, parentMap, value)
 		}
 	}
 
 	return
 }
 
+// polymorphicStructToSchema converts polymorphic NSX struct to terraform schema
+// of the concrete type, selected by resource_type discriminator. Nil is returned
+// for types that are not defined in schema.
+func polymorphicStructToSchema(ctx string, d *schema.ResourceData, structValue *data.StructValue, typeSchema map[string]*ExtendedSchema, statePath string) (map[string]interface{}, error) {
+	resourceType, err := structValue.String(polymorphicTypeField)
+	if err != nil {
+		return nil, fmt.Errorf("%s failed to get %s of polymorphic struct: %v", ctx, polymorphicTypeField, err)
+	}
+
+	for typeKey, typeItem := range typeSchema {
+		if typeItem.Metadata.ResourceType != resourceType {
+			continue
+		}
+
+		converter := bindings.NewTypeConverter()
+		obj, errs := converter.ConvertToGolang(structValue, typeItem.Metadata.BindingType)
+		if errs != nil {
+			return nil, fmt.Errorf("%s failed to convert %s: %v", ctx, resourceType, errs[0])
+		}
+
+		nestedSchema := make(map[string]interface{})
+		childElem := typeItem.Schema.Elem.(*ExtendedResource)
+		typePath := "-"
+		if statePath != "-" {
+			typePath = getNestedStatePath(statePath, typeKey+".0")
+		}
+		if err := structToSchema(reflect.ValueOf(obj), d, childElem.Schema, typeKey, nestedSchema, typePath); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{typeKey: []interface{}{nestedSchema}}, nil
+	}
+
+	logger.Printf("[WARN] %s skip polymorphic struct of unsupported type %s", ctx, resourceType)
+	return nil, nil
+}
+
+func isPrimitiveSchemaType(schemaType string) bool {
+	return schemaType == "string" || schemaType == "bool" || schemaType == "int" || schemaType == "float"
+}
+
 // SchemaToStruct converts terraform schema to NSX model struct
 // currently supports nested subtype and trivial types
 func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[string]*ExtendedSchema, parent string, parentMap map[string]interface{}) (err error) {
@@ -201,10 +451,11 @@ func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[str
 			continue
 		}
 		if item.Metadata.IntroducedInVersion != "" && util.NsxVersionLower(item.Metadata.IntroducedInVersion) {
-			logger.Printf("[TRACE] %s skip key %s as NSX does not have support", ctx, key)
+			logger.Printf("[WARN] %s skip key %s as NSX does not have support", ctx, key)
 			continue
 		}
-		if !elem.FieldByName(item.Metadata.SdkFieldName).IsValid() {
+		field := getStructField(elem, item.Metadata.SdkFieldName, true)
+		if !field.IsValid() {
 			// FieldByName can't find the field by name
 			logger.Printf("[WARN] %s skip key %s as %s not found in struct",
 				ctx, key, item.Metadata.SdkFieldName)
@@ -217,44 +468,47 @@ func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[str
 		if len(parent) > 0 {
 			logger.Printf("[TRACE] %s parent %s key %s", ctx, parent, key)
 		}
+		var rawValue interface{}
+		if len(parent) > 0 {
+			rawValue = parentMap[key]
+		} else {
+			rawValue = d.Get(key)
+		}
+		if item.Metadata.OmitEmpty && isPrimitiveSchemaType(item.Metadata.SchemaType) && reflect.ValueOf(rawValue).IsZero() {
+			logger.Printf("[TRACE] %s skip key %s with empty value", ctx, key)
+			continue
+		}
 		if item.Metadata.SchemaType == "string" {
-			var value string
-			if len(parent) > 0 {
-				value = parentMap[key].(string)
-			} else {
-				value = d.Get(key).(string)
-			}
-			logger.Printf("[TRACE] %s assigning string %v to %s", ctx, value, key)
-			elem.FieldByName(item.Metadata.SdkFieldName).Set(reflect.ValueOf(&value))
+			value := rawValue.(string)
+			logger.Printf("[TRACE] %s assigning string %v to %s", ctx, getLogValue(item, value), key)
+			field.Set(reflect.ValueOf(&value))
 		}
 		if item.Metadata.SchemaType == "bool" {
-			var value bool
-			if len(parent) > 0 {
-				value = parentMap[key].(bool)
-			} else {
-				value = d.Get(key).(bool)
-			}
-			logger.Printf("[TRACE] %s assigning bool %v to %s", ctx, value, key)
-			elem.FieldByName(item.Metadata.SdkFieldName).Set(reflect.ValueOf(&value))
+			value := rawValue.(bool)
+			logger.Printf("[TRACE] %s assigning bool %v to %s", ctx, getLogValue(item, value), key)
+			field.Set(reflect.ValueOf(&value))
 		}
 		if item.Metadata.SchemaType == "int" {
-			var value int64
-			if len(parent) > 0 {
-				value = int64(parentMap[key].(int))
-			} else {
-				value = int64(d.Get(key).(int))
+			value := int64(rawValue.(int))
+			logger.Printf("[TRACE] %s assigning int %v to %s", ctx, getLogValue(item, value), key)
+			field.Set(reflect.ValueOf(&value))
+		}
+		if item.Metadata.SchemaType == "float" {
+			value := rawValue.(float64)
+			logger.Printf("[TRACE] %s assigning float %v to %s", ctx, getLogValue(item, value), key)
+			field.Set(reflect.ValueOf(&value))
+		}
+		if item.Metadata.SchemaType == "map" {
+			value := make(map[string]string)
+			for k, v := range rawValue.(map[string]interface{}) {
+				value[k] = v.(string)
 			}
-			logger.Printf("[TRACE] %s assigning int %v to %s", ctx, value, key)
-			elem.FieldByName(item.Metadata.SdkFieldName).Set(reflect.ValueOf(&value))
+			logger.Printf("[TRACE] %s assigning map %v to %s", ctx, getLogValue(item, value), key)
+			field.Set(reflect.ValueOf(value))
 		}
 		if item.Metadata.SchemaType == "struct" {
 			nestedObj := reflect.New(item.Metadata.ReflectType)
-			var itemList []interface{}
-			if len(parent) > 0 {
-				itemList = parentMap[key].([]interface{})
-			} else {
-				itemList = d.Get(key).([]interface{})
-			}
+			itemList := rawValue.([]interface{})
 			if len(itemList) == 0 {
 				continue
 			}
@@ -265,27 +519,61 @@ func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[str
 				return
 			}
 			logger.Printf("[TRACE] %s assigning struct %v to %s", ctx, nestedObj, key)
-			elem.FieldByName(item.Metadata.SdkFieldName).Set(nestedObj)
+			field.Set(nestedObj)
+		}
+		if item.Metadata.SchemaType == "polymorphic-struct" {
+			itemList := rawValue.([]interface{})
+			if len(itemList) == 0 || itemList[0] == nil {
+				continue
+			}
+
+			childElem := item.Schema.Elem.(*ExtendedResource)
+			var structValue *data.StructValue
+			if structValue, err = schemaToPolymorphicStruct(ctx, d, childElem.Schema, key, itemList[0].(map[string]interface{})); err != nil {
+				return
+			}
+			if structValue == nil {
+				continue
+			}
+			logger.Printf("[TRACE] %s assigning polymorphic struct %v to %s", ctx, structValue, key)
+			field.Set(reflect.ValueOf(structValue))
+		}
+		if item.Metadata.SchemaType == "polymorphic-list" || item.Metadata.SchemaType == "polymorphic-set" {
+			var itemList []interface{}
+			if item.Metadata.SchemaType == "polymorphic-list" {
+				itemList = rawValue.([]interface{})
+			} else {
+				itemList = rawValue.(*schema.Set).List()
+			}
+
+			childElem := item.Schema.Elem.(*ExtendedResource)
+			structValues := make([]*data.StructValue, 0, len(itemList))
+			for _, childItem := range itemList {
+				if childItem == nil {
+					continue
+				}
+				var structValue *data.StructValue
+				if structValue, err = schemaToPolymorphicStruct(ctx, d, childElem.Schema, key, childItem.(map[string]interface{})); err != nil {
+					return
+				}
+				if structValue != nil {
+					structValues = append(structValues, structValue)
+					logger.Printf("[TRACE] %s appending polymorphic struct %v to %s", ctx, structValue, key)
+				}
+			}
+			field.Set(reflect.ValueOf(structValues))
 		}
 		if item.Metadata.SchemaType == "list" || item.Metadata.SchemaType == "set" {
 			var itemList []interface{}
 			if item.Metadata.SchemaType == "list" {
-				if len(parent) > 0 {
-					itemList = parentMap[key].([]interface{})
-				} else {
-					itemList = d.Get(key).([]interface{})
-				}
+				itemList = rawValue.([]interface{})
 			} else {
-				if len(parent) > 0 {
-					itemList = parentMap[key].(*schema.Set).List()
-				} else {
-					itemList = d.Get(key).(*schema.Set).List()
-				}
+				itemList = rawValue.(*schema.Set).List()
 			}
 
-			// List of string, bool, int
+			// List of string, bool, int, float
 			if childElem, ok := item.Schema.Elem.(*ExtendedSchema); ok {
-				sliceElem := elem.FieldByName(item.Metadata.SdkFieldName)
+				sliceElem := field
 				switch childElem.Metadata.SchemaType {
 				case "string":
 					sliceElem.Set(
@@ -296,6 +584,9 @@ func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[str
 				case "int":
 					sliceElem.Set(
 						reflect.MakeSlice(reflect.TypeOf([]int64{}), len(itemList), len(itemList)))
+				case "float":
+					sliceElem.Set(
+						reflect.MakeSlice(reflect.TypeOf([]float64{}), len(itemList), len(itemList)))
 				}
 
 				for i, v := range itemList {
@@ -304,13 +595,13 @@ func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[str
 					} else {
 						sliceElem.Index(i).Set(reflect.ValueOf(v))
 					}
-					logger.Printf("[TRACE] %s appending %v to %s", ctx, v, key)
+					logger.Printf("[TRACE] %s appending %v to %s", ctx, getLogValue(item, v), key)
 				}
 			}
 
 			// List of struct
 			if childElem, ok := item.Schema.Elem.(*ExtendedResource); ok {
-				sliceElem := elem.FieldByName(item.Metadata.SdkFieldName)
+				sliceElem := field
 				sliceElem.Set(
 					reflect.MakeSlice(reflect.SliceOf(item.Metadata.ReflectType), len(itemList), len(itemList)))
 				for i, childItem := range itemList {
@@ -328,3 +619,46 @@ func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[str
 
 	return
 }
+
+// schemaToPolymorphicStruct converts terraform schema of polymorphic attribute
+// to NSX struct. Schema is expected to specify exactly one of the concrete types.
+func schemaToPolymorphicStruct(ctx string, d *schema.ResourceData, typeSchema map[string]*ExtendedSchema, parent string, parentMap map[string]interface{}) (*data.StructValue, error) {
+	var result *data.StructValue
+	var resultKey string
+	for typeKey, typeItem := range typeSchema {
+		itemList, ok := parentMap[typeKey].([]interface{})
+		if !ok || len(itemList) == 0 || itemList[0] == nil {
+			continue
+		}
+		if result != nil {
+			return nil, fmt.Errorf("%s only one of %s and %s can be specified in %s", ctx, resultKey, typeKey, parent)
+		}
+
+		nestedObj := reflect.New(typeItem.Metadata.ReflectType)
+		childElem := typeItem.Schema.Elem.(*ExtendedResource)
+		if err := SchemaToStruct(nestedObj.Elem(), d, childElem.Schema, typeKey, itemList[0].(map[string]interface{})); err != nil {
+			return nil, err
+		}
+
+		// Set discriminator, which is either string or *string in SDK model
+		resourceType := typeItem.Metadata.ResourceType
+		typeField := nestedObj.Elem().FieldByName("ResourceType")
+		if typeField.IsValid() {
+			if typeField.Kind() == reflect.String {
+				typeField.SetString(resourceType)
+			} else {
+				typeField.Set(reflect.ValueOf(&resourceType))
+			}
+		}
+
+		converter := bindings.NewTypeConverter()
+		dataValue, errs := converter.ConvertToVapi(nestedObj.Elem().Interface(), typeItem.Metadata.BindingType)
+		if errs != nil {
+			return nil, fmt.Errorf("%s failed to convert %s: %v", ctx, resourceType, errs[0])
+		}
+		result = dataValue.(*data.StructValue)
+		resultKey = typeKey
+	}
+
+	return result, nil
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
 type testStruct struct {
@@ -395,6 +398,54 @@ func TestGetSchemaFromExtendedSchema(t *testing.T) {
 	assertSchemaEqual(t, testSchema, obs)
 }
 
+func TestGetIntroducedInVersions(t *testing.T) {
+	ext := map[string]*ExtendedSchema{
+		"string_field": {
+			Schema:   schema.Schema{Type: schema.TypeString},
+			Metadata: Metadata{SchemaType: "string", SdkFieldName: "StringField"},
+		},
+		"bool_field": {
+			Schema:   schema.Schema{Type: schema.TypeBool},
+			Metadata: Metadata{SchemaType: "bool", SdkFieldName: "BoolField", IntroducedInVersion: "3.2.0"},
+		},
+		"struct_field": {
+			Schema: schema.Schema{
+				Type: schema.TypeList,
+				Elem: &ExtendedResource{
+					Schema: map[string]*ExtendedSchema{
+						"int_field": {
+							Schema:   schema.Schema{Type: schema.TypeInt},
+							Metadata: Metadata{SchemaType: "int", SdkFieldName: "IntField", IntroducedInVersion: "4.0.0"},
+						},
+					},
+				},
+			},
+			Metadata: Metadata{SchemaType: "struct", SdkFieldName: "StructField"},
+		},
+		"gated_struct_field": {
+			Schema: schema.Schema{
+				Type: schema.TypeList,
+				Elem: &ExtendedResource{
+					Schema: map[string]*ExtendedSchema{
+						"int_field": {
+							Schema:   schema.Schema{Type: schema.TypeInt},
+							Metadata: Metadata{SchemaType: "int", SdkFieldName: "IntField", IntroducedInVersion: "4.2.0"},
+						},
+					},
+				},
+			},
+			Metadata: Metadata{SchemaType: "struct", SdkFieldName: "GatedStructField", IntroducedInVersion: "4.1.0"},
+		},
+	}
+
+	expected := map[string]string{
+		"bool_field":             "3.2.0",
+		"struct_field.int_field": "4.0.0",
+		"gated_struct_field":     "4.1.0",
+	}
+	assert.Equal(t, expected, GetIntroducedInVersions(ext))
+}
+
 func assertSchemaEqual(t *testing.T, expected, actual map[string]*schema.Schema) {
 	for k, v := range actual {
 		assert.Contains(t, expected, k, "unexpected schema key %s", k)
@@ -717,3 +768,514 @@ func TestSchemaToStructEmptySlice(t *testing.T) {
 		assert.Equal(t, 0, len(obj.StructList))
 	})
 }
+
+type testAdvancedStruct struct {
+	FloatField   *float64
+	FloatList    []float64
+	MapField     map[string]string
+	EnumField    *string
+	SecretField  *string
+	NestedSecret *testSecretStruct
+	Expression   *data.StructValue
+	Expressions  []*data.StructValue
+	Options      *testNestedStruct
+	OmitInt      *int64
+}
+
+type testSecretStruct struct {
+	Username *string
+	Password *string
+}
+
+func conditionTypeSchema() *ExtendedSchema {
+	return &ExtendedSchema{
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &ExtendedResource{
+				Schema: map[string]*ExtendedSchema{
+					"key":         basicStringSchema("Key", false),
+					"member_type": basicStringSchema("MemberType", false),
+					"operator":    basicStringSchema("Operator", false),
+					"value":       basicStringSchema("Value", false),
+				},
+			},
+		},
+		Metadata: Metadata{
+			SchemaType:   "struct",
+			ReflectType:  reflect.TypeOf(model.Condition{}),
+			ResourceType: model.Condition__TYPE_IDENTIFIER,
+			BindingType:  model.ConditionBindingType(),
+		},
+	}
+}
+
+func ipAddressExpressionTypeSchema() *ExtendedSchema {
+	return &ExtendedSchema{
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &ExtendedResource{
+				Schema: map[string]*ExtendedSchema{
+					"ip_addresses": {
+						Schema: schema.Schema{
+							Type: schema.TypeList,
+							Elem: basicStringSchema("IpAddresses", false),
+						},
+						Metadata: Metadata{
+							SchemaType:   "list",
+							SdkFieldName: "IpAddresses",
+						},
+					},
+				},
+			},
+		},
+		Metadata: Metadata{
+			SchemaType:   "struct",
+			ReflectType:  reflect.TypeOf(model.IPAddressExpression{}),
+			ResourceType: model.IPAddressExpression__TYPE_IDENTIFIER,
+			BindingType:  model.IPAddressExpressionBindingType(),
+		},
+	}
+}
+
+func expressionSchema(schemaType string) schema.Schema {
+	schemaTypes := map[string]schema.ValueType{
+		"polymorphic-struct": schema.TypeList,
+		"polymorphic-list":   schema.TypeList,
+		"polymorphic-set":    schema.TypeSet,
+	}
+	maxItems := 0
+	if schemaType == "polymorphic-struct" {
+		maxItems = 1
+	}
+	return schema.Schema{
+		Type:     schemaTypes[schemaType],
+		MaxItems: maxItems,
+		Optional: true,
+		Elem: &ExtendedResource{
+			Schema: map[string]*ExtendedSchema{
+				"condition":            conditionTypeSchema(),
+				"ipaddress_expression": ipAddressExpressionTypeSchema(),
+			},
+		},
+	}
+}
+
+var testAdvancedExtendedSchema = map[string]*ExtendedSchema{
+	"flattened_string": GetExtendedSchemaForSdkField(&schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}, "Options.StringField"),
+	"omit_int": {
+		Schema: schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		Metadata: Metadata{
+			SchemaType:   "int",
+			SdkFieldName: "OmitInt",
+			OmitEmpty:    true,
+		},
+	},
+	"float_field": {
+		Schema: schema.Schema{
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+		Metadata: Metadata{
+			SchemaType:   "float",
+			SdkFieldName: "FloatField",
+		},
+	},
+	"float_list": {
+		Schema: schema.Schema{
+			Type: schema.TypeList,
+			Elem: &ExtendedSchema{
+				Schema:   schema.Schema{Type: schema.TypeFloat},
+				Metadata: Metadata{SchemaType: "float"},
+			},
+			Optional: true,
+		},
+		Metadata: Metadata{
+			SchemaType:   "list",
+			SdkFieldName: "FloatList",
+		},
+	},
+	"map_field": {
+		Schema: schema.Schema{
+			Type:     schema.TypeMap,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+		},
+		Metadata: Metadata{
+			SchemaType:   "map",
+			SdkFieldName: "MapField",
+		},
+	},
+	"enum_field": {
+		Schema: schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		Metadata: Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "EnumField",
+			EnumValues: []string{
+				model.Condition_OPERATOR_EQUALS,
+				model.Condition_OPERATOR_CONTAINS,
+			},
+		},
+	},
+	"secret_field": {
+		Schema: schema.Schema{
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		Metadata: Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "SecretField",
+		},
+	},
+	"nested_secret": {
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &ExtendedResource{
+				Schema: map[string]*ExtendedSchema{
+					"username": basicStringSchema("Username", true),
+					"password": {
+						Schema: schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						Metadata: Metadata{
+							SchemaType:   "string",
+							SdkFieldName: "Password",
+						},
+					},
+				},
+			},
+		},
+		Metadata: Metadata{
+			SchemaType:   "struct",
+			SdkFieldName: "NestedSecret",
+			ReflectType:  reflect.TypeOf(testSecretStruct{}),
+		},
+	},
+	"expression": {
+		Schema: expressionSchema("polymorphic-struct"),
+		Metadata: Metadata{
+			SchemaType:   "polymorphic-struct",
+			SdkFieldName: "Expression",
+		},
+	},
+	"expressions": {
+		Schema: expressionSchema("polymorphic-list"),
+		Metadata: Metadata{
+			SchemaType:   "polymorphic-list",
+			SdkFieldName: "Expressions",
+		},
+	},
+}
+
+func testConditionStructValue(t *testing.T, key, memberType, operator, value string) *data.StructValue {
+	condition := model.Condition{
+		Key:          &key,
+		MemberType:   &memberType,
+		Operator:     &operator,
+		Value:        &value,
+		ResourceType: model.Condition__TYPE_IDENTIFIER,
+	}
+	dataValue, errs := bindings.NewTypeConverter().ConvertToVapi(condition, model.ConditionBindingType())
+	assert.Nil(t, errs, "unexpected error converting condition")
+	return dataValue.(*data.StructValue)
+}
+
+func testIPAddressExpressionStructValue(t *testing.T, addresses ...string) *data.StructValue {
+	expression := model.IPAddressExpression{
+		IpAddresses:  addresses,
+		ResourceType: model.IPAddressExpression__TYPE_IDENTIFIER,
+	}
+	dataValue, errs := bindings.NewTypeConverter().ConvertToVapi(expression, model.IPAddressExpressionBindingType())
+	assert.Nil(t, errs, "unexpected error converting expression")
+	return dataValue.(*data.StructValue)
+}
+
+func testConditionSchema(key, memberType, operator, value string) map[string]interface{} {
+	return map[string]interface{}{
+		"condition": []interface{}{
+			map[string]interface{}{
+				"key":         key,
+				"member_type": memberType,
+				"operator":    operator,
+				"value":       value,
+			},
+		},
+	}
+}
+
+func testIPAddressExpressionSchema(addresses ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"ipaddress_expression": []interface{}{
+			map[string]interface{}{
+				"ip_addresses": addresses,
+			},
+		},
+	}
+}
+
+func TestSchemaToStructAdvancedTypes(t *testing.T) {
+	floatVal := 2.5
+	enumVal := model.Condition_OPERATOR_EQUALS
+	secretVal, username, password := "secret", "admin", "pass"
+	flatVal := "flat"
+	omitVal := int64(5)
+
+	cases := []struct {
+		name        string
+		config      map[string]interface{}
+		expected    testAdvancedStruct
+		expectedErr string
+	}{
+		{
+			name:     "float",
+			config:   map[string]interface{}{"float_field": 2.5, "float_list": []interface{}{1.5, 0.25}},
+			expected: testAdvancedStruct{FloatField: &floatVal, FloatList: []float64{1.5, 0.25}},
+		},
+		{
+			name:     "map",
+			config:   map[string]interface{}{"map_field": map[string]interface{}{"k1": "v1", "k2": "v2"}},
+			expected: testAdvancedStruct{MapField: map[string]string{"k1": "v1", "k2": "v2"}},
+		},
+		{
+			name:     "enum",
+			config:   map[string]interface{}{"enum_field": model.Condition_OPERATOR_EQUALS},
+			expected: testAdvancedStruct{EnumField: &enumVal},
+		},
+		{
+			name: "sensitive",
+			config: map[string]interface{}{
+				"secret_field": "secret",
+				"nested_secret": []interface{}{
+					map[string]interface{}{"username": "admin", "password": "pass"},
+				},
+			},
+			expected: testAdvancedStruct{
+				SecretField:  &secretVal,
+				NestedSecret: &testSecretStruct{Username: &username, Password: &password},
+			},
+		},
+		{
+			name: "polymorphic struct",
+			config: map[string]interface{}{
+				"expression": []interface{}{testConditionSchema("Tag", "VirtualMachine", "EQUALS", "web")},
+			},
+			expected: testAdvancedStruct{
+				Expression: testConditionStructValue(t, "Tag", "VirtualMachine", "EQUALS", "web"),
+			},
+		},
+		{
+			name: "polymorphic list",
+			config: map[string]interface{}{
+				"expressions": []interface{}{
+					testIPAddressExpressionSchema("10.0.0.1", "10.0.0.2"),
+					testConditionSchema("Name", "Segment", "CONTAINS", "app"),
+				},
+			},
+			expected: testAdvancedStruct{
+				Expressions: []*data.StructValue{
+					testIPAddressExpressionStructValue(t, "10.0.0.1", "10.0.0.2"),
+					testConditionStructValue(t, "Name", "Segment", "CONTAINS", "app"),
+				},
+			},
+		},
+		{
+			name: "polymorphic struct with multiple types",
+			config: map[string]interface{}{
+				"expression": []interface{}{
+					map[string]interface{}{
+						"condition":            testConditionSchema("Tag", "VirtualMachine", "EQUALS", "web")["condition"],
+						"ipaddress_expression": testIPAddressExpressionSchema("10.0.0.1")["ipaddress_expression"],
+					},
+				},
+			},
+			expectedErr: "can be specified in expression",
+		},
+		{
+			name:     "flattened",
+			config:   map[string]interface{}{"flattened_string": "flat"},
+			expected: testAdvancedStruct{Options: &testNestedStruct{StringField: &flatVal}},
+		},
+		{
+			name:     "omit empty",
+			config:   map[string]interface{}{"omit_int": 0},
+			expected: testAdvancedStruct{},
+		},
+		{
+			name:     "omit empty with value",
+			config:   map[string]interface{}{"omit_int": 5},
+			expected: testAdvancedStruct{OmitInt: &omitVal},
+		},
+	}
+
+	sch := GetSchemaFromExtendedSchema(testAdvancedExtendedSchema)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, sch, tc.config)
+			obj := testAdvancedStruct{}
+			err := SchemaToStruct(reflect.ValueOf(&obj).Elem(), d, testAdvancedExtendedSchema, "", nil)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err, "unexpected error calling SchemaToStruct")
+
+			if tc.expected.FloatField != nil {
+				assert.Equal(t, tc.expected.FloatField, obj.FloatField)
+				assert.Equal(t, tc.expected.FloatList, obj.FloatList)
+			}
+			if tc.expected.MapField != nil {
+				assert.Equal(t, tc.expected.MapField, obj.MapField)
+			}
+			if tc.expected.EnumField != nil {
+				assert.Equal(t, tc.expected.EnumField, obj.EnumField)
+			}
+			if tc.expected.SecretField != nil {
+				assert.Equal(t, tc.expected.SecretField, obj.SecretField)
+				assert.Equal(t, tc.expected.NestedSecret, obj.NestedSecret)
+			}
+			if tc.expected.Expression != nil {
+				assert.Equal(t, tc.expected.Expression, obj.Expression)
+			}
+			if tc.expected.Expressions != nil {
+				assert.Equal(t, tc.expected.Expressions, obj.Expressions)
+			}
+			if tc.expected.Options != nil {
+				assert.Equal(t, tc.expected.Options, obj.Options)
+			}
+			assert.Equal(t, tc.expected.OmitInt, obj.OmitInt)
+		})
+	}
+}
+
+func TestStructToSchemaAdvancedTypes(t *testing.T) {
+	floatVal := 2.5
+	username := "admin"
+	flatVal := "flat"
+
+	cases := []struct {
+		name     string
+		state    map[string]interface{}
+		obj      testAdvancedStruct
+		expected map[string]interface{}
+	}{
+		{
+			name:     "float",
+			obj:      testAdvancedStruct{FloatField: &floatVal, FloatList: []float64{1.5, 0.25}},
+			expected: map[string]interface{}{"float_field": 2.5, "float_list": []interface{}{1.5, 0.25}},
+		},
+		{
+			name:     "map",
+			obj:      testAdvancedStruct{MapField: map[string]string{"k1": "v1"}},
+			expected: map[string]interface{}{"map_field": map[string]interface{}{"k1": "v1"}},
+		},
+		{
+			name: "sensitive values are preserved",
+			state: map[string]interface{}{
+				"secret_field": "secret",
+				"nested_secret": []interface{}{
+					map[string]interface{}{"username": "user", "password": "pass"},
+				},
+			},
+			obj: testAdvancedStruct{NestedSecret: &testSecretStruct{Username: &username}},
+			expected: map[string]interface{}{
+				"secret_field": "secret",
+				"nested_secret": []interface{}{
+					map[string]interface{}{"username": "admin", "password": "pass"},
+				},
+			},
+		},
+		{
+			name: "polymorphic struct",
+			obj: testAdvancedStruct{
+				Expression: testConditionStructValue(t, "Tag", "VirtualMachine", "EQUALS", "web"),
+			},
+			expected: map[string]interface{}{
+				"expression": []interface{}{
+					map[string]interface{}{
+						"condition":            testConditionSchema("Tag", "VirtualMachine", "EQUALS", "web")["condition"],
+						"ipaddress_expression": []interface{}{},
+					},
+				},
+			},
+		},
+		{
+			name: "polymorphic list",
+			obj: testAdvancedStruct{
+				Expressions: []*data.StructValue{
+					testIPAddressExpressionStructValue(t, "10.0.0.1"),
+					testConditionStructValue(t, "Name", "Segment", "CONTAINS", "app"),
+				},
+			},
+			expected: map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{
+						"condition":            []interface{}{},
+						"ipaddress_expression": testIPAddressExpressionSchema("10.0.0.1")["ipaddress_expression"],
+					},
+					map[string]interface{}{
+						"condition":            testConditionSchema("Name", "Segment", "CONTAINS", "app")["condition"],
+						"ipaddress_expression": []interface{}{},
+					},
+				},
+			},
+		},
+		{
+			name:     "flattened",
+			obj:      testAdvancedStruct{Options: &testNestedStruct{StringField: &flatVal}},
+			expected: map[string]interface{}{"flattened_string": "flat"},
+		},
+		{
+			name:     "flattened with nil parent",
+			state:    map[string]interface{}{"flattened_string": "flat"},
+			obj:      testAdvancedStruct{},
+			expected: map[string]interface{}{"flattened_string": ""},
+		},
+	}
+
+	sch := GetSchemaFromExtendedSchema(testAdvancedExtendedSchema)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, sch, tc.state)
+			err := StructToSchema(reflect.ValueOf(&tc.obj).Elem(), d, testAdvancedExtendedSchema, "", nil)
+			assert.NoError(t, err, "unexpected error calling StructToSchema")
+			for key, value := range tc.expected {
+				assert.Equal(t, value, d.Get(key), "unexpected value for %s", key)
+			}
+		})
+	}
+}
+
+func TestEnumValidation(t *testing.T) {
+	validateFunc := GetSchemaFromExtendedSchema(testAdvancedExtendedSchema)["enum_field"].ValidateFunc
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{model.Condition_OPERATOR_EQUALS, true},
+		{model.Condition_OPERATOR_CONTAINS, true},
+		{"equals", false},
+		{model.Condition_OPERATOR_STARTSWITH, false},
+	}
+
+	for _, tc := range cases {
+		_, errs := validateFunc(tc.value, "enum_field")
+		assert.Equal(t, tc.valid, len(errs) == 0, "unexpected validation result for %s", tc.value)
+	}
+}
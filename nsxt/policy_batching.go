@@ -0,0 +1,401 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Collections of objects that can be batched, mapped to their resource type
+var batchableCollectionTypes = map[string]string{
+	"groups":   "Group",
+	"services": "Service",
+	"rules":    "Rule",
+}
+
+// Collections of parent objects, referenced with ChildResourceReference in
+// the hierarchical API
+var batchParentCollectionTypes = map[string]string{
+	"domains":           "Domain",
+	"tier-0s":           "Tier0",
+	"tier-1s":           "Tier1",
+	"security-policies": "SecurityPolicy",
+	"gateway-policies":  "GatewayPolicy",
+}
+
+var policyAPIPrefixes = []string{"/policy/api/v1", "/global-manager/api/v1"}
+
+// batchItem is a single object PATCH held for the batch
+type batchItem struct {
+	req  *http.Request
+	path string
+	// Policy path of the object, as referenced in NSX errors
+	policyPath string
+	body       map[string]interface{}
+	res        *http.Response
+	err        error
+}
+
+// policyBatch collects PATCH requests under the same infra root
+type policyBatch struct {
+	key   string
+	items []*batchItem
+	paths map[string]bool
+	done  chan struct{}
+	timer *time.Timer
+}
+
+// policyBatchingRoundTripper holds PATCH requests of groups, services and
+// rules for a short window, and sends them to NSX as a single hierarchical API
+// call. The call is transactional, hence either all objects in the batch are
+// applied, or none of them. Objects referenced in the error of failed call
+// receive the error, while the rest of the batch is failed as not applied.
+// Requests that carry _revision are sent individually, since revision check
+// can only be enforced for the whole hierarchical API call.
+type policyBatchingRoundTripper struct {
+	window     time.Duration
+	maxObjects int
+	transport  http.RoundTripper
+
+	mutex   sync.Mutex
+	pending map[string]*policyBatch
+}
+
+func newPolicyBatchingRoundTripper(window time.Duration, maxObjects int, transport http.RoundTripper) *policyBatchingRoundTripper {
+	return &policyBatchingRoundTripper{
+		window:     window,
+		maxObjects: maxObjects,
+		transport:  transport,
+		pending:    make(map[string]*policyBatch),
+	}
+}
+
+// parseBatchablePath splits object URL into infra root, i.e. /policy/api/v1/infra,
+// and object path relative to it, i.e. domains/default/groups/g1
+func parseBatchablePath(req *http.Request) (string, []string, bool) {
+	if req.Method != http.MethodPatch || req.URL.RawQuery != "" {
+		return "", nil, false
+	}
+	for _, prefix := range policyAPIPrefixes {
+		path := strings.TrimPrefix(req.URL.Path, prefix)
+		if path == req.URL.Path {
+			continue
+		}
+		segments := strings.Split(strings.Trim(path, "/"), "/")
+		rootLen := 1
+		if segments[0] == "orgs" {
+			// Multitenancy root - orgs/<org>/projects/<project>/infra
+			rootLen = 5
+		}
+		if len(segments) <= rootLen || !isPolicyInfraPath("/"+strings.Join(segments[:rootLen], "/")) {
+			return "", nil, false
+		}
+		relative := segments[rootLen:]
+		if len(relative)%2 != 0 {
+			return "", nil, false
+		}
+		if _, ok := batchableCollectionTypes[relative[len(relative)-2]]; !ok {
+			return "", nil, false
+		}
+		for i := 0; i < len(relative)-2; i += 2 {
+			if _, ok := batchParentCollectionTypes[relative[i]]; !ok {
+				return "", nil, false
+			}
+		}
+		root := prefix + "/" + strings.Join(segments[:rootLen], "/")
+		return root, relative, true
+	}
+	return "", nil, false
+}
+
+func (rt *policyBatchingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	root, relative, ok := parseBatchablePath(req)
+	if !ok {
+		return rt.transport.RoundTrip(req)
+	}
+
+	var body map[string]interface{}
+	content := readBody(&req.Body)
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil || body == nil {
+		return rt.transport.RoundTrip(req)
+	}
+	if _, ok := body["_revision"]; ok {
+		// Revision check of a single object should not affect the batch
+		return rt.transport.RoundTrip(req)
+	}
+
+	relativePath := strings.Join(relative, "/")
+	item := &batchItem{
+		req:        req,
+		path:       relativePath,
+		policyPath: root[strings.Index(root, "/api/v1")+len("/api/v1"):] + "/" + relativePath,
+		body:       body,
+	}
+	batch := rt.add(req.URL.Scheme+"://"+req.URL.Host+root, item)
+
+	select {
+	case <-batch.done:
+		return item.res, item.err
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
+
+// add queues the item into pending batch for the root, and flushes the batch
+// once it is full
+func (rt *policyBatchingRoundTripper) add(key string, item *batchItem) *policyBatch {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	batch := rt.pending[key]
+	if batch != nil && batch.paths[item.path] {
+		// Same object can be written once per batch
+		rt.flushLocked(batch)
+		batch = nil
+	}
+	if batch == nil {
+		batch = &policyBatch{
+			key:   key,
+			paths: make(map[string]bool),
+			done:  make(chan struct{}),
+		}
+		rt.pending[key] = batch
+		batch.timer = time.AfterFunc(rt.window, func() {
+			rt.mutex.Lock()
+			defer rt.mutex.Unlock()
+			rt.flushLocked(batch)
+		})
+	}
+
+	batch.items = append(batch.items, item)
+	batch.paths[item.path] = true
+	if len(batch.items) >= rt.maxObjects {
+		rt.flushLocked(batch)
+	}
+	return batch
+}
+
+func (rt *policyBatchingRoundTripper) flushLocked(batch *policyBatch) {
+	if rt.pending[batch.key] != batch {
+		// Already flushed
+		return
+	}
+	delete(rt.pending, batch.key)
+	batch.timer.Stop()
+	go rt.send(batch)
+}
+
+// send issues hierarchical API call for the batch, and distributes the result
+// to the original requests
+func (rt *policyBatchingRoundTripper) send(batch *policyBatch) {
+	defer close(batch.done)
+
+	var items []*batchItem
+	for _, item := range batch.items {
+		if item.req.Context().Err() == nil {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	req, err := newBatchRequest(batch.key, items)
+	if err != nil {
+		for _, item := range items {
+			item.err = err
+		}
+		return
+	}
+
+	paths := make([]string, 0, len(items))
+	for _, item := range items {
+		paths = append(paths, item.path)
+	}
+	log.Printf("[INFO]: Sending %d objects in a single hierarchical API call: %s", len(items), strings.Join(paths, ", "))
+
+	res, err := rt.transport.RoundTrip(req)
+	if err != nil {
+		for _, item := range items {
+			item.err = err
+		}
+		return
+	}
+	content := readBody(&res.Body)
+	res.Body.Close()
+
+	var failed map[*batchItem]bool
+	var dependencyError []byte
+	if res.StatusCode >= http.StatusBadRequest {
+		log.Printf("[ERROR]: Hierarchical API call for %d objects failed with status %d, none of the objects were applied", len(items), res.StatusCode)
+		failed = getFailedBatchItems(items, content)
+		if len(failed) > 0 {
+			dependencyError = newBatchDependencyError(failed, content)
+		}
+	}
+	for _, item := range items {
+		itemRes := &http.Response{
+			Status:        res.Status,
+			StatusCode:    res.StatusCode,
+			Proto:         res.Proto,
+			ProtoMajor:    res.ProtoMajor,
+			ProtoMinor:    res.ProtoMinor,
+			Header:        res.Header.Clone(),
+			Body:          http.NoBody,
+			ContentLength: 0,
+			Request:       item.req,
+		}
+		if res.StatusCode >= http.StatusBadRequest {
+			itemContent := content
+			if len(failed) > 0 && !failed[item] {
+				// Object was valid, but was not applied due to failure of
+				// other objects in the batch
+				itemRes.Status = fmt.Sprintf("%d %s", http.StatusFailedDependency, http.StatusText(http.StatusFailedDependency))
+				itemRes.StatusCode = http.StatusFailedDependency
+				itemContent = dependencyError
+			}
+			itemRes.Body = io.NopCloser(bytes.NewReader(itemContent))
+			itemRes.ContentLength = int64(len(itemContent))
+		}
+		item.res = itemRes
+	}
+}
+
+// getFailedBatchItems returns items referenced in error of the hierarchical
+// API call. If none is referenced, the error can not be attributed, and
+// no item is returned.
+func getFailedBatchItems(items []*batchItem, content []byte) map[*batchItem]bool {
+	message := string(content)
+	failed := make(map[*batchItem]bool)
+	for _, item := range items {
+		for start := 0; start < len(message); {
+			index := strings.Index(message[start:], item.policyPath)
+			if index < 0 {
+				break
+			}
+			end := start + index + len(item.policyPath)
+			// Make sure the path is not a prefix of another path or ID
+			if end == len(message) || !isBatchPathChar(message[end]) {
+				failed[item] = true
+				break
+			}
+			start = end
+		}
+	}
+	return failed
+}
+
+func isBatchPathChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || strings.IndexByte("_.-/", c) >= 0
+}
+
+// newBatchDependencyError builds API error for items of the batch that were
+// not applied since other items failed
+func newBatchDependencyError(failed map[*batchItem]bool, content []byte) []byte {
+	paths := make([]string, 0, len(failed))
+	for item := range failed {
+		paths = append(paths, item.policyPath)
+	}
+	sort.Strings(paths)
+
+	var original map[string]interface{}
+	details := string(content)
+	if err := json.Unmarshal(content, &original); err == nil {
+		if message, ok := original["error_message"].(string); ok {
+			details = message
+		}
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"httpStatus":    "FAILED_DEPENDENCY",
+		"error_code":    original["error_code"],
+		"module_name":   original["module_name"],
+		"error_message": fmt.Sprintf("Object was not applied, since hierarchical API call failed for %s", strings.Join(paths, ", ")),
+		"details":       details,
+	})
+	return body
+}
+
+// newBatchRequest builds hierarchical API PATCH of the infra root, where
+// parents of batched objects are wrapped as ChildResourceReference
+func newBatchRequest(url string, items []*batchItem) (*http.Request, error) {
+	root := map[string]interface{}{"resource_type": "Infra"}
+	nodes := map[string]map[string]interface{}{"": root}
+
+	// Parents first, so that objects nested in other batched objects are
+	// placed under them
+	sort.SliceStable(items, func(i, j int) bool {
+		return strings.Count(items[i].path, "/") < strings.Count(items[j].path, "/")
+	})
+
+	var getNode func(path string) map[string]interface{}
+	getNode = func(path string) map[string]interface{} {
+		if node, ok := nodes[path]; ok {
+			return node
+		}
+		segments := strings.Split(path, "/")
+		collection, id := segments[len(segments)-2], segments[len(segments)-1]
+		parent := getNode(strings.Join(segments[:len(segments)-2], "/"))
+		node := map[string]interface{}{
+			"resource_type": "ChildResourceReference",
+			"id":            id,
+			"target_type":   batchParentCollectionTypes[collection],
+		}
+		appendBatchChild(parent, node)
+		nodes[path] = node
+		return node
+	}
+
+	for _, item := range items {
+		segments := strings.Split(item.path, "/")
+		collection, id := segments[len(segments)-2], segments[len(segments)-1]
+		resourceType := batchableCollectionTypes[collection]
+
+		obj := item.body
+		obj["id"] = id
+		if _, ok := obj["resource_type"]; !ok {
+			obj["resource_type"] = resourceType
+		}
+		parent := getNode(strings.Join(segments[:len(segments)-2], "/"))
+		appendBatchChild(parent, map[string]interface{}{
+			"resource_type": "Child" + resourceType,
+			resourceType:    obj,
+		})
+		nodes[item.path] = obj
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(root); err != nil {
+		return nil, fmt.Errorf("failed to encode hierarchical API request: %v", err)
+	}
+
+	first := items[0].req
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPatch, url, &body)
+	if err != nil {
+		return nil, err
+	}
+	// Authorization and session headers are already set on batched requests
+	req.Header = first.Header.Clone()
+	req.Header.Del("Content-Length")
+	req.ContentLength = int64(body.Len())
+	return req, nil
+}
+
+func appendBatchChild(parent map[string]interface{}, child map[string]interface{}) {
+	children, _ := parent["children"].([]interface{})
+	parent["children"] = append(children, child)
+}
@@ -0,0 +1,221 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestParseBatchablePath(t *testing.T) {
+	cases := []struct {
+		method   string
+		url      string
+		root     string
+		relative string
+	}{
+		{http.MethodPatch, "https://nsx/policy/api/v1/infra/domains/default/groups/g1", "/policy/api/v1/infra", "domains/default/groups/g1"},
+		{http.MethodPatch, "https://nsx/policy/api/v1/infra/services/s1", "/policy/api/v1/infra", "services/s1"},
+		{http.MethodPatch, "https://nsx/policy/api/v1/orgs/default/projects/p1/infra/domains/default/security-policies/sp/rules/r1", "/policy/api/v1/orgs/default/projects/p1/infra", "domains/default/security-policies/sp/rules/r1"},
+		{http.MethodPatch, "https://nsx/global-manager/api/v1/global-infra/services/s1", "/global-manager/api/v1/global-infra", "services/s1"},
+		// Not batched
+		{http.MethodPut, "https://nsx/policy/api/v1/infra/domains/default/groups/g1", "", ""},
+		{http.MethodPatch, "https://nsx/policy/api/v1/infra/domains/default/groups/g1?force=true", "", ""},
+		{http.MethodPatch, "https://nsx/policy/api/v1/infra/tier-1s/t1", "", ""},
+		{http.MethodPatch, "https://nsx/policy/api/v1/infra", "", ""},
+		{http.MethodPatch, "https://nsx/policy/api/v1/infra/ip-pools/p1/ip-subnets/s1", "", ""},
+		{http.MethodPatch, "https://nsx/policy/api/v1/infra/tier-1s/t1/segments/s1", "", ""},
+		{http.MethodPatch, "https://nsx/api/v1/logical-switches/ls1", "", ""},
+	}
+
+	for _, tc := range cases {
+		req, _ := http.NewRequest(tc.method, tc.url, nil)
+		root, relative, ok := parseBatchablePath(req)
+		if ok != (tc.root != "") || root != tc.root || strings.Join(relative, "/") != tc.relative {
+			t.Errorf("Unexpected result for %s %s: %v %s %v", tc.method, tc.url, ok, root, relative)
+		}
+	}
+}
+
+func TestNewBatchRequest(t *testing.T) {
+	newItem := func(path string, body map[string]interface{}) *batchItem {
+		req, _ := http.NewRequest(http.MethodPatch, "https://nsx/policy/api/v1/infra/"+path, nil)
+		req.Header.Set("Authorization", "Basic token")
+		return &batchItem{req: req, path: path, body: body}
+	}
+	items := []*batchItem{
+		newItem("domains/default/security-policies/sp/rules/r1", map[string]interface{}{"action": "ALLOW"}),
+		newItem("domains/default/groups/g1", map[string]interface{}{"display_name": "g1"}),
+		newItem("domains/default/groups/g2", map[string]interface{}{"display_name": "g2", "_revision": 3}),
+		newItem("services/s1", map[string]interface{}{"display_name": "s1"}),
+	}
+
+	req, err := newBatchRequest("https://nsx/policy/api/v1/infra", items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != http.MethodPatch || req.URL.Path != "/policy/api/v1/infra" || req.URL.RawQuery != "" {
+		t.Errorf("Unexpected batch request %s %s", req.Method, req.URL)
+	}
+	if req.Header.Get("Authorization") != "Basic token" {
+		t.Errorf("Expected headers of batched request to be preserved")
+	}
+
+	body := string(readBody(&req.Body))
+	for _, expected := range []string{
+		`"resource_type":"Infra"`,
+		`"resource_type":"ChildResourceReference","target_type":"Domain"`,
+		`"resource_type":"ChildResourceReference","target_type":"SecurityPolicy"`,
+		`"resource_type":"ChildGroup"`,
+		`"resource_type":"ChildRule"`,
+		`"resource_type":"ChildService"`,
+		`"id":"g2"`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected %s in batch body %s", expected, body)
+		}
+	}
+	// Domain is referenced once for all of its children
+	if count := strings.Count(body, `"target_type":"Domain"`); count != 1 {
+		t.Errorf("Expected single reference to domain, got %d in %s", count, body)
+	}
+}
+
+func TestProviderPolicyBatching(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	provider := testConfigureSimulatorProvider(t, sim, map[string]interface{}{
+		"policy_batching": []interface{}{map[string]interface{}{"window_ms": 300, "max_objects": 100}},
+	})
+	res := provider.ResourcesMap["nsxt_policy_group"]
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+				"nsx_id":       fmt.Sprintf("batched-%d", i),
+				"display_name": fmt.Sprintf("batched group %d", i),
+			})
+			if diags := testResourceCreate(res, d, provider.Meta()); diags.HasError() {
+				errs <- fmt.Errorf("failed to create group %d: %v", i, diags)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	for i := 0; i < 20; i++ {
+		obj, ok := sim.Get(fmt.Sprintf("/infra/domains/default/groups/batched-%d", i))
+		if !ok || obj["display_name"] != fmt.Sprintf("batched group %d", i) {
+			t.Errorf("Expected group batched-%d to be created, got %v", i, obj)
+		}
+	}
+	if patches := sim.Requests(http.MethodPatch); patches > 3 {
+		t.Errorf("Expected groups to be created with few hierarchical API calls, got %d PATCH requests", patches)
+	}
+}
+
+func TestProviderPolicyBatchingRevision(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+	// Second write bumps revision of the group, so that update with revision 0 is rejected
+	sim.Put("/infra/domains/default/groups/existing", simulator.Object{"display_name": "existing"})
+	sim.Put("/infra/domains/default/groups/existing", simulator.Object{"display_name": "existing"})
+
+	provider := testConfigureSimulatorProvider(t, sim, map[string]interface{}{
+		"policy_batching": []interface{}{map[string]interface{}{"window_ms": 300}},
+	})
+	connector := getPolicyConnector(provider.Meta())
+
+	var wg sync.WaitGroup
+	var staleErr, newErr, serviceErr error
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		revision := int64(0)
+		name := "stale"
+		staleErr = domains.NewGroupsClient(connector).Patch("default", "existing", model.Group{DisplayName: &name, Revision: &revision})
+	}()
+	go func() {
+		defer wg.Done()
+		name := "new"
+		newErr = domains.NewGroupsClient(connector).Patch("default", "new", model.Group{DisplayName: &name})
+	}()
+	go func() {
+		defer wg.Done()
+		name := "svc"
+		serviceErr = infra.NewServicesClient(connector).Patch("svc", model.Service{DisplayName: &name})
+	}()
+	wg.Wait()
+
+	if staleErr == nil {
+		t.Errorf("Expected stale revision to be rejected")
+	}
+	if newErr != nil || serviceErr != nil {
+		t.Errorf("Expected objects without revision not to be affected by stale revision, got %v, %v", newErr, serviceErr)
+	}
+	if _, ok := sim.Get("/infra/domains/default/groups/new"); !ok {
+		t.Errorf("Expected batched group to be applied")
+	}
+	if _, ok := sim.Get("/infra/services/svc"); !ok {
+		t.Errorf("Expected batched service to be applied")
+	}
+}
+
+func TestProviderPolicyBatchingFailure(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+	sim.Reject("/infra/domains/default/groups/bad")
+
+	provider := testConfigureSimulatorProvider(t, sim, map[string]interface{}{
+		"policy_batching": []interface{}{map[string]interface{}{"window_ms": 300}},
+	})
+	connector := getPolicyConnector(provider.Meta())
+
+	var wg sync.WaitGroup
+	errs := make(map[string]error)
+	var mutex sync.Mutex
+	for _, id := range []string{"bad", "b", "bad-2"} {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			err := domains.NewGroupsClient(connector).Patch("default", id, model.Group{DisplayName: &id})
+			mutex.Lock()
+			defer mutex.Unlock()
+			errs[id] = err
+		}(id)
+	}
+	wg.Wait()
+
+	if err := errs["bad"]; err == nil || !strings.Contains(logAPIError("error", err).Error(), "Configuration of object /infra/domains/default/groups/bad is invalid") {
+		t.Errorf("Expected rejected object to report the original error, got %v", err)
+	}
+	for _, id := range []string{"b", "bad-2"} {
+		err := errs[id]
+		if err == nil || !strings.Contains(logAPIError("error", err).Error(), "was not applied, since hierarchical API call failed for /infra/domains/default/groups/bad") {
+			t.Errorf("Expected group %s to report failure of other object, got %v", id, err)
+			continue
+		}
+		if _, ok := sim.Get("/infra/domains/default/groups/" + id); ok {
+			t.Errorf("Expected no object of failed batch to be applied")
+		}
+	}
+}
@@ -320,6 +320,32 @@ func getPolicyGatewayPolicySchema() map[string]*schema.Schema {
 	return secPolicy
 }
 
+// Current schema version of security and gateway policy resources
+const policySecurityPolicySchemaVersion = 1
+
+// getPolicySecurityPolicyStateUpgraders returns state upgraders for security
+// or gateway policy resource with given schema
+func getPolicySecurityPolicyStateUpgraders(policySchema map[string]*schema.Schema) []schema.StateUpgrader {
+	return []schema.StateUpgrader{
+		getPolicyStateUpgrader(0, policySchema, policySchema, policySecurityPolicyStateUpgradeV0),
+	}
+}
+
+// Rules in state of version 0 might lack nsx_id, which is needed in order to
+// delete rules that are removed from configuration. The ID is derived from
+// rule path.
+func policySecurityPolicyStateUpgradeV0(rawState map[string]interface{}) error {
+	for _, rule := range getStateBlocks(rawState, "rule") {
+		if nsxID, ok := rule["nsx_id"].(string); ok && nsxID != "" {
+			continue
+		}
+		if nsxID := getStateIDFromPath(rule); nsxID != "" {
+			rule["nsx_id"] = nsxID
+		}
+	}
+	return nil
+}
+
 func getPolicySecurityPolicySchema(isIds, withContext, withRule bool) map[string]*schema.Schema {
 	result := map[string]*schema.Schema{
 		"nsx_id":       getNsxIDSchema(),
@@ -556,6 +582,35 @@ func getDataSourceIDSchema() *schema.Schema {
 	return getDataSourceStringSchema("Unique ID of this resource")
 }
 
+func getDataSourceTagFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Tags that object should carry, empty scope or tag matches any value",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func getDataSourceExactMatchSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Match display name exactly, rather than as prefix",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
 func parseGatewayPolicyPath(gwPath string) (bool, string) {
 	// sample path looks like "/infra/tier-0s/mytier0gw"
 	// Or "/global-infra/tier-0s/mytier0gw" in Global Manager
@@ -0,0 +1,288 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+)
+
+// Policy paths referenced in NSX error messages
+var apiErrorPolicyPathRegexp = regexp.MustCompile(`/(?:infra|global-infra|orgs)/[^\s,;'"\[\]()]+`)
+
+// Attributes identifying an element of nested block list, such as a rule
+var apiErrorBlockIDAttributes = []string{"nsx_id", "path", "display_name"}
+
+type apiErrorDetails struct {
+	code      *int64
+	message   *string
+	details   *string
+	errorData *data.StructValue
+}
+
+// getAPIErrorDiagnostics converts error into diagnostics. NSX ApiError is split
+// into summary and detail, each of related errors is reported as separate
+// diagnostic, and when schema and resource data are provided, the diagnostic
+// points to the configuration attribute NSX complained about.
+func getAPIErrorDiagnostics(s map[string]*schema.Schema, d *schema.ResourceData, err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	var policyErr *policyAPIError
+	if !errors.As(err, &policyErr) {
+		return diag.FromErr(err)
+	}
+
+	apiError := policyErr.apiError
+	main := apiErrorDetails{
+		code:      apiError.ErrorCode,
+		message:   apiError.ErrorMessage,
+		details:   apiError.Details,
+		errorData: apiError.ErrorData,
+	}
+	diags := diag.Diagnostics{getAPIErrorDiagnostic(s, d, policyErr.message, main, "")}
+
+	for _, relatedErr := range apiError.RelatedErrors {
+		related := apiErrorDetails{
+			code:      relatedErr.ErrorCode,
+			message:   relatedErr.ErrorMessage,
+			details:   relatedErr.Details,
+			errorData: relatedErr.ErrorData,
+		}
+		relatedTo := "Related to the error above"
+		if apiError.ErrorCode != nil {
+			relatedTo = fmt.Sprintf("Related to NSX error code %d", *apiError.ErrorCode)
+		}
+		diags = append(diags, getAPIErrorDiagnostic(s, d, policyErr.message, related, relatedTo))
+	}
+	return diags
+}
+
+func getAPIErrorDiagnostic(s map[string]*schema.Schema, d *schema.ResourceData, message string, apiError apiErrorDetails, relatedTo string) diag.Diagnostic {
+	summary := message
+	if apiError.message != nil && *apiError.message != "" {
+		summary = fmt.Sprintf("%s: %s", message, *apiError.message)
+	}
+
+	var details []string
+	if apiError.code != nil {
+		details = append(details, fmt.Sprintf("NSX error code %d", *apiError.code))
+	}
+	if relatedTo != "" {
+		details = append(details, relatedTo)
+	}
+	if apiError.details != nil && *apiError.details != "" {
+		details = append(details, *apiError.details)
+	}
+
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   strings.Join(details, "\n"),
+	}
+	if s != nil && d != nil {
+		diagnostic.AttributePath = getAPIErrorAttributePath(s, d, apiError)
+	}
+	return diagnostic
+}
+
+// getAPIErrorAttributePath returns path of the attribute the error relates
+// to, by looking for policy paths referenced in error message and for values
+// of error_data in the configuration
+func getAPIErrorAttributePath(s map[string]*schema.Schema, d *schema.ResourceData, apiError apiErrorDetails) cty.Path {
+	var candidates []string
+	if apiError.message != nil {
+		candidates = append(candidates, apiErrorPolicyPathRegexp.FindAllString(*apiError.message, -1)...)
+	}
+	candidates = append(candidates, getJSONStringValues(getAPIErrorDataMap(apiError.errorData))...)
+
+	var best cty.Path
+	for _, candidate := range candidates {
+		if path := findAttributeWithValue(s, d, candidate); len(path) > len(best) {
+			best = path
+		}
+	}
+	return best
+}
+
+func getAPIErrorDataMap(errorData *data.StructValue) map[string]interface{} {
+	if errorData == nil {
+		return nil
+	}
+	encoded, err := cleanjson.NewDataValueToJsonEncoder().Encode(errorData)
+	if err != nil {
+		return nil
+	}
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(encoded), &value); err != nil {
+		return nil
+	}
+	return value
+}
+
+func getJSONStringValues(value interface{}) []string {
+	var values []string
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			values = append(values, getJSONStringValues(v[key])...)
+		}
+	case []interface{}:
+		for _, item := range v {
+			values = append(values, getJSONStringValues(item)...)
+		}
+	case string:
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// findAttributeWithValue returns path of the deepest configurable attribute
+// that holds the value, or of nested block element identified by the value
+func findAttributeWithValue(s map[string]*schema.Schema, d *schema.ResourceData, value string) cty.Path {
+	var best cty.Path
+	for _, key := range getSortedSchemaKeys(s) {
+		if !s[key].Optional && !s[key].Required {
+			continue
+		}
+		path := findSchemaValue(s[key], d.Get(key), value, cty.GetAttrPath(key))
+		if len(path) > len(best) {
+			best = path
+		}
+	}
+	return best
+}
+
+func findSchemaValue(sch *schema.Schema, current interface{}, value string, path cty.Path) cty.Path {
+	switch sch.Type {
+	case schema.TypeString:
+		if current == value {
+			return path
+		}
+	case schema.TypeList, schema.TypeSet:
+		var items []interface{}
+		if sch.Type == schema.TypeSet {
+			if set, ok := current.(*schema.Set); ok {
+				items = set.List()
+			}
+		} else {
+			items, _ = current.([]interface{})
+		}
+
+		var best cty.Path
+		for i, item := range items {
+			// Elements of a set can not be addressed, hence the set itself is reported
+			itemPath := path
+			if sch.Type == schema.TypeList {
+				itemPath = path.Copy().IndexInt(i)
+			}
+
+			var found cty.Path
+			switch elem := sch.Elem.(type) {
+			case *schema.Schema:
+				found = findSchemaValue(elem, item, value, itemPath)
+			case *schema.Resource:
+				found = findBlockValue(elem.Schema, item, value, itemPath)
+			}
+			if found != nil && sch.Type == schema.TypeSet {
+				found = path
+			}
+			if len(found) > len(best) {
+				best = found
+			}
+		}
+		return best
+	}
+	return nil
+}
+
+func findBlockValue(s map[string]*schema.Schema, current interface{}, value string, path cty.Path) cty.Path {
+	block, ok := current.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var best cty.Path
+	if isBlockIdentifiedBy(block, value) {
+		best = path
+	}
+	for _, key := range getSortedSchemaKeys(s) {
+		if !s[key].Optional && !s[key].Required || isBlockIDAttribute(key) {
+			continue
+		}
+		found := findSchemaValue(s[key], block[key], value, path.Copy().GetAttr(key))
+		if len(found) > len(best) {
+			best = found
+		}
+	}
+	return best
+}
+
+// isBlockIdentifiedBy checks whether ID, path or name of nested block element
+// matches the value
+func isBlockIdentifiedBy(block map[string]interface{}, value string) bool {
+	for _, attr := range apiErrorBlockIDAttributes {
+		id, _ := block[attr].(string)
+		if id != "" && (id == value || strings.HasSuffix(value, "/"+id)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Identifying attributes refer to the block element as a whole
+func isBlockIDAttribute(key string) bool {
+	for _, attr := range apiErrorBlockIDAttributes {
+		if key == attr {
+			return true
+		}
+	}
+	return false
+}
+
+func getSortedSchemaKeys(s map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Variants of handle*Error functions for resources that report diagnostics.
+// Schema of the resource is used to point diagnostics to the attribute NSX
+// complained about.
+
+func handleCreateErrorDiagnostics(s map[string]*schema.Schema, d *schema.ResourceData, resourceType string, resource string, err error) diag.Diagnostics {
+	return getAPIErrorDiagnostics(s, d, handleCreateError(resourceType, resource, err))
+}
+
+func handleUpdateErrorDiagnostics(s map[string]*schema.Schema, d *schema.ResourceData, resourceType string, resourceID string, err error) diag.Diagnostics {
+	return getAPIErrorDiagnostics(s, d, handleUpdateError(resourceType, resourceID, err))
+}
+
+func handleReadErrorDiagnostics(d *schema.ResourceData, resourceType string, resource string, err error) diag.Diagnostics {
+	return getAPIErrorDiagnostics(nil, nil, handleReadError(d, resourceType, resource, err))
+}
+
+func handleDeleteErrorDiagnostics(resourceType string, resourceID string, err error) diag.Diagnostics {
+	return getAPIErrorDiagnostics(nil, nil, handleDeleteError(resourceType, resourceID, err))
+}
@@ -0,0 +1,188 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func testInvalidRequestError(t *testing.T, apiError model.ApiError) error {
+	dataValue, errs := bindings.NewTypeConverter().ConvertToVapi(apiError, model.ApiErrorBindingType())
+	if errs != nil {
+		t.Fatalf("Failed to convert ApiError: %v", errs)
+	}
+	return errors.InvalidRequest{Data: dataValue.(*data.StructValue)}
+}
+
+func testSecurityPolicyResourceData(t *testing.T) (map[string]*schema.Schema, *schema.ResourceData) {
+	s := resourceNsxtPolicySecurityPolicy().Schema
+	var rules []interface{}
+	for i := 0; i < 15; i++ {
+		rules = append(rules, map[string]interface{}{
+			"display_name":       fmt.Sprintf("rule%d", i),
+			"action":             "ALLOW",
+			"destination_groups": []interface{}{fmt.Sprintf("/infra/domains/default/groups/g%d", i)},
+		})
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"display_name": "policy",
+		"category":     "Application",
+		"nsx_id":       "policy1",
+		"rule":         rules,
+	})
+	return s, d
+}
+
+func TestGetAPIErrorDiagnostics(t *testing.T) {
+	s, d := testSecurityPolicyResourceData(t)
+
+	err := testInvalidRequestError(t, model.ApiError{
+		ErrorCode:    testInt64Ptr(500041),
+		ErrorMessage: testStringPtr("Security policy validation failed."),
+		Details:      testStringPtr("See related errors"),
+		RelatedErrors: []model.RelatedApiError{
+			{
+				ErrorCode:    testInt64Ptr(500090),
+				ErrorMessage: testStringPtr("The path=[/infra/domains/default/groups/g12] is invalid"),
+				ErrorData:    testErrorData(map[string]string{"rule_id": "rule12", "path": "/infra/domains/default/groups/g12"}),
+			},
+			{
+				ErrorCode:    testInt64Ptr(500100),
+				ErrorMessage: testStringPtr("Rule [rule3] has invalid scope"),
+				ErrorData:    testErrorData(map[string]string{"rule_id": "rule3"}),
+			},
+			{
+				ErrorCode:    testInt64Ptr(500200),
+				ErrorMessage: testStringPtr("Unknown failure"),
+			},
+		},
+	})
+	err = handleCreateError("Security Policy", "policy1", err)
+
+	diags := getAPIErrorDiagnostics(s, d, err)
+	if len(diags) != 4 {
+		t.Fatalf("Expected diagnostic per error and related error, got %v", diags)
+	}
+
+	if diags[0].Summary != "Failed to create Security Policy policy1: Security policy validation failed." {
+		t.Errorf("Unexpected summary %q", diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, "NSX error code 500041") || !strings.Contains(diags[0].Detail, "See related errors") {
+		t.Errorf("Expected error code and details in detail, got %q", diags[0].Detail)
+	}
+	if diags[0].AttributePath != nil {
+		t.Errorf("Expected no attribute path for top level error, got %v", diags[0].AttributePath)
+	}
+
+	expectedPath := cty.GetAttrPath("rule").IndexInt(12).GetAttr("destination_groups")
+	if !diags[1].AttributePath.Equals(expectedPath) {
+		t.Errorf("Expected path %v for invalid group, got %v", expectedPath, diags[1].AttributePath)
+	}
+	if !strings.Contains(diags[1].Detail, "NSX error code 500090") || !strings.Contains(diags[1].Detail, "500041") {
+		t.Errorf("Expected error codes in related error detail, got %q", diags[1].Detail)
+	}
+
+	expectedPath = cty.GetAttrPath("rule").IndexInt(3)
+	if !diags[2].AttributePath.Equals(expectedPath) {
+		t.Errorf("Expected path %v for invalid rule, got %v", expectedPath, diags[2].AttributePath)
+	}
+
+	if diags[3].AttributePath != nil {
+		t.Errorf("Expected no attribute path for unknown failure, got %v", diags[3].AttributePath)
+	}
+}
+
+func TestGetAPIErrorAttributePath(t *testing.T) {
+	s, d := testSecurityPolicyResourceData(t)
+
+	cases := []struct {
+		name      string
+		code      int64
+		message   string
+		errorData map[string]string
+		expected  cty.Path
+	}{
+		{"nested attribute by error_data", 500090, "Invalid path", map[string]string{"rule_id": "rule7", "path": "/infra/domains/default/groups/g7"},
+			cty.GetAttrPath("rule").IndexInt(7).GetAttr("destination_groups")},
+		{"nested attribute by rule path", 500090, "Invalid path", map[string]string{"rule_id": "/infra/domains/default/security-policies/policy1/rules/rule5", "path": "/infra/domains/default/groups/g5"},
+			cty.GetAttrPath("rule").IndexInt(5).GetAttr("destination_groups")},
+		{"value not in identified element", 500090, "Invalid path", map[string]string{"rule_id": "rule1", "path": "/infra/domains/default/groups/g9"},
+			cty.GetAttrPath("rule").IndexInt(9).GetAttr("destination_groups")},
+		{"path in message", 500200, "Group /infra/domains/default/groups/g4 is in use", nil,
+			cty.GetAttrPath("rule").IndexInt(4).GetAttr("destination_groups")},
+		{"top level attribute", 500012, "Object already exists", map[string]string{"id": "policy1"}, cty.GetAttrPath("nsx_id")},
+		{"element in error_data", 500200, "Rule is invalid", map[string]string{"rule_id": "rule1"}, cty.GetAttrPath("rule").IndexInt(1)},
+		{"unknown error", 500200, "Unknown failure", nil, nil},
+	}
+	for _, tc := range cases {
+		apiError := apiErrorDetails{
+			code:      &tc.code,
+			message:   &tc.message,
+			errorData: testErrorData(tc.errorData),
+		}
+		path := getAPIErrorAttributePath(s, d, apiError)
+		if !path.Equals(tc.expected) {
+			t.Errorf("%s: expected path %#v, got %#v", tc.name, tc.expected, path)
+		}
+	}
+}
+
+func TestGetAPIErrorDiagnosticsErrorData(t *testing.T) {
+	s, d := testSecurityPolicyResourceData(t)
+
+	err := handleCreateError("Security Policy", "policy1", testInvalidRequestError(t, model.ApiError{
+		ErrorCode:    testInt64Ptr(500012),
+		ErrorMessage: testStringPtr("Object already exists"),
+		ErrorData:    testErrorData(map[string]string{"id": "policy1"}),
+	}))
+	diags := getAPIErrorDiagnostics(s, d, err)
+	if len(diags) != 1 || !diags[0].AttributePath.Equals(cty.GetAttrPath("nsx_id")) {
+		t.Errorf("Expected error to point to nsx_id, got %v", diags)
+	}
+
+	// Error text is preserved for callers that do not use diagnostics
+	if !strings.Contains(err.Error(), "Object already exists (code 500012)") {
+		t.Errorf("Unexpected error text %q", err.Error())
+	}
+}
+
+func TestGetAPIErrorDiagnosticsNonAPIError(t *testing.T) {
+	s, d := testSecurityPolicyResourceData(t)
+
+	diags := getAPIErrorDiagnostics(s, d, fmt.Errorf("connection refused"))
+	if len(diags) != 1 || diags[0].Summary != "connection refused" || diags[0].AttributePath != nil {
+		t.Errorf("Expected plain diagnostic, got %v", diags)
+	}
+	if getAPIErrorDiagnostics(s, d, nil) != nil {
+		t.Errorf("Expected no diagnostics without error")
+	}
+}
+
+func testErrorData(values map[string]string) *data.StructValue {
+	if values == nil {
+		return nil
+	}
+	fields := make(map[string]data.DataValue)
+	for key, value := range values {
+		fields[key] = data.NewStringValue(value)
+	}
+	return data.NewStructValue("", fields)
+}
+
+func testInt64Ptr(value int64) *int64 {
+	return &value
+}
+
+func testStringPtr(value string) *string {
+	return &value
+}
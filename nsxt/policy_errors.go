@@ -95,16 +95,28 @@ func logVapiErrorData(message string, vapiMessages []std.LocalizableMessage, vap
 		return logRawVapiErrorData(message, vapiType, apiErrorDataValue)
 	}
 
-	details := fmt.Sprintf(" %s: %s", message, printAPIError(apiError))
+	policyErr := &policyAPIError{message: message, apiError: apiError}
+	log.Printf("[ERROR]: %s", policyErr)
+	return policyErr
+}
+
+// policyAPIError keeps NSX ApiError along with the formatted message, so that
+// it can be reported as separate diagnostics per related error
+type policyAPIError struct {
+	message  string
+	apiError model.ApiError
+}
+
+func (e *policyAPIError) Error() string {
+	details := fmt.Sprintf(" %s: %s", e.message, printAPIError(e.apiError))
 
-	if len(apiError.RelatedErrors) > 0 {
+	if len(e.apiError.RelatedErrors) > 0 {
 		details += "\nRelated errors:\n"
-		for _, relatedErr := range apiError.RelatedErrors {
+		for _, relatedErr := range e.apiError.RelatedErrors {
 			details += fmt.Sprintf("%s ", printRelatedAPIError(relatedErr))
 		}
 	}
-	log.Printf("[ERROR]: %s", details)
-	return fmt.Errorf(details)
+	return details
 }
 
 func logAPIError(message string, err error) error {
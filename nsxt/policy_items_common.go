@@ -0,0 +1,106 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+// getPolicyItemsDataSourceSchema returns schema of plural data source, which
+// builds display name to path map of policy objects of certain type
+func getPolicyItemsDataSourceSchema(extraSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{
+		"display_name_regex": {
+			Type:         schema.TypeString,
+			Description:  "Regular expression that display name of the object should match",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"tag":     getDataSourceTagFilterSchema(),
+		"context": getContextSchema(false, false),
+		"items": {
+			Type:        schema.TypeMap,
+			Description: "Mapping of object policy path by display name",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	for key, value := range extraSchema {
+		result[key] = value
+	}
+	return result
+}
+
+func policyItemsDataSourceRead(d *schema.ResourceData, m interface{}, resourceType string, additionalQuery map[string]string) error {
+	connector := getPolicyConnector(m)
+	var nameRegex *regexp.Regexp
+	if regex := d.Get("display_name_regex").(string); regex != "" {
+		nameRegex = regexp.MustCompile(regex)
+	}
+	tags := getPolicyTagFiltersFromSchema(d, "tag")
+	query := buildPolicySearchQuery("", resourceType, tags)
+	if additionalQueryString := buildQueryStringFromMap(additionalQuery); additionalQueryString != "" {
+		query = query + " AND " + additionalQueryString
+	}
+
+	log.Printf("[DEBUG] Listing %s objects with query %s", resourceType, query)
+	resultValues, err := searchPolicyResourcesInContext(connector, getSessionContext(d, m), query)
+	if err != nil {
+		return handleListError(resourceType, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	paths := make(map[string][]string)
+	for _, result := range resultValues {
+		dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		if policyResource.ResourceType == nil || *policyResource.ResourceType != resourceType {
+			continue
+		}
+		if policyResource.DisplayName == nil || policyResource.Path == nil {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(*policyResource.DisplayName) {
+			continue
+		}
+		if !policyResourceMatchesTags(policyResource.Tags, tags) {
+			continue
+		}
+		paths[*policyResource.DisplayName] = append(paths[*policyResource.DisplayName], *policyResource.Path)
+	}
+
+	var names []string
+	for name := range paths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	items := make(map[string]interface{})
+	for _, name := range names {
+		if len(paths[name]) > 1 {
+			sort.Strings(paths[name])
+			return fmt.Errorf("Found multiple %s with name '%s': %s, use display_name_regex or tag to narrow down the results", resourceType, name, strings.Join(paths[name], ", "))
+		}
+		items[name] = paths[name][0]
+	}
+
+	d.SetId(newUUID())
+	d.Set("items", items)
+
+	return nil
+}
@@ -0,0 +1,211 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+)
+
+// policyMetadataResource describes a Policy resource whose attributes are
+// mapped to SDK model by extended schema. Common attributes (display_name,
+// description, tag, path, revision, nsx_id) are handled by the builder, and
+// should be included in the schema with metadata.GetExtendedSchema.
+type policyMetadataResource struct {
+	// Object type used in log and error messages
+	objectType string
+	schema     map[string]*metadata.ExtendedSchema
+	// Type of SDK model struct, i.e. reflect.TypeOf(model.QosProfile{})
+	modelType reflect.Type
+	// Binding type of modelType for polymorphic objects. When specified, API
+	// callbacks operate on *data.StructValue rather than on model struct
+	bindingType bindings.BindingType
+	// Value of ResourceType field for polymorphic objects
+	resourceType string
+
+	exists func(utl.SessionContext, string, client.Connector) (bool, error)
+	// API callbacks receive and return model struct (not a pointer), or
+	// *data.StructValue for polymorphic objects
+	getObject    func(utl.SessionContext, client.Connector, string) (interface{}, error)
+	patchObject  func(utl.SessionContext, client.Connector, string, interface{}) error
+	deleteObject func(utl.SessionContext, client.Connector, string) error
+
+	// Optional hooks for attributes that can not be described by metadata.
+	// Hooks receive pointer to the model struct.
+	schemaToStruct func(*schema.ResourceData, interface{}) error
+	structToSchema func(*schema.ResourceData, interface{}) error
+
+	// Defaults to nsxtPolicyPathResourceImporter
+	importer schema.StateFunc
+}
+
+// getPolicyMetadataResource builds terraform resource with CRUD and import
+// implemented on top of extended schema
+func getPolicyMetadataResource(r *policyMetadataResource) *schema.Resource {
+	importer := r.importer
+	if importer == nil {
+		importer = nsxtPolicyPathResourceImporter
+	}
+
+	return &schema.Resource{
+		Create: r.create,
+		Read:   r.read,
+		Update: r.update,
+		Delete: r.delete,
+		Importer: &schema.ResourceImporter{
+			State: importer,
+		},
+
+		Schema:        metadata.GetSchemaFromExtendedSchema(r.schema),
+		CustomizeDiff: getExtendedSchemaVersionGatingCustomizeDiff(r.schema),
+	}
+}
+
+// getLegacyExistsWrapper adapts presence checker that predates session context
+func getLegacyExistsWrapper(exists func(string, client.Connector, bool) (bool, error)) func(utl.SessionContext, string, client.Connector) (bool, error) {
+	return func(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+		return exists(id, connector, sessionContext.ClientType == utl.Global)
+	}
+}
+
+func (r *policyMetadataResource) getObjectFromSchema(d *schema.ResourceData, m interface{}) (interface{}, error) {
+	obj := reflect.New(r.modelType)
+	elem := obj.Elem()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	elem.FieldByName("DisplayName").Set(reflect.ValueOf(&displayName))
+	elem.FieldByName("Description").Set(reflect.ValueOf(&description))
+	elem.FieldByName("Tags").Set(reflect.ValueOf(getPolicyTagsFromSchema(d, m)))
+	if r.resourceType != "" {
+		elem.FieldByName("ResourceType").SetString(r.resourceType)
+	}
+
+	if err := metadata.SchemaToStruct(elem, d, r.schema, "", nil); err != nil {
+		return nil, err
+	}
+	if r.schemaToStruct != nil {
+		if err := r.schemaToStruct(d, obj.Interface()); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.bindingType == nil {
+		return elem.Interface(), nil
+	}
+
+	converter := bindings.NewTypeConverter()
+	dataValue, errs := converter.ConvertToVapi(elem.Interface(), r.bindingType)
+	if errs != nil {
+		return nil, errs[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func (r *policyMetadataResource) patch(d *schema.ResourceData, m interface{}, id string) error {
+	obj, err := r.getObjectFromSchema(d, m)
+	if err != nil {
+		return err
+	}
+
+	return r.patchObject(getSessionContext(d, m), getPolicyConnector(m), id, obj)
+}
+
+func (r *policyMetadataResource) create(d *schema.ResourceData, m interface{}) error {
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, r.exists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating %s with ID %s", r.objectType, id)
+	err = r.patch(d, m, id)
+	if err != nil {
+		return handleCreateError(r.objectType, id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return r.read(d, m)
+}
+
+func (r *policyMetadataResource) read(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", r.objectType)
+	}
+
+	result, err := r.getObject(getSessionContext(d, m), getPolicyConnector(m), id)
+	if err != nil {
+		return handleReadError(d, r.objectType, id, err)
+	}
+
+	if r.bindingType != nil {
+		converter := bindings.NewTypeConverter()
+		var errs []error
+		result, errs = converter.ConvertToGolang(result.(*data.StructValue), r.bindingType)
+		if errs != nil {
+			return fmt.Errorf("Error converting %s %s: %v", r.objectType, id, errs[0])
+		}
+	}
+
+	obj := reflect.New(r.modelType)
+	elem := obj.Elem()
+	elem.Set(reflect.ValueOf(result))
+
+	d.Set("display_name", elem.FieldByName("DisplayName").Interface())
+	d.Set("description", elem.FieldByName("Description").Interface())
+	setPolicyTagsInSchema(d, elem.FieldByName("Tags").Interface().([]model.Tag), m)
+	d.Set("nsx_id", id)
+	d.Set("path", elem.FieldByName("Path").Interface())
+	d.Set("revision", elem.FieldByName("Revision").Interface())
+
+	if err := metadata.StructToSchema(elem, d, r.schema, "", nil); err != nil {
+		return err
+	}
+	if r.structToSchema != nil {
+		return r.structToSchema(d, obj.Interface())
+	}
+	return nil
+}
+
+func (r *policyMetadataResource) update(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", r.objectType)
+	}
+
+	log.Printf("[INFO] Updating %s with ID %s", r.objectType, id)
+	err := r.patch(d, m, id)
+	if err != nil {
+		return handleUpdateError(r.objectType, id, err)
+	}
+
+	return r.read(d, m)
+}
+
+func (r *policyMetadataResource) delete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", r.objectType)
+	}
+
+	err := r.deleteObject(getSessionContext(d, m), getPolicyConnector(m), id)
+	if err != nil {
+		return handleDeleteError(r.objectType, id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestProviderMetadataResources(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	cases := []struct {
+		resourceType string
+		config       map[string]interface{}
+		verify       func(obj simulator.Object) bool
+	}{
+		{"nsxt_policy_qos_profile", map[string]interface{}{
+			"display_name":  "simulator-qos",
+			"dscp_trusted":  true,
+			"dscp_priority": 53,
+			"ingress_rate_shaper": []interface{}{
+				map[string]interface{}{"enabled": true, "average_bw_mbps": 30},
+			},
+		}, func(obj simulator.Object) bool {
+			dscp := obj["dscp"].(map[string]interface{})
+			return dscp["mode"] == "TRUSTED" && dscp["priority"] == float64(53) && len(obj["shaper_configurations"].([]interface{})) == 1
+		}},
+		{"nsxt_policy_ip_discovery_profile", map[string]interface{}{
+			"display_name":      "simulator-ip-discovery",
+			"arp_binding_limit": 140,
+		}, func(obj simulator.Object) bool {
+			arpConfig := obj["ip_v4_discovery_options"].(map[string]interface{})["arp_snooping_config"].(map[string]interface{})
+			return arpConfig["arp_binding_limit"] == float64(140)
+		}},
+		{"nsxt_policy_gateway_flood_protection_profile", map[string]interface{}{
+			"display_name":          "simulator-flood-protection",
+			"udp_active_flow_limit": 300,
+		}, func(obj simulator.Object) bool {
+			_, icmpLimitSet := obj["icmp_active_flow_limit"]
+			return obj["resource_type"] == "GatewayFloodProtectionProfile" && obj["udp_active_flow_limit"] == float64(300) && !icmpLimitSet
+		}},
+		{"nsxt_policy_lb_https_monitor_profile", map[string]interface{}{
+			"display_name": "simulator-https-monitor",
+			"request_header": []interface{}{
+				map[string]interface{}{"name": "X-Header", "value": "value"},
+			},
+			"server_ssl": []interface{}{
+				map[string]interface{}{"server_auth": "IGNORE"},
+			},
+		}, func(obj simulator.Object) bool {
+			return obj["resource_type"] == "LBHttpsMonitorProfile" && len(obj["request_headers"].([]interface{})) == 1 && obj["server_ssl_profile_binding"] != nil
+		}},
+	}
+
+	for _, tc := range cases {
+		res := provider.ResourcesMap[tc.resourceType]
+		d := schema.TestResourceDataRaw(t, res.Schema, tc.config)
+		if diags := testResourceCreate(res, d, provider.Meta()); diags.HasError() {
+			t.Fatalf("Failed to create %s: %v", tc.resourceType, diags)
+		}
+
+		path := d.Get("path").(string)
+		obj, ok := sim.Get(path)
+		if !ok || obj["display_name"] != tc.config["display_name"] || !tc.verify(obj) {
+			t.Errorf("%s was not stored in simulator as expected: %v", tc.resourceType, obj)
+		}
+
+		imported := res.TestResourceData()
+		imported.SetId(d.Id())
+		if diags := testResourceRead(res, imported, provider.Meta()); diags.HasError() {
+			t.Errorf("Failed to read %s: %v", tc.resourceType, diags)
+		}
+		for key := range tc.config {
+			expected, actual := d.Get(key), imported.Get(key)
+			if set, ok := expected.(*schema.Set); ok {
+				expected, actual = set.List(), actual.(*schema.Set).List()
+			}
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("Expected %s of %s to be %v, got %v", key, tc.resourceType, expected, actual)
+			}
+		}
+
+		if diags := testResourceDelete(res, d, provider.Meta()); diags.HasError() {
+			t.Fatalf("Failed to delete %s: %v", tc.resourceType, diags)
+		}
+		if _, ok := sim.Get(path); ok {
+			t.Errorf("%s was not removed from simulator", tc.resourceType)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+const (
+	policyRawAPIBasePath       = "/policy/api/v1"
+	policyRawAPIGlobalBasePath = "/global-manager/api/v1"
+	policyRawAPIBodyField      = "body"
+)
+
+// getPolicyRawAPIObjectPath returns full policy path of the object in given
+// context. Path of object in project context is relative to the project.
+func getPolicyRawAPIObjectPath(context utl.SessionContext, objPath string) string {
+	if context.ClientType == utl.Multitenancy {
+		return fmt.Sprintf("/orgs/%s/projects/%s%s", utl.DefaultOrgID, context.ProjectID, objPath)
+	}
+	return objPath
+}
+
+func getPolicyRawAPIRestMetadata(context utl.SessionContext, method string, objPath string, withBody bool) protocol.OperationRestMetadata {
+	fields := map[string]bindings.BindingType{}
+	fieldNameMap := map[string]string{}
+	bodyParam := ""
+	if withBody {
+		fields[policyRawAPIBodyField] = bindings.NewDynamicStructType(nil)
+		fieldNameMap[policyRawAPIBodyField] = "Body"
+		bodyParam = policyRawAPIBodyField
+	}
+	successCode := http.StatusOK
+	if method == http.MethodDelete {
+		successCode = http.StatusNoContent
+	}
+	basePath := policyRawAPIBasePath
+	if context.ClientType == utl.Global {
+		basePath = policyRawAPIGlobalBasePath
+	}
+
+	return protocol.NewOperationRestMetadata(
+		fields,
+		fieldNameMap,
+		map[string]bindings.BindingType{},
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		"",
+		bodyParam,
+		method,
+		basePath+getPolicyRawAPIObjectPath(context, objPath),
+		"",
+		map[string]string{},
+		successCode,
+		"",
+		map[string]map[string]string{},
+		map[string]int{"com.vmware.vapi.std.errors.invalid_request": 400, "com.vmware.vapi.std.errors.unauthorized": 403, "com.vmware.vapi.std.errors.service_unavailable": 503, "com.vmware.vapi.std.errors.internal_server_error": 500, "com.vmware.vapi.std.errors.not_found": 404})
+}
+
+// policyRawAPIRequest sends request for policy object with given path via
+// generic API provider of the connector, so that authentication, retries and
+// custom headers apply same as for any other policy API call. Body, if not nil,
+// is sent as is, and response body is returned as is.
+func policyRawAPIRequest(connector client.Connector, context utl.SessionContext, method string, objPath string, body *data.StructValue) (data.DataValue, error) {
+	executionContext := connector.NewExecutionContext()
+	executionContext.SetConnectionMetadata(core.RESTMetadataKey, getPolicyRawAPIRestMetadata(context, method, objPath, body != nil))
+	executionContext.SetConnectionMetadata(core.ResponseTypeKey, core.NewResponseType(true, false))
+
+	input := data.NewStructValue("operation-input", nil)
+	if body != nil {
+		input.SetField(policyRawAPIBodyField, body)
+	}
+
+	methodResult := connector.GetApiProvider().Invoke("com.vmware.nsx_policy.raw", method, input, executionContext)
+	if methodResult.IsSuccess() {
+		return methodResult.Output(), nil
+	}
+
+	typeConverter := connector.TypeConverter()
+	methodError, errs := typeConverter.ConvertToGolang(methodResult.Error(), errors.ERROR_BINDINGS_MAP[methodResult.Error().Name()])
+	if errs != nil {
+		return nil, bindings.VAPIerrorsToError(errs)
+	}
+	return nil, methodError.(error)
+}
+
+// decodePolicyRawAPIBody converts JSON object to data value to be sent as
+// request body
+func decodePolicyRawAPIBody(body string) (*data.StructValue, error) {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	// preserve integers, which would be converted to doubles otherwise
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	dataValue, err := cleanjson.NewJsonToDataValueDecoder().Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	structValue, ok := dataValue.(*data.StructValue)
+	if !ok {
+		return nil, fmt.Errorf("JSON object expected")
+	}
+	return structValue, nil
+}
@@ -29,6 +29,9 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 	var obj policySearchDataValue
 	objName := d.Get("display_name").(string)
 	objID := d.Get("id").(string)
+	tags := getPolicyDataSourceTagFilters(d)
+	// exact_match is not defined for all data sources
+	exactMatch, _ := d.Get("exact_match").(bool)
 	converter := bindings.NewTypeConverter()
 
 	for _, result := range resultValues {
@@ -40,6 +43,9 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 		if resourceType != *policyResource.ResourceType {
 			continue
 		}
+		if !policyResourceMatchesTags(policyResource.Tags, tags) {
+			continue
+		}
 
 		if objID != "" {
 			perfectMatch = append(perfectMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
@@ -48,30 +54,34 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 			if *policyResource.DisplayName == objName {
 				perfectMatch = append(perfectMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
 			}
-			if strings.HasPrefix(*policyResource.DisplayName, objName) {
+			if !(exactMatch && objName != "") && strings.HasPrefix(*policyResource.DisplayName, objName) {
 				prefixMatch = append(prefixMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
 			}
 		}
 	}
 
+	tagsDescription := ""
+	if len(tags) > 0 {
+		tagsDescription = " and matching tags"
+	}
 	if len(perfectMatch) > 0 {
 		if len(perfectMatch) > 1 {
 			if objID != "" {
 				return nil, fmt.Errorf("Found multiple %s with ID '%s'", resourceType, objID)
 			}
-			return nil, fmt.Errorf("Found multiple %s with name '%s'", resourceType, objName)
+			return nil, fmt.Errorf("Found multiple %s with name '%s'%s", resourceType, objName, tagsDescription)
 		}
 		obj = perfectMatch[0]
 	} else if len(prefixMatch) > 0 {
 		if len(prefixMatch) > 1 {
-			return nil, fmt.Errorf("Found multiple %s with name starting with '%s'", resourceType, objName)
+			return nil, fmt.Errorf("Found multiple %s with name starting with '%s'%s", resourceType, objName, tagsDescription)
 		}
 		obj = prefixMatch[0]
 	} else {
 		if objID != "" {
-			return nil, fmt.Errorf("%s with ID '%s' was not found", resourceType, objID)
+			return nil, fmt.Errorf("%s with ID '%s'%s was not found", resourceType, objID, tagsDescription)
 		}
-		return nil, fmt.Errorf("%s with name '%s' was not found", resourceType, objName)
+		return nil, fmt.Errorf("%s with name '%s'%s was not found", resourceType, objName, tagsDescription)
 	}
 
 	d.SetId(*obj.Resource.Id)
@@ -82,6 +92,15 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 	return obj.StructValue, nil
 }
 
+// getPolicyDataSourceTagFilters returns tag filters of data source, if
+// supported by its schema
+func getPolicyDataSourceTagFilters(d *schema.ResourceData) []model.Tag {
+	if _, ok := d.Get("tag").(*schema.Set); !ok {
+		return nil
+	}
+	return getPolicyTagFiltersFromSchema(d, "tag")
+}
+
 func policyDataSourceResourceRead(d *schema.ResourceData, connector client.Connector, context utl.SessionContext, resourceType string, additionalQuery map[string]string) (*data.StructValue, error) {
 	return policyDataSourceResourceReadWithValidation(d, connector, context, resourceType, additionalQuery, true)
 }
@@ -91,9 +110,10 @@ func policyDataSourceResourceReadWithValidation(d *schema.ResourceData, connecto
 	objID := d.Get("id").(string)
 	var err error
 	var resultValues []*data.StructValue
+	tags := getPolicyDataSourceTagFilters(d)
 	additionalQueryString := buildQueryStringFromMap(additionalQuery)
-	if paramsValidation && objID == "" && objName == "" {
-		return nil, fmt.Errorf("No 'id' or 'display_name' specified for %s", resourceType)
+	if paramsValidation && objID == "" && objName == "" && len(tags) == 0 {
+		return nil, fmt.Errorf("No 'id', 'display_name' or 'tag' specified for %s", resourceType)
 	}
 	if objID != "" {
 		if resourceType == "PolicyEdgeNode" {
@@ -104,7 +124,7 @@ func policyDataSourceResourceReadWithValidation(d *schema.ResourceData, connecto
 			resultValues, err = listPolicyResourcesByID(connector, context, &objID, &additionalQueryString)
 		}
 	} else {
-		resultValues, err = listPolicyResourcesByNameAndType(connector, context, objName, resourceType, &additionalQueryString)
+		resultValues, err = listPolicyResourcesByNameAndTags(connector, context, objName, resourceType, tags, &additionalQueryString)
 	}
 	if err != nil {
 		return nil, err
@@ -114,7 +134,16 @@ func policyDataSourceResourceReadWithValidation(d *schema.ResourceData, connecto
 }
 
 func listPolicyResourcesByNameAndType(connector client.Connector, context utl.SessionContext, displayName string, resourceType string, additionalQuery *string) ([]*data.StructValue, error) {
+	return listPolicyResourcesByNameAndTags(connector, context, displayName, resourceType, nil, additionalQuery)
+}
+
+// listPolicyResourcesByNameAndTags searches policy objects of given type with
+// display name starting with given prefix and carrying given tags
+func listPolicyResourcesByNameAndTags(connector client.Connector, context utl.SessionContext, displayName string, resourceType string, tags []model.Tag, additionalQuery *string) ([]*data.StructValue, error) {
 	query := fmt.Sprintf("resource_type:%s AND display_name:%s* AND marked_for_delete:false", resourceType, escapeSpecialCharacters(displayName))
+	if tagsQuery := buildPolicyTagsQuery(tags); tagsQuery != "" {
+		query = query + " AND " + tagsQuery
+	}
 	switch context.ClientType {
 	case utl.Local:
 		return searchLMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
@@ -245,3 +274,81 @@ func searchMultitenancyPolicyResources(connector client.Connector, org string, p
 	query = query + fmt.Sprintf(" AND path:\\/orgs\\/%s\\/projects\\/%s*", org, project)
 	return searchLM(connector, query)
 }
+
+// searchPolicyResourcesInContext searches policy objects with given query in
+// the scope of session context: local infra, global infra or project
+func searchPolicyResourcesInContext(connector client.Connector, context utl.SessionContext, query string) ([]*data.StructValue, error) {
+	switch context.ClientType {
+	case utl.Local:
+		return searchLMPolicyResources(connector, query)
+	case utl.Global:
+		return searchGMPolicyResources(connector, query)
+	case utl.Multitenancy:
+		return searchMultitenancyPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, query)
+	}
+	return nil, fmt.Errorf("invalid ClientType %d", context.ClientType)
+}
+
+// getPolicyTagFiltersFromSchema returns tag filters configured in given attribute
+func getPolicyTagFiltersFromSchema(d *schema.ResourceData, attrName string) []model.Tag {
+	var tags []model.Tag
+	for _, item := range d.Get(attrName).(*schema.Set).List() {
+		data := item.(map[string]interface{})
+		scope := data["scope"].(string)
+		tag := data["tag"].(string)
+		if scope == "" && tag == "" {
+			continue
+		}
+		tags = append(tags, model.Tag{Scope: &scope, Tag: &tag})
+	}
+	return tags
+}
+
+// buildPolicyTagsQuery builds search query matching objects with all given
+// tags. Since search matches scope and tag independently, results should be
+// verified with policyResourceMatchesTags.
+func buildPolicyTagsQuery(tags []model.Tag) string {
+	var terms []string
+	for _, tag := range tags {
+		if tag.Scope != nil && *tag.Scope != "" {
+			terms = append(terms, fmt.Sprintf("tags.scope:%s", escapeSearchValue(*tag.Scope)))
+		}
+		if tag.Tag != nil && *tag.Tag != "" {
+			terms = append(terms, fmt.Sprintf("tags.tag:%s", escapeSearchValue(*tag.Tag)))
+		}
+	}
+	return strings.Join(terms, " AND ")
+}
+
+// escapeSearchValue escapes value for exact match in search query. Values with
+// whitespace, quotes or backslashes are quoted as a phrase.
+func escapeSearchValue(value string) string {
+	if !strings.ContainsAny(value, " \t\n\"\\") {
+		return escapeSpecialCharacters(value)
+	}
+	value = strings.ReplaceAll(value, "\\", "\\\\")
+	value = strings.ReplaceAll(value, "\"", "\\\"")
+	return "\"" + value + "\""
+}
+
+// policyResourceMatchesTags checks that object carries each of the given tags,
+// where empty scope or tag of the filter matches any value
+func policyResourceMatchesTags(objTags []model.Tag, tags []model.Tag) bool {
+	for _, filter := range tags {
+		found := false
+		for _, objTag := range objTags {
+			if filter.Scope != nil && *filter.Scope != "" && (objTag.Scope == nil || *objTag.Scope != *filter.Scope) {
+				continue
+			}
+			if filter.Tag != nil && *filter.Tag != "" && (objTag.Tag == nil || *objTag.Tag != *filter.Tag) {
+				continue
+			}
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -16,9 +16,9 @@ import (
 	sdkerrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
-	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
+	realizedstate "github.com/vmware/terraform-provider-nsxt/api/infra/realized_state"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
 )
 
@@ -99,8 +99,8 @@ func initPolicyTagsSet(tags []model.Tag) []map[string]interface{} {
 	return tagList
 }
 
-func getCustomizedPolicyTagsFromSchema(d *schema.ResourceData, schemaName string) []model.Tag {
-	tags := d.Get(schemaName).(*schema.Set).List()
+func getCustomizedPolicyTagsFromSchema(d *schema.ResourceData, schemaName string, m interface{}) []model.Tag {
+	tags := getTagsWithDefaultsFromSchema(d, schemaName, m)
 	tagList := make([]model.Tag, 0)
 	for _, tag := range tags {
 		data := tag.(map[string]interface{})
@@ -115,7 +115,7 @@ func getCustomizedPolicyTagsFromSchema(d *schema.ResourceData, schemaName string
 	return tagList
 }
 
-func setCustomizedPolicyTagsInSchema(d *schema.ResourceData, tags []model.Tag, schemaName string) {
+func setCustomizedPolicyTagsInSchema(d *schema.ResourceData, tags []model.Tag, schemaName string, m interface{}) {
 	var tagList []map[string]interface{}
 	for _, tag := range tags {
 		elem := make(map[string]interface{})
@@ -123,18 +123,18 @@ func setCustomizedPolicyTagsInSchema(d *schema.ResourceData, tags []model.Tag, s
 		elem["tag"] = tag.Tag
 		tagList = append(tagList, elem)
 	}
-	err := d.Set(schemaName, tagList)
+	err := setTagsWithDefaultsInSchema(d, tagList, schemaName, m)
 	if err != nil {
 		log.Printf("[WARNING] Failed to set tag in schema: %v", err)
 	}
 }
 
-func getPolicyTagsFromSchema(d *schema.ResourceData) []model.Tag {
-	return getCustomizedPolicyTagsFromSchema(d, "tag")
+func getPolicyTagsFromSchema(d *schema.ResourceData, m interface{}) []model.Tag {
+	return getCustomizedPolicyTagsFromSchema(d, "tag", m)
 }
 
-func setPolicyTagsInSchema(d *schema.ResourceData, tags []model.Tag) {
-	setCustomizedPolicyTagsInSchema(d, tags, "tag")
+func setPolicyTagsInSchema(d *schema.ResourceData, tags []model.Tag, m interface{}) {
+	setCustomizedPolicyTagsInSchema(d, tags, "tag", m)
 }
 
 func getPathListFromMap(data map[string]interface{}, attrName string) []string {
@@ -324,14 +324,17 @@ func commaSeparatedStringToStringList(commaString string) []string {
 	return strList
 }
 
-func nsxtPolicyWaitForRealizationStateConf(connector client.Connector, d *schema.ResourceData, realizedEntityPath string, timeout int) *resource.StateChangeConf {
-	client := realized_state.NewRealizedEntitiesClient(connector)
+func nsxtPolicyWaitForRealizationStateConf(connector client.Connector, context utl.SessionContext, realizedEntityPath string, timeout time.Duration) *resource.StateChangeConf {
+	client := realizedstate.NewRealizedEntitiesClient(context, connector)
 	pendingStates := []string{"UNKNOWN", "UNREALIZED"}
 	targetStates := []string{"REALIZED", "ERROR"}
 	stateConf := &resource.StateChangeConf{
 		Pending: pendingStates,
 		Target:  targetStates,
 		Refresh: func() (interface{}, string, error) {
+			if client == nil {
+				return nil, "", policyResourceNotSupportedError()
+			}
 
 			realizationResult, realizationError := client.List(realizedEntityPath, nil)
 			if realizationError == nil {
@@ -346,7 +349,7 @@ func nsxtPolicyWaitForRealizationStateConf(connector client.Connector, d *schema
 			}
 			return nil, "", realizationError
 		},
-		Timeout:    time.Duration(timeout) * time.Second,
+		Timeout:    timeout,
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
@@ -354,6 +357,27 @@ func nsxtPolicyWaitForRealizationStateConf(connector client.Connector, d *schema
 	return stateConf
 }
 
+// nsxtPolicyWaitForRealization waits for policy object to be realized on local
+// manager. Realization errors are reported in log, but do not fail the operation.
+func nsxtPolicyWaitForRealization(d *schema.ResourceData, m interface{}, realizedEntityPath string, timeout time.Duration) error {
+	context := getSessionContext(d, m)
+	if context.ClientType == utl.Global {
+		// Realization is tracked per site on global manager
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting for realization of %s", realizedEntityPath)
+	stateConf := nsxtPolicyWaitForRealizationStateConf(getPolicyConnector(m), context, realizedEntityPath, timeout)
+	entity, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Failed to wait for realization of %s: %v", realizedEntityPath, err)
+	}
+	if realizedResource, ok := entity.(model.GenericPolicyRealizedResource); ok && *realizedResource.State == "ERROR" {
+		log.Printf("[WARNING] Realization of %s is in ERROR state", realizedEntityPath)
+	}
+	return nil
+}
+
 func getPolicyEnforcementPointPath(m interface{}) string {
 	return "/infra/sites/default/enforcement-points/" + getPolicyEnforcementPoint(m)
 }
@@ -4,24 +4,25 @@
 package nsxt
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math/rand"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	tf_api "github.com/vmware/terraform-provider-nsxt/api/utl"
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "github.com/vmware/go-vmware-nsxt"
@@ -45,9 +46,18 @@ type commonProviderConfig struct {
 	MinRetryInterval       int
 	MaxRetryInterval       int
 	RetryStatusCodes       []int
+	APIRateLimit           float64
+	APIRateBurst           int
+	BatchWindow            int
+	BatchMaxObjects        int
 	Username               string
 	Password               string
 	LicenseKeys            []string
+	DefaultProjectID       string
+	VersionCheck           string
+	EnforceRevision        bool
+	RevisionConflict       string
+	DefaultTags            []interface{}
 }
 
 type nsxtClients struct {
@@ -57,22 +67,28 @@ type nsxtClients struct {
 	// Config for the above client
 	NsxtClientConfig *api.Configuration
 	// Data for NSX Policy client - based on vsphere-automation-sdk-go SDK
-	// First offering of Policy SDK does not support concurrent
-	// operations in single connector. In order to avoid heavy locks,
-	// we are allocating connector per provider operation.
-	// TODO: when concurrency support is introduced policy client,
-	// change this code to allocate single connector for all provider
-	// operations.
 	PolicySecurityContext  *core.SecurityContextImpl
 	PolicyHTTPClient       *http.Client
 	Host                   string
 	PolicyEnforcementPoint string
 	PolicyGlobalManager    bool
+	// Policy connector shared by all provider operations. Connector is safe
+	// for concurrent use, and reuses keep-alive connections of PolicyHTTPClient
+	PolicyConnector client.Connector
+	// NSX session shared by MP and Policy clients, if session auth is used
+	Session *nsxtSession
+	// Rate limiter shared by MP and Policy clients
+	RateLimiter *apiRateLimiter
+	// Transport of Policy HTTP client without session handling and batching,
+	// used for management of sessions other than the shared one
+	PolicyTransport http.RoundTripper
+	// VMC authentication settings, used to obtain fresh API tokens
+	VmcAuthInfo *vmcAuthInfo
 }
 
 // Provider for VMWare NSX-T
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 
 		Schema: map[string]*schema.Schema{
 			"allow_unverified_ssl": {
@@ -150,6 +166,111 @@ func Provider() *schema.Provider {
 				},
 				// There is no support for default values/func for list, so it will be handled later
 			},
+			"api_rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Client-side limit on rate of NSX API requests, shared by Policy and Manager API calls",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Description:  "Sustained number of API requests per second",
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0.01),
+						},
+						"burst": {
+							Type:         schema.TypeInt,
+							Description:  "Number of API requests that may be sent at once above the sustained rate",
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"policy_batching": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Batch creation and update of policy groups, services and rules into hierarchical API calls",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"window_ms": {
+							Type:         schema.TypeInt,
+							Description:  "Time in milliseconds to collect objects into a batch",
+							Optional:     true,
+							Default:      200,
+							ValidateFunc: validation.IntBetween(1, 60000),
+						},
+						"max_objects": {
+							Type:         schema.TypeInt,
+							Description:  "Maximum number of objects in a single batch",
+							Optional:     true,
+							Default:      500,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"max_idle_conns": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of idle keep-alive connections to NSX",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_MAX_IDLE_CONNS", 100),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_idle_conns_per_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of idle keep-alive connections per NSX host",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_MAX_IDLE_CONNS_PER_HOST", 100),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"idle_conn_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Time in seconds an idle keep-alive connection remains open",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_IDLE_CONN_TIMEOUT", 90),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"default_tags": getDefaultTagsSchema(),
+			"default_context": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Context applied to resources and data sources that do not specify context explicitly",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:         schema.TypeString,
+							Description:  "Id of the project which resources belong to by default",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+			"version_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Behavior when configured attribute requires newer NSX version than the connected NSX manager",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_VERSION_CHECK", versionCheckError),
+				ValidateFunc: validation.StringInSlice(versionCheckValues, false),
+			},
+			"enforce_revision": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Send revision captured at refresh with every update, so that changes made outside Terraform are not overwritten",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_ENFORCE_REVISION", false),
+			},
+			"revision_conflict": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Behavior when update is rejected since the object changed outside Terraform",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_REVISION_CONFLICT", revisionConflictError),
+				ValidateFunc: validation.StringInSlice(revisionConflictValues, false),
+			},
 			"tolerate_partial_success": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -301,6 +422,14 @@ func Provider() *schema.Provider {
 			"nsxt_policy_ipsec_vpn_service":                          dataSourceNsxtPolicyIPSecVpnService(),
 			"nsxt_policy_l2_vpn_service":                             dataSourceNsxtPolicyL2VpnService(),
 			"nsxt_policy_segment":                                    dataSourceNsxtPolicySegment(),
+			"nsxt_policy_search":                                     dataSourceNsxtPolicySearch(),
+			"nsxt_policy_groups":                                     dataSourceNsxtPolicyGroups(),
+			"nsxt_policy_segments":                                   dataSourceNsxtPolicySegments(),
+			"nsxt_policy_services":                                   dataSourceNsxtPolicyServices(),
+			"nsxt_policy_tier1_gateways":                             dataSourceNsxtPolicyTier1Gateways(),
+			"nsxt_policy_security_policies":                          dataSourceNsxtPolicySecurityPolicies(),
+			"nsxt_policy_context_profiles":                           dataSourceNsxtPolicyContextProfiles(),
+			"nsxt_policy_group_members":                              dataSourceNsxtPolicyGroupMembers(),
 			"nsxt_policy_project":                                    dataSourceNsxtPolicyProject(),
 			"nsxt_policy_gateway_dns_forwarder":                      dataSourceNsxtPolicyGatewayDNSForwarder(),
 			"nsxt_policy_gateway_prefix_list":                        dataSourceNsxtPolicyGatewayPrefixList(),
@@ -496,10 +625,17 @@ func Provider() *schema.Provider {
 			"nsxt_policy_gateway_flood_protection_profile_binding":     resourceNsxtPolicyGatewayFloodProtectionProfileBinding(),
 			"nsxt_policy_compute_sub_cluster":                          resourceNsxtPolicyComputeSubCluster(),
 			"nsxt_policy_tier0_inter_vrf_routing":                      resourceNsxtPolicyTier0InterVRFRouting(),
+			"nsxt_policy_raw_object":                                   resourceNsxtPolicyRawObject(),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	addTagsAllToResources(provider.ResourcesMap)
+	addMultitenancyValidationToResources(provider.ResourcesMap)
+	addVersionGatingToResources(provider.ResourcesMap)
+	addRevisionEnforcementToResources(provider.ResourcesMap)
+	return provider
 }
 
 func isVMCCredentialSet(d *schema.ResourceData) bool {
@@ -558,12 +694,6 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
 
-	// The correct place to escape special chars would be inside the SDK
-	// However since the SDK is deprecated, we implement escaping here
-	// TODO implement this functionality with new mp-sdk
-	username = url.QueryEscape(username)
-	password = url.QueryEscape(password)
-
 	if needCreds {
 		if username == "" {
 			return fmt.Errorf("username must be provided")
@@ -587,6 +717,12 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 	sessionAuth := d.Get("session_auth").(bool)
 	skipSessionAuth := !sessionAuth
 
+	// The correct place to escape special chars would be inside the SDK
+	// However since the SDK is deprecated, we implement escaping here
+	// TODO implement this functionality with new mp-sdk
+	escapedUsername := url.QueryEscape(username)
+	escapedPassword := url.QueryEscape(password)
+
 	retriesConfig := api.ClientRetriesConfiguration{
 		MaxRetries:      clients.CommonConfig.MaxRetries,
 		RetryMinDelay:   clients.CommonConfig.MinRetryInterval,
@@ -599,8 +735,8 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		Host:                 host,
 		Scheme:               "https",
 		UserAgent:            "terraform-provider-nsxt",
-		UserName:             username,
-		Password:             password,
+		UserName:             escapedUsername,
+		Password:             escapedPassword,
 		RemoteAuth:           clients.CommonConfig.RemoteAuth,
 		ClientAuthCertFile:   clientAuthCertFile,
 		ClientAuthKeyFile:    clientAuthKeyFile,
@@ -613,12 +749,33 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		SkipSessionAuth:      skipSessionAuth,
 	}
 
+	err := api.InitHttpClient(clients.NsxtClientConfig)
+	if clients.NsxtClientConfig.HTTPClient == nil {
+		return err
+	}
+
+	transport := clients.NsxtClientConfig.HTTPClient.Transport
+	if clients.RateLimiter != nil {
+		transport = newRateLimitRoundTripper(clients.RateLimiter, transport)
+		clients.NsxtClientConfig.HTTPClient.Transport = transport
+	}
+
+	if sessionAuth {
+		// Session is initially created by MP SDK, and re-created by the
+		// provider whenever NSX reports it as expired
+		clients.Session = newNsxtSession(host, username, password, clients.CommonConfig.RemoteAuth, transport)
+		clients.NsxtClientConfig.HTTPClient.Transport = newSessionRoundTripper(clients.Session, transport)
+	}
+
 	nsxClient, err := api.NewAPIClient(clients.NsxtClientConfig)
 	if err != nil {
 		return err
 	}
 
 	clients.NsxtClient = nsxClient
+	if clients.Session != nil {
+		clients.Session.setHeaders(clients.NsxtClientConfig.DefaultHeader["Cookie"], clients.NsxtClientConfig.DefaultHeader["X-XSRF-TOKEN"])
+	}
 
 	return nil
 }
@@ -818,13 +975,32 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 	}
 
 	tr := &http.Transport{
-		Proxy:           http.ProxyFromEnvironment,
-		TLSClientConfig: tlsConfig,
+		Proxy:               http.ProxyFromEnvironment,
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConns:        d.Get("max_idle_conns").(int),
+		MaxIdleConnsPerHost: d.Get("max_idle_conns_per_host").(int),
+		IdleConnTimeout:     time.Duration(d.Get("idle_conn_timeout").(int)) * time.Second,
 	}
 
-	httpClient := http.Client{Transport: tr}
+	var transport http.RoundTripper = tr
+	if clients.RateLimiter != nil {
+		transport = newRateLimitRoundTripper(clients.RateLimiter, tr)
+	}
+	clients.PolicyTransport = transport
+	httpClient := http.Client{Transport: transport}
+	if clients.Session != nil {
+		httpClient.Transport = newSessionRoundTripper(clients.Session, transport)
+	}
+	if clients.CommonConfig.BatchWindow > 0 {
+		window := time.Duration(clients.CommonConfig.BatchWindow) * time.Millisecond
+		httpClient.Transport = newPolicyBatchingRoundTripper(window, clients.CommonConfig.BatchMaxObjects, httpClient.Transport)
+	}
+	if os.Getenv("TF_LOG_PROVIDER_NSX_HTTP") == httpLogModeJSON {
+		httpClient.Transport = newStructuredLogRoundTripper(httpClient.Transport)
+	}
 	clients.PolicyHTTPClient = &httpClient
 	clients.Host = host
+	clients.VmcAuthInfo = vmcInfo
 	clients.PolicyEnforcementPoint = policyEnforcementPoint
 	clients.PolicyGlobalManager = policyGlobalManager
 
@@ -910,44 +1086,6 @@ func (processor remoteAuthHeaderProcessor) Process(req *http.Request) error {
 	return nil
 }
 
-type logRequestProcessor struct {
-}
-
-func newLogRequestProcessor() *logRequestProcessor {
-	return &logRequestProcessor{}
-}
-
-func (processor logRequestProcessor) Process(req *http.Request) error {
-	reqDump, err := httputil.DumpRequestOut(req, true)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// Replace sensitive information in HTTP headers
-	authHeaderRegexp := regexp.MustCompile(`(?i)Authorization:.*`)
-	cspHeaderRegexp := regexp.MustCompile(`(?i)Csp-Auth-Token:.*`)
-	replaced := authHeaderRegexp.ReplaceAllString(string(reqDump), "<Omitted Authorization header>")
-	replaced = cspHeaderRegexp.ReplaceAllString(replaced, "<Omitted Csp-Auth-Token header>")
-
-	log.Printf("Issuing request towards NSX:\n%s", replaced)
-	return nil
-}
-
-type logResponseAcceptor struct {
-}
-
-func newLogResponseAcceptor() *logResponseAcceptor {
-	return &logResponseAcceptor{}
-}
-
-func (processor logResponseAcceptor) Accept(req *http.Response) {
-	dumpResponse, err := httputil.DumpResponse(req, true)
-	if err != nil {
-		log.Fatal(err)
-	}
-	log.Printf("Received NSX response:\n%s", dumpResponse)
-}
-
 type bearerAuthHeaderProcessor struct {
 	Token string
 }
@@ -963,20 +1101,19 @@ func (processor bearerAuthHeaderProcessor) Process(req *http.Request) error {
 }
 
 type sessionHeaderProcessor struct {
-	cookie string
-	xsrf   string
+	session *nsxtSession
 }
 
-func newSessionHeaderProcessor(cookie string, xsrf string) *sessionHeaderProcessor {
+func newSessionHeaderProcessor(session *nsxtSession) *sessionHeaderProcessor {
 	return &sessionHeaderProcessor{
-		cookie: cookie,
-		xsrf:   xsrf,
+		session: session,
 	}
 }
 
 func (processor sessionHeaderProcessor) Process(req *http.Request) error {
-	req.Header.Set("Cookie", processor.cookie)
-	req.Header.Set("X-XSRF-TOKEN", processor.xsrf)
+	cookie, xsrf := processor.session.getHeaders()
+	req.Header.Set("Cookie", cookie)
+	req.Header.Set("X-XSRF-TOKEN", xsrf)
 	return nil
 }
 
@@ -1055,6 +1192,28 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 		retryStatuses = append(retryStatuses, defaultRetryOnStatusCodes...)
 	}
 
+	defaultProjectID := ""
+	for _, item := range d.Get("default_context").([]interface{}) {
+		data := item.(map[string]interface{})
+		defaultProjectID = data["project_id"].(string)
+	}
+
+	apiRateLimit := float64(0)
+	apiRateBurst := 0
+	for _, item := range d.Get("api_rate_limit").([]interface{}) {
+		data := item.(map[string]interface{})
+		apiRateLimit = data["requests_per_second"].(float64)
+		apiRateBurst = data["burst"].(int)
+	}
+
+	batchWindow := 0
+	batchMaxObjects := 0
+	for _, item := range d.Get("policy_batching").([]interface{}) {
+		data := item.(map[string]interface{})
+		batchWindow = data["window_ms"].(int)
+		batchMaxObjects = data["max_objects"].(int)
+	}
+
 	licenses := interfaceListToStringList(d.Get("license_keys").([]interface{}))
 	return commonProviderConfig{
 		RemoteAuth:             remoteAuth,
@@ -1063,9 +1222,18 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 		MinRetryInterval:       retryMinDelay,
 		MaxRetryInterval:       retryMaxDelay,
 		RetryStatusCodes:       retryStatuses,
+		APIRateLimit:           apiRateLimit,
+		APIRateBurst:           apiRateBurst,
+		BatchWindow:            batchWindow,
+		BatchMaxObjects:        batchMaxObjects,
 		Username:               username,
 		Password:               password,
 		LicenseKeys:            licenses,
+		DefaultProjectID:       defaultProjectID,
+		VersionCheck:           d.Get("version_check").(string),
+		EnforceRevision:        d.Get("enforce_revision").(bool),
+		RevisionConflict:       d.Get("revision_conflict").(string),
+		DefaultTags:            d.Get("default_tags").(*schema.Set).List(),
 	}
 }
 
@@ -1073,6 +1241,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	commonConfig := initCommonConfig(d)
 	clients := nsxtClients{
 		CommonConfig: commonConfig,
+		RateLimiter:  newAPIRateLimiter(commonConfig.APIRateLimit, commonConfig.APIRateBurst),
+	}
+
+	if commonConfig.DefaultProjectID != "" && d.Get("global_manager").(bool) {
+		return nil, fmt.Errorf("default_context is not supported with global manager")
 	}
 
 	err := configureNsxtClient(d, &clients)
@@ -1085,12 +1258,19 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
+	clients.PolicyConnector = newPolicyConnector(clients, nil, true)
+
 	return clients, nil
 }
 
 // Standard policy connection that initializes global connection settings on demand
 func getPolicyConnector(clients interface{}) client.Connector {
-	return getPolicyConnectorWithHeaders(clients, nil, false, true)
+	c := clients.(nsxtClients)
+	if c.PolicyConnector == nil {
+		return getPolicyConnectorWithHeaders(clients, nil, false, true)
+	}
+	initPolicyConnection(c, c.PolicyConnector)
+	return c.PolicyConnector
 }
 
 // Standalone policy connector, possibly for different endpoint,
@@ -1102,6 +1282,35 @@ func getStandalonePolicyConnector(clients interface{}, withRetry bool) client.Co
 
 func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[string]string, standaloneFlow bool, withRetry bool) client.Connector {
 	c := clients.(nsxtClients)
+	connector := newPolicyConnector(c, customHeaders, withRetry)
+	// Global connection settings are skipped if the connector is for special
+	// purpose, or for different endpoint
+	if !standaloneFlow {
+		initPolicyConnection(c, connector)
+	}
+	return connector
+}
+
+// Guards on demand initialization of NSX version and licenses
+var policyConnectionInitMutex sync.Mutex
+
+// Init NSX version on demand if not done yet
+// This is also our indication to apply licenses, in case of delayed connection
+func initPolicyConnection(c nsxtClients, connector client.Connector) {
+	policyConnectionInitMutex.Lock()
+	defer policyConnectionInitMutex.Unlock()
+	if util.NsxVersion != "" {
+		return
+	}
+
+	initNSXVersion(connector)
+	err := configureLicenses(connector, c.CommonConfig.LicenseKeys)
+	if err != nil {
+		log.Printf("[ERROR]: Failed to apply NSX licenses")
+	}
+}
+
+func newPolicyConnector(c nsxtClients, customHeaders *map[string]string, withRetry bool) client.Connector {
 
 	retryFunc := func(retryContext retry.RetryContext) bool {
 		shouldRetry := false
@@ -1122,18 +1331,26 @@ func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[strin
 			return false
 		}
 
-		min := c.CommonConfig.MinRetryInterval
-		max := c.CommonConfig.MaxRetryInterval
-		if max > 0 {
-			interval := (rand.Intn(max-min) + min)
-			time.Sleep(time.Duration(interval) * time.Millisecond)
-			log.Printf("[DEBUG]: Waited %d ms before retrying", interval)
+		delay := getRetryBackoff(retryContext.Attempt, c.CommonConfig.MinRetryInterval, c.CommonConfig.MaxRetryInterval)
+		if retryAfter, ok := getRetryAfter(retryContext.Response); ok && retryAfter > delay {
+			// NSX explicitly asked to back off
+			delay = retryAfter
+		}
+		if delay > 0 {
+			time.Sleep(delay)
+			log.Printf("[DEBUG]: Waited %v before retrying", delay)
 		}
 
 		return true
 	}
 
-	connectorOptions := []client.ConnectorOption{client.UsingRest(nil), client.WithHttpClient(c.PolicyHTTPClient)}
+	// Application context is set explicitly, since lazy initialization in the SDK
+	// is not safe for concurrent use
+	connectorOptions := []client.ConnectorOption{
+		client.UsingRest(nil),
+		client.WithHttpClient(c.PolicyHTTPClient),
+		client.WithApplicationContext(core.NewApplicationContext(nil)),
+	}
 	var requestProcessors []core.RequestProcessor
 	var responseAcceptors []core.ResponseAcceptor
 
@@ -1155,20 +1372,21 @@ func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[strin
 	}
 
 	// Session support for policy resources (main rationale - vIDM environment where auth is slow)
-	// Currently session creation is done via old MP sdk.
+	// Initial session creation is done via old MP sdk, expired session is re-created
+	// by session round tripper of the policy HTTP client.
 	// TODO - when MP resources are removed, switch to official SDK to initiate session/create API
-	// TODO - re-trigger session/create when token is expired
-	if c.NsxtClientConfig != nil && len(c.NsxtClientConfig.DefaultHeader["Cookie"]) > 0 {
-		cookie := c.NsxtClientConfig.DefaultHeader["Cookie"]
-		xsrf := ""
-		if len(c.NsxtClientConfig.DefaultHeader["X-XSRF-TOKEN"]) > 0 {
-			xsrf = c.NsxtClientConfig.DefaultHeader["X-XSRF-TOKEN"]
-		}
-		requestProcessors = append(requestProcessors, newSessionHeaderProcessor(cookie, xsrf).Process)
+	if c.Session != nil && c.Session.isActive() {
+		requestProcessors = append(requestProcessors, newSessionHeaderProcessor(c.Session).Process)
 		log.Printf("[INFO]: Session headers configured for policy objects")
 	}
 
-	if os.Getenv("TF_LOG_PROVIDER_NSX_HTTP") != "" {
+	if c.CommonConfig.EnforceRevision {
+		requestProcessors = append(requestProcessors, newRevisionRequestProcessor().Process)
+		responseAcceptors = append(responseAcceptors, newRevisionResponseAcceptor().Accept)
+	}
+
+	// Structured log is written by the policy HTTP client transport
+	if logMode := os.Getenv("TF_LOG_PROVIDER_NSX_HTTP"); logMode != "" && logMode != httpLogModeJSON {
 		requestProcessors = append(requestProcessors, newLogRequestProcessor().Process)
 		responseAcceptors = append(responseAcceptors, newLogResponseAcceptor().Accept)
 	}
@@ -1179,18 +1397,7 @@ func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[strin
 	if len(responseAcceptors) > 0 {
 		connectorOptions = append(connectorOptions, client.WithResponseAcceptors(responseAcceptors...))
 	}
-	connector := client.NewConnector(c.Host, connectorOptions...)
-	// Init NSX version on demand if not done yet
-	// This is also our indication to apply licenses, in case of delayed connection
-	// This step is skipped if the connector is for special purpose, or for different endpoint
-	if util.NsxVersion == "" && !standaloneFlow {
-		initNSXVersion(connector)
-		err := configureLicenses(connector, c.CommonConfig.LicenseKeys)
-		if err != nil {
-			log.Printf("[ERROR]: Failed to apply NSX licenses")
-		}
-	}
-	return connector
+	return client.NewConnector(c.Host, connectorOptions...)
 }
 
 func getPolicyEnforcementPoint(clients interface{}) string {
@@ -1214,7 +1421,11 @@ func getProjectIDFromSchema(d *schema.ResourceData) string {
 	if ctxPtr != nil {
 		contexts := ctxPtr.([]interface{})
 		for _, context := range contexts {
-			data := context.(map[string]interface{})
+			// Context block with empty project_id is read as nil
+			data, ok := context.(map[string]interface{})
+			if !ok {
+				return ""
+			}
 
 			return data["project_id"].(string)
 		}
@@ -1222,9 +1433,23 @@ func getProjectIDFromSchema(d *schema.ResourceData) string {
 	return ""
 }
 
+// Default project configured on provider level, if any
+func getDefaultProjectID(m interface{}) string {
+	clients, ok := m.(nsxtClients)
+	if !ok {
+		return ""
+	}
+	return clients.CommonConfig.DefaultProjectID
+}
+
 func getSessionContext(d *schema.ResourceData, m interface{}) tf_api.SessionContext {
 	var clientType tf_api.ClientType
 	projectID := getProjectIDFromSchema(d)
+	if contexts, ok := d.Get("context").([]interface{}); ok && len(contexts) == 0 {
+		// Resource supports multitenancy, but context is not specified. Context
+		// with empty project_id opts out of provider default context.
+		projectID = getDefaultProjectID(m)
+	}
 	if projectID != "" {
 		clientType = tf_api.Multitenancy
 	} else if isPolicyGlobalManager(m) {
@@ -1234,3 +1459,51 @@ func getSessionContext(d *schema.ResourceData, m interface{}) tf_api.SessionCont
 	}
 	return tf_api.SessionContext{ProjectID: projectID, ClientType: clientType}
 }
+
+func getProjectIDFromResourceDiff(d *schema.ResourceDiff, m interface{}) string {
+	for _, item := range d.Get("context").([]interface{}) {
+		if data, ok := item.(map[string]interface{}); ok {
+			return data["project_id"].(string)
+		}
+		return ""
+	}
+	return getDefaultProjectID(m)
+}
+
+// Tier0 gateway objects can not be used within project context
+func validateGatewayContextDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("gateway_path") {
+		return nil
+	}
+	isT0, _ := parseGatewayPolicyPath(d.Get("gateway_path").(string))
+	if isT0 && getProjectIDFromResourceDiff(d, m) != "" {
+		return handleMultitenancyTier0Error()
+	}
+	return nil
+}
+
+func addResourceCustomizeDiff(resource *schema.Resource, customizeDiff schema.CustomizeDiffFunc) {
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, customizeDiff)
+	} else {
+		resource.CustomizeDiff = customizeDiff
+	}
+}
+
+// addMultitenancyValidationToResources fails the plan for resources that do not
+// support multitenancy, if provider is configured with default project
+func addMultitenancyValidationToResources(resources map[string]*schema.Resource) {
+	for name, resource := range resources {
+		if _, ok := resource.Schema["context"]; ok {
+			continue
+		}
+
+		resourceName := name
+		addResourceCustomizeDiff(resource, func(_ context.Context, _ *schema.ResourceDiff, m interface{}) error {
+			if projectID := getDefaultProjectID(m); projectID != "" {
+				return fmt.Errorf("%s does not support multitenancy, and can not be used with provider default_context (project %s)", resourceName, projectID)
+			}
+			return nil
+		})
+	}
+}
@@ -0,0 +1,306 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider serves resources, data sources, ephemeral resources and
+// functions implemented with terraform-plugin-framework. It is muxed with the
+// SDK provider, which owns provider configuration: schema of the framework
+// provider mirrors the SDK one, and nsxtClients configured by the SDK provider
+// are shared with framework implementations.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+)
+
+func newFrameworkProvider(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+// NewMuxProviderServer combines the SDK provider with the framework provider
+// into a single provider server
+func NewMuxProviderServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+	// SDK provider goes first, so that it is configured before the framework
+	// provider picks up its clients
+	providers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(newFrameworkProvider(sdkProvider)),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "nsxt"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	// Only provider schema is needed, hence resources are not converted
+	sdkServer := schema.NewGRPCProviderServer(&schema.Provider{Schema: p.sdkProvider.Schema})
+	sdkResp, err := sdkServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve provider schema", err.Error())
+		return
+	}
+	if sdkResp.Provider == nil || sdkResp.Provider.Block == nil {
+		resp.Diagnostics.AddError("Failed to retrieve provider schema", "SDK provider returned empty schema")
+		return
+	}
+
+	attributes, blocks, err := frameworkProviderSchemaFromBlock(sdkResp.Provider.Block)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to convert provider schema", err.Error())
+		return
+	}
+	resp.Schema = providerschema.Schema{
+		Attributes:  attributes,
+		Blocks:      blocks,
+		Description: sdkResp.Provider.Block.Description,
+	}
+}
+
+// Configure shares clients of the SDK provider, which is configured first
+// by the mux server
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	meta := p.sdkProvider.Meta()
+	if meta == nil {
+		return
+	}
+
+	resp.ResourceData = meta
+	resp.DataSourceData = meta
+	resp.EphemeralResourceData = meta
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newAPITokenEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		newParsePolicyPathFunction,
+		newPolicyPathFunction,
+		newNormalizeIPAddressFunction,
+		newNormalizePortRangeFunction,
+	}
+}
+
+// getFrameworkClients returns nsxtClients shared by the SDK provider with
+// framework implementations, or nil if provider is not configured yet
+func getFrameworkClients(providerData any) (*nsxtClients, error) {
+	if providerData == nil {
+		return nil, nil
+	}
+	clients, ok := providerData.(nsxtClients)
+	if !ok {
+		return nil, fmt.Errorf("unexpected provider data type %T", providerData)
+	}
+	return &clients, nil
+}
+
+// frameworkProviderSchemaFromBlock converts provider schema, as reported by
+// the SDK provider, to framework provider schema. Both schemas need to be
+// identical for the mux server.
+func frameworkProviderSchemaFromBlock(block *tfprotov5.SchemaBlock) (map[string]providerschema.Attribute, map[string]providerschema.Block, error) {
+	attributes := make(map[string]providerschema.Attribute)
+	for _, attribute := range block.Attributes {
+		converted, err := frameworkProviderAttribute(attribute)
+		if err != nil {
+			return nil, nil, fmt.Errorf("attribute %s: %v", attribute.Name, err)
+		}
+		attributes[attribute.Name] = converted
+	}
+
+	blocks := make(map[string]providerschema.Block)
+	for _, nested := range block.BlockTypes {
+		nestedAttributes, nestedBlocks, err := frameworkProviderSchemaFromBlock(nested.Block)
+		if err != nil {
+			return nil, nil, fmt.Errorf("block %s: %v", nested.TypeName, err)
+		}
+		object := providerschema.NestedBlockObject{
+			Attributes: nestedAttributes,
+			Blocks:     nestedBlocks,
+		}
+		deprecation := frameworkDeprecationMessage(nested.Block.Deprecated)
+
+		switch nested.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			blocks[nested.TypeName] = providerschema.ListNestedBlock{
+				NestedObject:       object,
+				Description:        nested.Block.Description,
+				DeprecationMessage: deprecation,
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			blocks[nested.TypeName] = providerschema.SetNestedBlock{
+				NestedObject:       object,
+				Description:        nested.Block.Description,
+				DeprecationMessage: deprecation,
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSingle:
+			blocks[nested.TypeName] = providerschema.SingleNestedBlock{
+				Attributes:         nestedAttributes,
+				Blocks:             nestedBlocks,
+				Description:        nested.Block.Description,
+				DeprecationMessage: deprecation,
+			}
+		default:
+			return nil, nil, fmt.Errorf("block %s: unsupported nesting mode %s", nested.TypeName, nested.Nesting)
+		}
+	}
+	return attributes, blocks, nil
+}
+
+func frameworkDeprecationMessage(deprecated bool) string {
+	if deprecated {
+		return "This attribute is deprecated."
+	}
+	return ""
+}
+
+func frameworkProviderAttribute(attribute *tfprotov5.SchemaAttribute) (providerschema.Attribute, error) {
+	if attribute.Computed {
+		return nil, fmt.Errorf("computed attributes are not supported in provider schema")
+	}
+	deprecation := frameworkDeprecationMessage(attribute.Deprecated)
+
+	switch {
+	case attribute.Type.Is(tftypes.String):
+		return providerschema.StringAttribute{
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case attribute.Type.Is(tftypes.Bool):
+		return providerschema.BoolAttribute{
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case attribute.Type.Is(tftypes.Number):
+		return providerschema.NumberAttribute{
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	}
+
+	attrType, err := frameworkAttrType(attribute.Type)
+	if err != nil {
+		return nil, err
+	}
+	switch t := attrType.(type) {
+	case types.ListType:
+		return providerschema.ListAttribute{
+			ElementType:        t.ElemType,
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case types.SetType:
+		return providerschema.SetAttribute{
+			ElementType:        t.ElemType,
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case types.MapType:
+		return providerschema.MapAttribute{
+			ElementType:        t.ElemType,
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case types.ObjectType:
+		return providerschema.ObjectAttribute{
+			AttributeTypes:     t.AttrTypes,
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", attribute.Type)
+}
+
+func frameworkAttrType(t tftypes.Type) (attr.Type, error) {
+	switch {
+	case t.Is(tftypes.String):
+		return types.StringType, nil
+	case t.Is(tftypes.Bool):
+		return types.BoolType, nil
+	case t.Is(tftypes.Number):
+		return types.NumberType, nil
+	case t.Is(tftypes.List{}):
+		elemType, err := frameworkAttrType(t.(tftypes.List).ElementType)
+		return types.ListType{ElemType: elemType}, err
+	case t.Is(tftypes.Set{}):
+		elemType, err := frameworkAttrType(t.(tftypes.Set).ElementType)
+		return types.SetType{ElemType: elemType}, err
+	case t.Is(tftypes.Map{}):
+		elemType, err := frameworkAttrType(t.(tftypes.Map).ElementType)
+		return types.MapType{ElemType: elemType}, err
+	case t.Is(tftypes.Object{}):
+		attrTypes := make(map[string]attr.Type)
+		for name, attributeType := range t.(tftypes.Object).AttributeTypes {
+			converted, err := frameworkAttrType(attributeType)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[name] = converted
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
@@ -0,0 +1,76 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestMuxProviderServerSchema(t *testing.T) {
+	ctx := context.Background()
+	providerServer, err := NewMuxProviderServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mux server reports an error if provider schemas of muxed servers differ
+	resp, err := providerServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("Unexpected error in mux provider schema: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	if resp.Provider == nil || resp.Provider.Block == nil {
+		t.Fatalf("Expected provider schema")
+	}
+	if _, ok := resp.ResourceSchemas["nsxt_policy_group"]; !ok {
+		t.Errorf("Expected SDK resources to be served through mux server")
+	}
+	for _, name := range []string{"parse_policy_path", "policy_path", "normalize_ip_address", "normalize_port_range"} {
+		if _, ok := resp.Functions[name]; !ok {
+			t.Errorf("Expected function %s to be served through mux server", name)
+		}
+	}
+}
+
+func TestFrameworkProviderConfigure(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	sdkProvider := testConfigureSimulatorProvider(t, sim, nil)
+	p := newFrameworkProvider(sdkProvider)
+
+	var resp provider.ConfigureResponse
+	p.Configure(context.Background(), provider.ConfigureRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+
+	clients, err := getFrameworkClients(resp.ResourceData)
+	if err != nil || clients == nil {
+		t.Fatalf("Expected clients of SDK provider to be shared, got %v: %v", resp.ResourceData, err)
+	}
+	if getPolicyConnector(*clients) != getPolicyConnector(sdkProvider.Meta()) {
+		t.Errorf("Expected policy connector to be shared with SDK provider")
+	}
+	if resp.DataSourceData == nil || resp.EphemeralResourceData == nil {
+		t.Errorf("Expected clients to be shared with data sources and ephemeral resources")
+	}
+
+	// Unconfigured provider shares no clients
+	clients, err = getFrameworkClients(nil)
+	if err != nil || clients != nil {
+		t.Errorf("Expected no clients for unconfigured provider")
+	}
+}
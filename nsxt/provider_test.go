@@ -10,21 +10,27 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "github.com/vmware/go-vmware-nsxt"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
 )
 
 var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 var testAccConnector client.Connector
+var testAccSimulator *simulator.Server
+var testAccSimulatorOnce sync.Once
 
 func init() {
 
@@ -44,7 +50,277 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProviderSimulator(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	if util.NsxVersion != simulator.DefaultVersion {
+		t.Errorf("Expected NSX version %s, got %s", simulator.DefaultVersion, util.NsxVersion)
+	}
+
+	singleTag := []interface{}{map[string]interface{}{"scope": "scope1", "tag": "tag1"}}
+	cases := []struct {
+		resourceType string
+		config       map[string]interface{}
+	}{
+		{"nsxt_policy_group", map[string]interface{}{
+			"display_name": "simulator-group",
+			"tag":          singleTag,
+		}},
+		{"nsxt_policy_segment", map[string]interface{}{
+			"display_name":        "simulator-segment",
+			"transport_zone_path": "/infra/sites/default/enforcement-points/default/transport-zones/tz",
+			"subnet":              []interface{}{map[string]interface{}{"cidr": "12.12.2.1/24"}},
+		}},
+		{"nsxt_policy_tier1_gateway", map[string]interface{}{
+			"display_name":      "simulator-tier1",
+			"edge_cluster_path": "/infra/sites/default/enforcement-points/default/edge-clusters/ec",
+		}},
+		{"nsxt_policy_security_policy", map[string]interface{}{
+			"display_name": "simulator-policy",
+			"category":     "Application",
+			"rule": []interface{}{
+				map[string]interface{}{"display_name": "rule1", "action": "ALLOW"},
+				map[string]interface{}{"display_name": "rule2", "action": "DROP"},
+			},
+		}},
+	}
+
+	for _, tc := range cases {
+		res := provider.ResourcesMap[tc.resourceType]
+		d := schema.TestResourceDataRaw(t, res.Schema, tc.config)
+		if diags := testResourceCreate(res, d, provider.Meta()); diags.HasError() {
+			t.Fatalf("Failed to create %s: %v", tc.resourceType, diags)
+		}
+
+		path := d.Get("path").(string)
+		obj, ok := sim.Get(path)
+		if !ok || obj["display_name"] != tc.config["display_name"] {
+			t.Errorf("%s was not stored in simulator at %s", tc.resourceType, path)
+		}
+		if rules, ok := tc.config["rule"]; ok && d.Get("rule.#").(int) != len(rules.([]interface{})) {
+			t.Errorf("Expected %d rules in %s, got %d", len(rules.([]interface{})), tc.resourceType, d.Get("rule.#"))
+		}
+
+		if diags := testResourceRead(res, d, provider.Meta()); diags.HasError() || d.Id() == "" {
+			t.Errorf("Failed to read %s: %v", tc.resourceType, diags)
+		}
+
+		if diags := testResourceDelete(res, d, provider.Meta()); diags.HasError() {
+			t.Fatalf("Failed to delete %s: %v", tc.resourceType, diags)
+		}
+		if _, ok := sim.Get(path); ok {
+			t.Errorf("%s was not removed from simulator", tc.resourceType)
+		}
+	}
+}
+
+func testConfigureSimulatorProvider(t testing.TB, sim *simulator.Server, extraConfig map[string]interface{}) *schema.Provider {
+	provider := Provider()
+	config := map[string]interface{}{
+		"host":                 sim.Host(),
+		"username":             "admin",
+		"password":             "simulator",
+		"allow_unverified_ssl": true,
+	}
+	for key, value := range extraConfig {
+		config[key] = value
+	}
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+	if diags.HasError() {
+		t.Fatalf("Failed to configure provider against simulator: %v", diags)
+	}
+	return provider
+}
+
+// Resources implement either legacy or context-aware CRUD, helpers below
+// invoke whichever is defined
+func testResourceCreate(res *schema.Resource, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if res.CreateContext != nil {
+		return res.CreateContext(context.Background(), d, m)
+	}
+	return diag.FromErr(res.Create(d, m))
+}
+
+func testResourceRead(res *schema.Resource, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if res.ReadContext != nil {
+		return res.ReadContext(context.Background(), d, m)
+	}
+	return diag.FromErr(res.Read(d, m))
+}
+
+func testResourceUpdate(res *schema.Resource, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if res.UpdateContext != nil {
+		return res.UpdateContext(context.Background(), d, m)
+	}
+	return diag.FromErr(res.Update(d, m))
+}
+
+func testResourceDelete(res *schema.Resource, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if res.DeleteContext != nil {
+		return res.DeleteContext(context.Background(), d, m)
+	}
+	return diag.FromErr(res.Delete(d, m))
+}
+
+func TestProviderSharedPolicyConnector(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+	sim.Put("/infra/domains/default/groups/g1", simulator.Object{"display_name": "shared-group"})
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	connector := getPolicyConnector(provider.Meta())
+	if connector != getPolicyConnector(provider.Meta()) {
+		t.Fatalf("Expected same policy connector to be shared between operations")
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := domains.NewGroupsClient(getPolicyConnector(provider.Meta())).Get("default", "g1")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Concurrent read with shared connector failed: %v", err)
+		}
+	}
+}
+
+func TestProviderDefaultContext(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	provider := testConfigureSimulatorProvider(t, sim, map[string]interface{}{
+		"default_context": []interface{}{map[string]interface{}{"project_id": "default"}},
+	})
+
+	res := provider.ResourcesMap["nsxt_policy_group"]
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"display_name": "project-group"})
+	if diags := testResourceCreate(res, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("Failed to create group: %v", diags)
+	}
+	path := d.Get("path").(string)
+	if !strings.HasPrefix(path, "/orgs/default/projects/default/infra/") {
+		t.Errorf("Expected group to be created in default project, got path %s", path)
+	}
+	if diags := testResourceDelete(res, d, provider.Meta()); diags.HasError() {
+		t.Errorf("Failed to delete group: %v", diags)
+	}
+
+	// Context with empty project opts out of default context
+	config := map[string]interface{}{
+		"display_name": "infra-group",
+		"context":      []interface{}{map[string]interface{}{"project_id": ""}},
+	}
+	if diags := provider.ValidateResource("nsxt_policy_group", terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("Expected context with empty project to be valid: %v", diags)
+	}
+	d = schema.TestResourceDataRaw(t, res.Schema, config)
+	if diags := testResourceCreate(res, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("Failed to create group: %v", diags)
+	}
+	if path := d.Get("path").(string); !strings.HasPrefix(path, "/infra/") {
+		t.Errorf("Expected group to be created in infra, got path %s", path)
+	}
+	if diags := testResourceRead(res, d, provider.Meta()); diags.HasError() || d.Id() == "" {
+		t.Errorf("Failed to read group in infra: %v", diags)
+	}
+	if diags := testResourceDelete(res, d, provider.Meta()); diags.HasError() {
+		t.Errorf("Failed to delete group: %v", diags)
+	}
+
+	cases := []struct {
+		resourceType string
+		config       map[string]interface{}
+		expectedErr  string
+	}{
+		{"nsxt_policy_tier0_gateway", map[string]interface{}{"display_name": "t0"}, "does not support multitenancy"},
+		{"nsxt_policy_static_route", map[string]interface{}{
+			"display_name": "route",
+			"gateway_path": "/infra/tier-0s/t0",
+			"network":      "13.1.1.0/24",
+			"next_hop":     []interface{}{map[string]interface{}{"ip_address": "16.1.1.254"}},
+		}, "not supported with Tier0"},
+	}
+	for _, tc := range cases {
+		res := provider.ResourcesMap[tc.resourceType]
+		_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), provider.Meta())
+		if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+			t.Errorf("Expected plan error for %s with default project, got %v", tc.resourceType, err)
+		}
+
+		if _, ok := res.Schema["context"]; !ok {
+			continue
+		}
+		tc.config["context"] = []interface{}{map[string]interface{}{"project_id": ""}}
+		if _, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), provider.Meta()); err != nil {
+			t.Errorf("Expected %s to be planned outside of default project, got %v", tc.resourceType, err)
+		}
+	}
+}
+
+// Compares connection setup with connector allocated per operation over
+// default transport settings, versus shared connector over keep-alive transport
+func BenchmarkPolicyConnector(b *testing.B) {
+	cases := []struct {
+		name         string
+		config       map[string]interface{}
+		getConnector func(interface{}) client.Connector
+	}{
+		{"per-operation", map[string]interface{}{"max_idle_conns_per_host": 2}, func(m interface{}) client.Connector {
+			return getPolicyConnectorWithHeaders(m, nil, false, true)
+		}},
+		{"shared", nil, getPolicyConnector},
+	}
+
+	for _, tc := range cases {
+		b.Run(tc.name, func(b *testing.B) {
+			sim := simulator.NewServer()
+			defer sim.Close()
+			sim.Put("/infra/domains/default/groups/g1", simulator.Object{"display_name": "bench-group"})
+			provider := testConfigureSimulatorProvider(b, sim, tc.config)
+			initialConnections := sim.Connections()
+
+			b.SetParallelism(30)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					_, err := domains.NewGroupsClient(tc.getConnector(provider.Meta())).Get("default", "g1")
+					if err != nil {
+						b.Error(err)
+					}
+				}
+			})
+			b.ReportMetric(float64(sim.Connections()-initialConnections)/float64(b.N), "conns/op")
+		})
+	}
+}
+
+// When NSXT_TEST_SIMULATOR is set, acceptance tests run against in-memory
+// NSX API simulator rather than real NSX manager
+func testAccStartSimulator() {
+	if os.Getenv("NSXT_TEST_SIMULATOR") == "" {
+		return
+	}
+	testAccSimulatorOnce.Do(func() {
+		testAccSimulator = simulator.NewServer()
+		os.Setenv("NSXT_MANAGER_HOST", testAccSimulator.Host())
+		os.Setenv("NSXT_USERNAME", "admin")
+		os.Setenv("NSXT_PASSWORD", "simulator")
+		os.Setenv("NSXT_ALLOW_UNVERIFIED_SSL", "true")
+	})
+}
+
 func testAccPreCheck(t *testing.T) {
+	testAccStartSimulator()
 	var requiredVariables = []string{"NSXT_USERNAME", "NSXT_PASSWORD", "NSXT_MANAGER_HOST", "NSXT_ALLOW_UNVERIFIED_SSL"}
 	for _, element := range requiredVariables {
 		if v := os.Getenv(element); v == "" {
@@ -60,6 +336,7 @@ func testAccPreCheck(t *testing.T) {
 }
 
 func testAccGetClient() (*api.APIClient, error) {
+	testAccStartSimulator()
 	if os.Getenv("NSXT_MANAGER_HOST") == "" {
 		return nil, fmt.Errorf("NSXT_MANAGER_HOST is not set in environment")
 	}
@@ -135,6 +412,8 @@ func testAccGetPolicyConnector() (client.Connector, error) {
 		return testAccConnector, nil
 	}
 
+	testAccStartSimulator()
+
 	if os.Getenv("NSXT_MANAGER_HOST") == "" {
 		return nil, fmt.Errorf("NSXT_MANAGER_HOST is not set in environment")
 	}
@@ -0,0 +1,203 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NSX sends Retry-After header with these statuses when API rate limit is hit
+var retryAfterStatusCodes = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}
+
+// Upper bound for Retry-After, protecting from waiting on bogus header values
+const maxRetryAfterDelay = 5 * time.Minute
+
+// Base of exponential backoff when retry_min_delay is not configured
+const defaultRetryBackoffBase = 100 * time.Millisecond
+
+// apiRateLimiter is a token bucket shared by Policy and MP HTTP clients, so
+// that limit applies to the provider as a whole. In addition, the limiter
+// holds all requests while NSX asks clients to back off with Retry-After.
+type apiRateLimiter struct {
+	mutex sync.Mutex
+	// Tokens added per second, zero means no limit
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	// Requests are held until this time after NSX replied with Retry-After
+	pausedUntil time.Time
+}
+
+func newAPIRateLimiter(rate float64, burst int) *apiRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &apiRateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns time to wait before the
+// request can be sent. Tokens may go negative, which queues callers in order.
+func (l *apiRateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	var delay time.Duration
+	if l.rate > 0 {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+		l.tokens--
+		if l.tokens < 0 {
+			delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+		}
+	}
+
+	if pause := l.pausedUntil.Sub(now); pause > delay {
+		delay = pause
+	}
+	return delay
+}
+
+// wait blocks until the request is allowed by the limiter, or the context is done
+func (l *apiRateLimiter) wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pause holds all requests for given duration
+func (l *apiRateLimiter) pause(delay time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	until := time.Now().Add(delay)
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// rateLimitRoundTripper applies shared rate limiter to every request sent on
+// the wire, including retries and session re-creation
+type rateLimitRoundTripper struct {
+	limiter   *apiRateLimiter
+	transport http.RoundTripper
+}
+
+func newRateLimitRoundTripper(limiter *apiRateLimiter, transport http.RoundTripper) *rateLimitRoundTripper {
+	return &rateLimitRoundTripper{
+		limiter:   limiter,
+		transport: transport,
+	}
+}
+
+func (rt *rateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := rt.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	res, err := rt.transport.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	if delay, ok := getRetryAfter(res); ok {
+		log.Printf("[DEBUG]: NSX replied with status %d, holding requests for %v", res.StatusCode, delay)
+		rt.limiter.pause(delay)
+	}
+	return res, nil
+}
+
+// getRetryAfter parses Retry-After header of throttled response, which holds
+// either number of seconds or HTTP date
+func getRetryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	throttled := false
+	for _, code := range retryAfterStatusCodes {
+		if res.StatusCode == code {
+			throttled = true
+			break
+		}
+	}
+	value := strings.TrimSpace(res.Header.Get("Retry-After"))
+	if !throttled || value == "" {
+		return 0, false
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		delay = time.Until(date)
+	} else {
+		log.Printf("[WARNING]: Ignoring invalid Retry-After header %q", value)
+		return 0, false
+	}
+
+	if delay < 0 {
+		delay = 0
+	}
+	if delay > maxRetryAfterDelay {
+		delay = maxRetryAfterDelay
+	}
+	return delay, true
+}
+
+// getRetryBackoff returns delay before given retry attempt (starting from 0).
+// Upper bound of the delay grows exponentially from min delay, and is capped
+// by max delay. Actual delay is randomly picked between min delay and the
+// bound, so that concurrent requests do not retry in lockstep.
+func getRetryBackoff(attempt uint, minDelay int, maxDelay int) time.Duration {
+	min := time.Duration(minDelay) * time.Millisecond
+	max := time.Duration(maxDelay) * time.Millisecond
+	if max <= 0 {
+		return 0
+	}
+	if min > max {
+		min = max
+	}
+
+	bound := min
+	if bound <= 0 {
+		bound = defaultRetryBackoffBase
+	}
+	for i := uint(0); i < attempt && bound < max; i++ {
+		bound *= 2
+	}
+	if bound > max {
+		bound = max
+	}
+	if bound <= min {
+		return min
+	}
+
+	return min + time.Duration(rand.Int63n(int64(bound-min)))
+}
@@ -0,0 +1,160 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestGetRetryBackoff(t *testing.T) {
+	cases := []struct {
+		attempt  uint
+		minDelay int
+		maxDelay int
+		min      time.Duration
+		max      time.Duration
+	}{
+		{0, 0, 0, 0, 0},
+		{3, 100, 0, 0, 0},
+		{0, 0, 500, 0, defaultRetryBackoffBase},
+		{2, 0, 500, 0, 4 * defaultRetryBackoffBase},
+		{10, 0, 500, 0, 500 * time.Millisecond},
+		{0, 200, 1000, 200 * time.Millisecond, 200 * time.Millisecond},
+		{1, 200, 1000, 200 * time.Millisecond, 400 * time.Millisecond},
+		{5, 200, 1000, 200 * time.Millisecond, time.Second},
+		{1, 800, 500, 500 * time.Millisecond, 500 * time.Millisecond},
+	}
+
+	for _, tc := range cases {
+		for i := 0; i < 20; i++ {
+			delay := getRetryBackoff(tc.attempt, tc.minDelay, tc.maxDelay)
+			if delay < tc.min || delay > tc.max {
+				t.Errorf("Backoff for attempt %d with delays %d-%d: expected %v-%v, got %v",
+					tc.attempt, tc.minDelay, tc.maxDelay, tc.min, tc.max, delay)
+			}
+		}
+	}
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	cases := []struct {
+		status   int
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		{http.StatusTooManyRequests, "3", 3 * time.Second, true},
+		{http.StatusServiceUnavailable, "0", 0, true},
+		{http.StatusTooManyRequests, "", 0, false},
+		{http.StatusTooManyRequests, "soon", 0, false},
+		{http.StatusTooManyRequests, "100000", maxRetryAfterDelay, true},
+		{http.StatusConflict, "3", 0, false},
+		{http.StatusTooManyRequests, time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), 0, true},
+	}
+
+	for _, tc := range cases {
+		res := &http.Response{StatusCode: tc.status, Header: http.Header{}}
+		if tc.header != "" {
+			res.Header.Set("Retry-After", tc.header)
+		}
+		delay, ok := getRetryAfter(res)
+		if ok != tc.ok || delay != tc.expected {
+			t.Errorf("Retry-After %q with status %d: expected %v %v, got %v %v", tc.header, tc.status, tc.expected, tc.ok, delay, ok)
+		}
+	}
+
+	future := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	res := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": []string{future}}}
+	if delay, ok := getRetryAfter(res); !ok || delay <= 5*time.Second || delay > 10*time.Second {
+		t.Errorf("Retry-After date %s: expected about 10s, got %v %v", future, delay, ok)
+	}
+}
+
+func TestAPIRateLimiter(t *testing.T) {
+	limiter := newAPIRateLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Fatalf("Expected unlimited limiter not to delay requests, got %v", delay)
+		}
+	}
+
+	limiter = newAPIRateLimiter(10, 3)
+	for i := 0; i < 3; i++ {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Errorf("Expected burst request %d not to be delayed, got %v", i, delay)
+		}
+	}
+	if delay := limiter.reserve(); delay < 90*time.Millisecond || delay > 100*time.Millisecond {
+		t.Errorf("Expected request above burst to be delayed by 100ms, got %v", delay)
+	}
+
+	limiter = newAPIRateLimiter(0, 0)
+	limiter.pause(time.Second)
+	if delay := limiter.reserve(); delay < 900*time.Millisecond {
+		t.Errorf("Expected paused limiter to delay request by 1s, got %v", delay)
+	}
+}
+
+func TestProviderRetryAfter(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+	sim.Put("/infra/domains/default/groups/g1", simulator.Object{"display_name": "throttled-group"})
+
+	provider := testConfigureSimulatorProvider(t, sim, map[string]interface{}{"retry_max_delay": 0})
+	clients := provider.Meta().(nsxtClients)
+
+	sim.Throttle(1, "1")
+	start := time.Now()
+	_, err := domains.NewGroupsClient(getPolicyConnector(provider.Meta())).Get("default", "g1")
+	if err != nil {
+		t.Fatalf("Failed to read group after throttling: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected policy client to honour Retry-After, retried after %v", elapsed)
+	}
+
+	sim.Throttle(1, "1")
+	start = time.Now()
+	_, resp, err := clients.NsxtClient.LicensingApi.GetLicenses(clients.NsxtClient.Context)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Failed to list licenses with MP client after throttling: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected MP client to honour Retry-After, retried after %v", elapsed)
+	}
+}
+
+func TestProviderAPIRateLimit(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+	sim.Put("/infra/domains/default/groups/g1", simulator.Object{"display_name": "limited-group"})
+
+	provider := testConfigureSimulatorProvider(t, sim, map[string]interface{}{
+		"api_rate_limit": []interface{}{map[string]interface{}{"requests_per_second": 20.0, "burst": 1}},
+	})
+	clients := provider.Meta().(nsxtClients)
+	connector := getPolicyConnector(provider.Meta())
+
+	// Drain the bucket before measuring
+	clients.RateLimiter.reserve()
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := domains.NewGroupsClient(connector).Get("default", "g1"); err != nil {
+			t.Fatalf("Failed to read group: %v", err)
+		}
+		if _, _, err := clients.NsxtClient.LicensingApi.GetLicenses(clients.NsxtClient.Context); err != nil {
+			t.Fatalf("Failed to list licenses: %v", err)
+		}
+	}
+	// 10 requests at 20 per second, shared by Policy and MP clients
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Errorf("Expected requests to be rate limited, 10 requests took %v", elapsed)
+	}
+}
@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster"
@@ -24,6 +22,10 @@ var DefaultIPv4VirtualAddress = "0.0.0.0"
 
 var DefaultIPv6VirtualAddress = "::"
 
+var clusterVirtualIPv6Gate = newAttributeVersionGate("4.0.0", map[string][]string{
+	"nsxt_cluster_virtual_ip": {"ipv6_address"},
+})
+
 func resourceNsxtClusterVirualIP() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtClusterVirualIPCreate,
@@ -106,7 +108,7 @@ func setClusterVirtualIP(d *schema.ResourceData, m interface{}) error {
 		forceStr = nsxModel.ClusterVirtualIpProperties_FORCE_FALSE
 	}
 	var err error
-	if util.NsxVersionHigherOrEqual("4.0.0") {
+	if clusterVirtualIPv6Gate.isSupported() {
 		_, err = client.Setvirtualip(&forceStr, &ipv6Address, &ipAddress)
 	} else {
 		// IPv6 not supported
@@ -140,7 +142,7 @@ func resourceNsxtClusterVirualIPDelete(d *schema.ResourceData, m interface{}) er
 		log.Printf("[WARNING] Failed to clear virtual ip: %v", err)
 		return handleDeleteError("ClusterVirtualIP", id, err)
 	}
-	if util.NsxVersionHigherOrEqual("4.0.0") {
+	if clusterVirtualIPv6Gate.isSupported() {
 		_, err = client.Clearvirtualip6()
 		if err != nil {
 			log.Printf("[WARNING] Failed to clear virtual ipv6 ip: %v", err)
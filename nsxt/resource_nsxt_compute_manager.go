@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -30,6 +31,11 @@ func resourceNsxtComputeManager() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(
+				cty.GetAttrPath("credential").Index(anyListIndex).GetAttr("username_password_login").Index(anyListIndex).GetAttr("password"),
+				cty.GetAttrPath("credential").Index(anyListIndex).GetAttr("username_password_login").Index(anyListIndex).GetAttr("password_wo")),
+		},
 		Schema: map[string]*schema.Schema{
 			"revision":     getRevisionSchema(),
 			"description":  getDescriptionSchema(),
@@ -113,9 +119,15 @@ func resourceNsxtComputeManager() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Description: "The authentication password for login",
-										Required:    true,
+										Optional:    true,
 										Sensitive:   true,
+										ExactlyOneOf: []string{
+											"credential.0.username_password_login.0.password",
+											"credential.0.username_password_login.0.password_wo",
+										},
 									},
+									"password_wo":         getWriteOnlySchema("The authentication password for login, not stored in state"),
+									"password_wo_version": getWriteOnlyVersionSchema("password_wo"),
 									"thumbprint": {
 										Type:        schema.TypeString,
 										Description: "Thumbprint of the login server",
@@ -221,7 +233,7 @@ func resourceNsxtComputeManagerCreate(d *schema.ResourceData, m interface{}) err
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 
 	var accessLevelForOidc *string
 	alfo := d.Get("access_level_for_oidc").(string)
@@ -313,7 +325,8 @@ func getCredentialValues(d *schema.ResourceData) (*data.StructValue, error) {
 			dataValue, errs = converter.ConvertToVapi(cred, model.SessionLoginCredentialBindingType())
 
 		case "username_password_login":
-			password := cData["password"].(string)
+			passwordWOPath := cty.GetAttrPath("credential").IndexInt(0).GetAttr("username_password_login").IndexInt(0).GetAttr("password_wo")
+			password := getWriteOnlyOrString(d, passwordWOPath, cData["password"].(string))
 			thumbPrint := cData["thumbprint"].(string)
 			username := cData["username"].(string)
 
@@ -381,7 +394,7 @@ func resourceNsxtComputeManagerRead(d *schema.ResourceData, m interface{}) error
 	d.Set("revision", obj.Revision)
 	d.Set("description", obj.Description)
 	d.Set("display_name", obj.DisplayName)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 
 	d.Set("access_level_for_oidc", obj.AccessLevelForOidc)
 	d.Set("create_service_account", obj.CreateServiceAccount)
@@ -479,7 +492,7 @@ func resourceNsxtComputeManagerUpdate(d *schema.ResourceData, m interface{}) err
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
 	revision := int64(d.Get("revision").(int))
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	var accessLevelForOidc *string
 	alfo := d.Get("access_level_for_oidc").(string)
 	if alfo != "" {
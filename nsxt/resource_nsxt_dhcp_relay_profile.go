@@ -57,7 +57,7 @@ func resourceNsxtDhcpRelayProfileCreate(d *schema.ResourceData, m interface{}) e
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	serverAddresses := getStringListFromSchemaSet(d, "server_addresses")
 	dhcpRelayProfile := manager.DhcpRelayProfile{
 		Description:     description,
@@ -104,7 +104,7 @@ func resourceNsxtDhcpRelayProfileRead(d *schema.ResourceData, m interface{}) err
 	d.Set("revision", dhcpRelayProfile.Revision)
 	d.Set("description", dhcpRelayProfile.Description)
 	d.Set("display_name", dhcpRelayProfile.DisplayName)
-	setTagsInSchema(d, dhcpRelayProfile.Tags)
+	setTagsInSchema(d, dhcpRelayProfile.Tags, m)
 	d.Set("server_addresses", dhcpRelayProfile.ServerAddresses)
 
 	return nil
@@ -124,7 +124,7 @@ func resourceNsxtDhcpRelayProfileUpdate(d *schema.ResourceData, m interface{}) e
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	serverAddresses := interface2StringList(d.Get("server_addresses").(*schema.Set).List())
 	dhcpRelayProfile := manager.DhcpRelayProfile{
 		Revision:        revision,
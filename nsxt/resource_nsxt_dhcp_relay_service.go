@@ -53,7 +53,7 @@ func resourceNsxtDhcpRelayServiceCreate(d *schema.ResourceData, m interface{}) e
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	dhcpRelayProfileID := d.Get("dhcp_relay_profile_id").(string)
 	dhcpRelayService := manager.DhcpRelayService{
 		Description:        description,
@@ -100,7 +100,7 @@ func resourceNsxtDhcpRelayServiceRead(d *schema.ResourceData, m interface{}) err
 	d.Set("revision", dhcpRelayService.Revision)
 	d.Set("description", dhcpRelayService.Description)
 	d.Set("display_name", dhcpRelayService.DisplayName)
-	setTagsInSchema(d, dhcpRelayService.Tags)
+	setTagsInSchema(d, dhcpRelayService.Tags, m)
 	d.Set("dhcp_relay_profile_id", dhcpRelayService.DhcpRelayProfileId)
 
 	return nil
@@ -120,7 +120,7 @@ func resourceNsxtDhcpRelayServiceUpdate(d *schema.ResourceData, m interface{}) e
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	dhcpRelayProfileID := d.Get("dhcp_relay_profile_id").(string)
 	dhcpRelayService := manager.DhcpRelayService{
 		Revision:           revision,
@@ -100,7 +100,7 @@ func resourceNsxtDhcpServerIPPoolCreate(d *schema.ResourceData, m interface{}) e
 			StaticRoutes: opt121Routes,
 		}
 	}
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	pool := manager.DhcpIpPool{
 		DisplayName: displayName,
 		Description: description,
@@ -154,7 +154,7 @@ func resourceNsxtDhcpServerIPPoolRead(d *schema.ResourceData, m interface{}) err
 	d.Set("revision", pool.Revision)
 	d.Set("display_name", pool.DisplayName)
 	d.Set("description", pool.Description)
-	setTagsInSchema(d, pool.Tags)
+	setTagsInSchema(d, pool.Tags, m)
 	d.Set("logical_dhcp_server_id", serverID)
 	d.Set("gateway_ip", pool.GatewayIp)
 	setIPRangesInSchema(d, pool.AllocationRanges)
@@ -207,7 +207,7 @@ func resourceNsxtDhcpServerIPPoolUpdate(d *schema.ResourceData, m interface{}) e
 			StaticRoutes: opt121Routes,
 		}
 	}
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	pool := manager.DhcpIpPool{
 		DisplayName: displayName,
 		Description: description,
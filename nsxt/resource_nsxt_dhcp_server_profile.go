@@ -62,7 +62,7 @@ func resourceNsxtDhcpServerProfileCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	edgeClusterID := d.Get("edge_cluster_id").(string)
 	edgeClusterMemberIndexes := intList2int64List(d.Get("edge_cluster_member_indexes").([]interface{}))
 	dhcpProfile := manager.DhcpProfile{
@@ -111,7 +111,7 @@ func resourceNsxtDhcpServerProfileRead(d *schema.ResourceData, m interface{}) er
 	d.Set("revision", dhcpProfile.Revision)
 	d.Set("description", dhcpProfile.Description)
 	d.Set("display_name", dhcpProfile.DisplayName)
-	setTagsInSchema(d, dhcpProfile.Tags)
+	setTagsInSchema(d, dhcpProfile.Tags, m)
 	d.Set("edge_cluster_id", dhcpProfile.EdgeClusterId)
 	d.Set("edge_cluster_member_indexes", dhcpProfile.EdgeClusterMemberIndexes)
 
@@ -133,7 +133,7 @@ func resourceNsxtDhcpServerProfileUpdate(d *schema.ResourceData, m interface{})
 	description := d.Get("description").(string)
 	edgeClusterID := d.Get("edge_cluster_id").(string)
 	edgeClusterMemberIndexes := intList2int64List(d.Get("edge_cluster_member_indexes").([]interface{}))
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	dhcpProfile := manager.DhcpProfile{
 		DisplayName:              displayName,
@@ -119,7 +119,7 @@ func resourceNsxtEdgeClusterCreate(d *schema.ResourceData, m interface{}) error
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	clusterProfileBindings := getClusterProfileBindingsFromSchema(d)
 	members := getEdgeClusterMembersFromSchema(d)
 	allocationRules := getAllocationRulesFromSchema(d)
@@ -213,7 +213,7 @@ func resourceNsxtEdgeClusterRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", obj.Revision)
 	d.Set("description", obj.Description)
 	d.Set("display_name", obj.DisplayName)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 
 	setClusterProfileBindingsInSchema(d, obj)
 
@@ -293,7 +293,7 @@ func resourceNsxtEdgeClusterUpdate(d *schema.ResourceData, m interface{}) error
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	members := getEdgeClusterMembersFromSchema(d)
 	clusterProfileBindings := getClusterProfileBindingsFromSchema(d)
 	allocationRules := getAllocationRulesFromSchema(d)
@@ -65,7 +65,7 @@ func resourceNsxtEdgeHighAvailabilityProfileCreate(d *schema.ResourceData, m int
 	client := nsx.NewClusterProfilesClient(connector)
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	bfdAllowedHops := int64(d.Get("bfd_allowed_hops").(int))
 	bfdDeclareDeadMultiple := int64(d.Get("bfd_declare_dead_multiple").(int))
 	bfdProbeInterval := int64(d.Get("bfd_probe_interval").(int))
@@ -123,7 +123,7 @@ func resourceNsxtEdgeHighAvailabilityProfileRead(d *schema.ResourceData, m inter
 	d.Set("revision", obj.Revision)
 	d.Set("description", obj.Description)
 	d.Set("display_name", obj.DisplayName)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 	d.Set("bfd_allowed_hops", obj.BfdAllowedHops)
 	d.Set("bfd_declare_dead_multiple", obj.BfdDeclareDeadMultiple)
 	d.Set("bfd_probe_interval", obj.BfdProbeInterval)
@@ -144,7 +144,7 @@ func resourceNsxtEdgeHighAvailabilityProfileUpdate(d *schema.ResourceData, m int
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	bfdAllowedHops := int64(d.Get("bfd_allowed_hops").(int))
 	bfdDeclareDeadMultiple := int64(d.Get("bfd_declare_dead_multiple").(int))
 	bfdProbeInterval := int64(d.Get("bfd_probe_interval").(int))
@@ -4,13 +4,14 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
 	"time"
 
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
-
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,6 +33,9 @@ var ipAssignmentTypes = []string{
 const nodeTypeEdge = "EdgeNode"
 const nodeTypeHost = "HostNode"
 
+// Default time to wait for transport node removal
+const defaultTransportNodeDeleteTimeout = 20 * time.Minute
+
 var hostSwitchModeValues = []string{
 	model.StandardHostSwitch_HOST_SWITCH_MODE_STANDARD,
 	model.StandardHostSwitch_HOST_SWITCH_MODE_ENS,
@@ -74,13 +78,17 @@ var syslogProtocolValues = []string{
 
 func resourceNsxtEdgeTransportNode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtEdgeTransportNodeCreate,
-		Read:   resourceNsxtEdgeTransportNodeRead,
-		Update: resourceNsxtEdgeTransportNodeUpdate,
-		Delete: resourceNsxtEdgeTransportNodeDelete,
+		CreateContext: resourceNsxtEdgeTransportNodeCreate,
+		ReadContext:   resourceNsxtEdgeTransportNodeRead,
+		UpdateContext: resourceNsxtEdgeTransportNodeUpdate,
+		DeleteContext: resourceNsxtEdgeTransportNodeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(defaultTransportNodeDeleteTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		ValidateRawResourceConfigFuncs: getEdgeNodeUserSettingsWriteOnlyValidation(),
 		Schema: map[string]*schema.Schema{
 			"revision":     getRevisionSchema(),
 			"description":  getDescriptionSchema(),
@@ -149,6 +157,7 @@ func getEdgeNodeDeploymentConfigSchema() *schema.Schema {
 								Sensitive:   true,
 								Description: "Node audit user password",
 							},
+							"audit_password_wo": getWriteOnlySchema("Node audit user password, not stored in state"),
 							"audit_username": {
 								Type:        schema.TypeString,
 								Optional:    true,
@@ -156,10 +165,15 @@ func getEdgeNodeDeploymentConfigSchema() *schema.Schema {
 							},
 							"cli_password": {
 								Type:        schema.TypeString,
-								Required:    true,
+								Optional:    true,
 								Sensitive:   true,
 								Description: "Node cli password",
+								ExactlyOneOf: []string{
+									"deployment_config.0.node_user_settings.0.cli_password",
+									"deployment_config.0.node_user_settings.0.cli_password_wo",
+								},
 							},
+							"cli_password_wo": getWriteOnlySchema("Node cli password, not stored in state"),
 							"cli_username": {
 								Type:        schema.TypeString,
 								Optional:    true,
@@ -168,9 +182,19 @@ func getEdgeNodeDeploymentConfigSchema() *schema.Schema {
 							},
 							"root_password": {
 								Type:        schema.TypeString,
-								Required:    true,
+								Optional:    true,
 								Sensitive:   true,
 								Description: "Node root user password",
+								ExactlyOneOf: []string{
+									"deployment_config.0.node_user_settings.0.root_password",
+									"deployment_config.0.node_user_settings.0.root_password_wo",
+								},
+							},
+							"root_password_wo": getWriteOnlySchema("Node root user password, not stored in state"),
+							"password_wo_version": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "Version of write-only passwords, change to update the passwords on NSX",
 							},
 						},
 					},
@@ -297,6 +321,10 @@ func getEdgeNodeDeploymentConfigSchema() *schema.Schema {
 	}
 }
 
+var edgeTransportNodeUptModeGate = newAttributeVersionGate("4.0.0", map[string][]string{
+	"nsxt_edge_transport_node": {"node_settings.enable_upt_mode"},
+})
+
 func getEdgeNodeSettingsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
@@ -672,10 +700,10 @@ func getIPAssignmentSchema(required bool) *schema.Schema {
 	}
 }
 
-func getTransportNodeFromSchema(d *schema.ResourceData) (*model.TransportNode, error) {
+func getTransportNodeFromSchema(d *schema.ResourceData, m interface{}) (*model.TransportNode, error) {
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	failureDomain := d.Get("failure_domain").(string)
 	hostSwitchSpec, err := getHostSwitchSpecFromSchema(d, nodeTypeEdge)
 	if err != nil {
@@ -690,7 +718,7 @@ func getTransportNodeFromSchema(d *schema.ResourceData) (*model.TransportNode, e
 	fqdn := d.Get("fqdn").(string)
 	ipAddresses := interfaceListToStringList(d.Get("ip_addresses").([]interface{}))
 
-	deploymentConfig, err := getEdgeNodeDeploymentConfigFromSchema(d.Get("deployment_config"))
+	deploymentConfig, err := getEdgeNodeDeploymentConfigFromSchema(d)
 	if err != nil {
 		return nil, err
 	}
@@ -726,29 +754,39 @@ func getTransportNodeFromSchema(d *schema.ResourceData) (*model.TransportNode, e
 	return &obj, nil
 }
 
-func resourceNsxtEdgeTransportNodeCreate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func resourceNsxtEdgeTransportNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 	client := nsx.NewTransportNodesClient(connector)
 
-	obj, err := getTransportNodeFromSchema(d)
+	obj, err := getTransportNodeFromSchema(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating Transport Node with name %s", *obj.DisplayName)
 
 	obj1, err := client.Create(*obj)
 	if err != nil {
-		return handleCreateError("TransportNode", *obj.DisplayName, err)
+		return handleContextError(ctx, "TransportNode creation", handleCreateError("TransportNode", *obj.DisplayName, err))
 	}
 
 	d.SetId(*obj1.Id)
-	return resourceNsxtEdgeTransportNodeRead(d, m)
+	return resourceNsxtEdgeTransportNodeRead(ctx, d, m)
+}
+
+func getEdgeNodeUserSettingsWriteOnlyValidation() []schema.ValidateRawResourceConfigFunc {
+	var funcs []schema.ValidateRawResourceConfigFunc
+	settingsPath := cty.GetAttrPath("deployment_config").Index(anyListIndex).GetAttr("node_user_settings").Index(anyListIndex)
+	for _, attrName := range []string{"audit_password", "cli_password", "root_password"} {
+		funcs = append(funcs, validation.PreferWriteOnlyAttribute(settingsPath.GetAttr(attrName), settingsPath.GetAttr(attrName+"_wo")))
+	}
+	return funcs
 }
 
-func getEdgeNodeDeploymentConfigFromSchema(cfg interface{}) (*model.EdgeNodeDeploymentConfig, error) {
+func getEdgeNodeDeploymentConfigFromSchema(d *schema.ResourceData) (*model.EdgeNodeDeploymentConfig, error) {
 	converter := bindings.NewTypeConverter()
 
+	cfg := d.Get("deployment_config")
 	if cfg == nil {
 		return nil, nil
 	}
@@ -759,11 +797,12 @@ func getEdgeNodeDeploymentConfigFromSchema(cfg interface{}) (*model.EdgeNodeDepl
 		if c["node_user_settings"] != nil {
 			for _, nusi := range c["node_user_settings"].([]interface{}) {
 				nus := nusi.(map[string]interface{})
-				auditPassword := nus["audit_password"].(string)
+				settingsPath := cty.GetAttrPath("deployment_config").IndexInt(0).GetAttr("node_user_settings").IndexInt(0)
+				auditPassword := getWriteOnlyOrString(d, settingsPath.GetAttr("audit_password_wo"), nus["audit_password"].(string))
 				auditUsername := nus["audit_username"].(string)
-				cliPassword := nus["cli_password"].(string)
+				cliPassword := getWriteOnlyOrString(d, settingsPath.GetAttr("cli_password_wo"), nus["cli_password"].(string))
 				cliUsername := nus["cli_username"].(string)
-				rootPassword := nus["root_password"].(string)
+				rootPassword := getWriteOnlyOrString(d, settingsPath.GetAttr("root_password_wo"), nus["root_password"].(string))
 
 				nodeUserSettings = &model.NodeUserSettings{
 					CliPassword:  &cliPassword,
@@ -898,7 +937,7 @@ func getEdgeNodeSettingsFromSchema(s interface{}) (*model.EdgeNodeSettings, erro
 			SearchDomains:         searchDomains,
 			SyslogServers:         syslogServers,
 		}
-		if util.NsxVersionHigherOrEqual("4.0.0") {
+		if edgeTransportNodeUptModeGate.isSupported() {
 			obj.EnableUptMode = &enableUptMode
 		}
 		return obj, nil
@@ -1196,50 +1235,50 @@ func getTransportNodeSubProfileCfg(iface interface{}) []model.TransportNodeProfi
 	return cfgList
 }
 
-func resourceNsxtEdgeTransportNodeRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func resourceNsxtEdgeTransportNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewTransportNodesClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "TransportNode", id, err)
+		return handleContextError(ctx, "TransportNode read", handleReadError(d, "TransportNode", id, err))
 	}
 
 	d.Set("revision", obj.Revision)
 	d.Set("description", obj.Description)
 	d.Set("display_name", obj.DisplayName)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 	d.Set("failure_domain", obj.FailureDomainId)
 
 	if obj.HostSwitchSpec != nil {
 		err = setHostSwitchSpecInSchema(d, obj.HostSwitchSpec, nodeTypeEdge)
 		if err != nil {
-			return handleReadError(d, "TransportNode", id, err)
+			return diag.FromErr(handleReadError(d, "TransportNode", id, err))
 		}
 	}
 
 	converter := bindings.NewTypeConverter()
 	base, errs := converter.ConvertToGolang(obj.NodeDeploymentInfo, model.EdgeNodeBindingType())
 	if errs != nil {
-		return handleReadError(d, "TransportNode", id, errs[0])
+		return diag.FromErr(handleReadError(d, "TransportNode", id, errs[0]))
 	}
 	node := base.(model.EdgeNode)
 
 	if node.DeploymentConfig != nil {
 		err = setEdgeDeploymentConfigInSchema(d, node.DeploymentConfig)
 		if err != nil {
-			return handleReadError(d, "TransportNode", id, err)
+			return diag.FromErr(handleReadError(d, "TransportNode", id, err))
 		}
 	}
 
 	if node.NodeSettings != nil {
 		err = setEdgeNodeSettingsInSchema(d, node.NodeSettings)
 		if err != nil {
-			return handleReadError(d, "TransportNode", id, err)
+			return diag.FromErr(handleReadError(d, "TransportNode", id, err))
 		}
 	}
 
@@ -1249,7 +1288,7 @@ func resourceNsxtEdgeTransportNodeRead(d *schema.ResourceData, m interface{}) er
 	d.Set("ip_addresses", node.IpAddresses)
 
 	if err != nil {
-		return handleReadError(d, "TransportNode", id, err)
+		return diag.FromErr(handleReadError(d, "TransportNode", id, err))
 	}
 
 	return nil
@@ -1537,31 +1576,31 @@ func setHostSwitchProfileIDsInSchema(hspIDs []model.HostSwitchProfileTypeIdEntry
 	return hostSwitchProfileIDs
 }
 
-func resourceNsxtEdgeTransportNodeUpdate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func resourceNsxtEdgeTransportNodeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewTransportNodesClient(connector)
 
-	obj, err := getTransportNodeFromSchema(d)
+	obj, err := getTransportNodeFromSchema(d, m)
 	if err != nil {
-		return handleUpdateError("TransportNode", id, err)
+		return diag.FromErr(handleUpdateError("TransportNode", id, err))
 	}
 	revision := int64(d.Get("revision").(int))
 	obj.Revision = &revision
 
 	_, err = client.Update(id, *obj, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
-		return handleUpdateError("TransportNode", id, err)
+		return handleContextError(ctx, "TransportNode update", handleUpdateError("TransportNode", id, err))
 	}
 
-	return resourceNsxtEdgeTransportNodeRead(d, m)
+	return resourceNsxtEdgeTransportNodeRead(ctx, d, m)
 }
 
-func getTransportNodeStateConf(connector client.Connector, id string) *resource.StateChangeConf {
+func getTransportNodeStateConf(connector client.Connector, id string, timeout time.Duration) *resource.StateChangeConf {
 	return &resource.StateChangeConf{
 		Pending: []string{"notyet"},
 		Target:  []string{"success", "failed"},
@@ -1582,30 +1621,30 @@ func getTransportNodeStateConf(connector client.Connector, id string) *resource.
 			return "notyet", "notyet", nil
 		},
 		Delay:        time.Duration(5) * time.Second,
-		Timeout:      time.Duration(1200) * time.Second,
+		Timeout:      timeout,
 		PollInterval: time.Duration(5) * time.Second,
 	}
 }
 
-func resourceNsxtEdgeTransportNodeDelete(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func resourceNsxtEdgeTransportNodeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewTransportNodesClient(connector)
 
 	err := client.Delete(id, nil, nil)
 	if err != nil {
-		return handleDeleteError("TransportNode", id, err)
+		return handleContextError(ctx, "TransportNode deletion", handleDeleteError("TransportNode", id, err))
 	}
 
-	stateConf := getTransportNodeStateConf(connector, id)
-	_, err = stateConf.WaitForState()
+	stateConf := getTransportNodeStateConf(connector, id, d.Timeout(schema.TimeoutDelete))
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get deletion status for %s: %v", id, err)
+		return handleContextError(ctx, "TransportNode deletion", fmt.Errorf("failed to get deletion status for %s: %v", id, err))
 	}
 
 	return nil
@@ -58,7 +58,7 @@ func resourceNsxtEtherTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	etherType := int64(d.Get("ether_type").(int))
 
 	nsService := manager.EtherTypeNsService{
@@ -112,7 +112,7 @@ func resourceNsxtEtherTypeNsServiceRead(d *schema.ResourceData, m interface{}) e
 	d.Set("revision", nsService.Revision)
 	d.Set("description", nsService.Description)
 	d.Set("display_name", nsService.DisplayName)
-	setTagsInSchema(d, nsService.Tags)
+	setTagsInSchema(d, nsService.Tags, m)
 	d.Set("default_service", nsService.DefaultService)
 	d.Set("ether_type", nsserviceElement.EtherType)
 
@@ -132,7 +132,7 @@ func resourceNsxtEtherTypeNsServiceUpdate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	etherType := int64(d.Get("ether_type").(int))
 
@@ -61,7 +61,7 @@ func resourceNsxtFailureDomainRead(d *schema.ResourceData, m interface{}) error
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 	d.Set("revision", obj.Revision)
 
 	preferPtr := obj.PreferredActiveEdgeServices
@@ -77,10 +77,10 @@ func resourceNsxtFailureDomainRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func failureDomainSchemaToModel(d *schema.ResourceData) model.FailureDomain {
+func failureDomainSchemaToModel(d *schema.ResourceData, m interface{}) model.FailureDomain {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 
 	obj := model.FailureDomain{
 		DisplayName: &displayName,
@@ -105,7 +105,7 @@ func resourceNsxtFailureDomainCreate(d *schema.ResourceData, m interface{}) erro
 	connector := getPolicyConnector(m)
 	client := nsx.NewFailureDomainsClient(connector)
 
-	failureDomain := failureDomainSchemaToModel(d)
+	failureDomain := failureDomainSchemaToModel(d, m)
 	displayName := d.Get("display_name").(string)
 	log.Printf("[INFO] Creating Failure Domain %s", displayName)
 	obj, err := client.Create(failureDomain)
@@ -126,7 +126,7 @@ func resourceNsxtFailureDomainUpdate(d *schema.ResourceData, m interface{}) erro
 	connector := getPolicyConnector(m)
 	client := nsx.NewFailureDomainsClient(connector)
 
-	failureDomain := failureDomainSchemaToModel(d)
+	failureDomain := failureDomainSchemaToModel(d, m)
 	revision := int64(d.Get("revision").(int))
 	failureDomain.Revision = &revision
 
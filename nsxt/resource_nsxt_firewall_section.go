@@ -250,7 +250,7 @@ func resourceNsxtFirewallSectionCreate(d *schema.ResourceData, m interface{}) er
 	rules := getRulesFromSchema(d)
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	appliedTos := getResourceReferencesFromSchemaSet(d, "applied_to")
 	sectionType := d.Get("section_type").(string)
 	stateful := d.Get("stateful").(bool)
@@ -322,7 +322,7 @@ func resourceNsxtFirewallSectionRead(d *schema.ResourceData, m interface{}) erro
 	d.Set("is_default", firewallSection.IsDefault)
 	d.Set("section_type", firewallSection.SectionType)
 	d.Set("stateful", firewallSection.Stateful)
-	setTagsInSchema(d, firewallSection.Tags)
+	setTagsInSchema(d, firewallSection.Tags, m)
 	err = setRulesInSchema(d, firewallSection.Rules)
 	if err != nil {
 		return fmt.Errorf("Error during FirewallSection rules set in schema: %v", err)
@@ -361,7 +361,7 @@ func resourceNsxtFirewallSectionUpdate(d *schema.ResourceData, m interface{}) er
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	appliedTos := getResourceReferencesFromSchemaSet(d, "applied_to")
 	sectionType := d.Get("section_type").(string)
 	stateful := d.Get("stateful").(bool)
@@ -74,7 +74,7 @@ func resourceNsxtIcmpTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	icmpCode := int64(d.Get("icmp_code").(int))
 	icmpType := int64(d.Get("icmp_type").(int))
 	protocol := d.Get("protocol").(string)
@@ -131,7 +131,7 @@ func resourceNsxtIcmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) er
 	d.Set("revision", nsService.Revision)
 	d.Set("description", nsService.Description)
 	d.Set("display_name", nsService.DisplayName)
-	setTagsInSchema(d, nsService.Tags)
+	setTagsInSchema(d, nsService.Tags, m)
 	d.Set("default_service", nsService.DefaultService)
 	d.Set("icmp_type", nsserviceElement.IcmpType)
 	d.Set("icmp_code", nsserviceElement.IcmpCode)
@@ -153,7 +153,7 @@ func resourceNsxtIcmpTypeNsServiceUpdate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	icmpCode := int64(d.Get("icmp_code").(int))
 	icmpType := int64(d.Get("icmp_type").(int))
 	protocol := d.Get("protocol").(string)
@@ -53,7 +53,7 @@ func resourceNsxtIgmpTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 
 	nsService := manager.IgmpTypeNsService{
 		NsService: manager.NsService{
@@ -103,7 +103,7 @@ func resourceNsxtIgmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) er
 	d.Set("revision", nsService.Revision)
 	d.Set("description", nsService.Description)
 	d.Set("display_name", nsService.DisplayName)
-	setTagsInSchema(d, nsService.Tags)
+	setTagsInSchema(d, nsService.Tags, m)
 	d.Set("default_service", nsService.DefaultService)
 
 	return nil
@@ -122,7 +122,7 @@ func resourceNsxtIgmpTypeNsServiceUpdate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 
 	nsService := manager.IgmpTypeNsService{
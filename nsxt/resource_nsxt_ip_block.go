@@ -54,7 +54,7 @@ func resourceNsxtIPBlockCreate(d *schema.ResourceData, m interface{}) error {
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	cidr := d.Get("cidr").(string)
 	ipBlock := manager.IpBlock{
 		Description: description,
@@ -101,7 +101,7 @@ func resourceNsxtIPBlockRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", ipBlock.Revision)
 	d.Set("description", ipBlock.Description)
 	d.Set("display_name", ipBlock.DisplayName)
-	setTagsInSchema(d, ipBlock.Tags)
+	setTagsInSchema(d, ipBlock.Tags, m)
 	d.Set("cidr", ipBlock.Cidr)
 
 	return nil
@@ -121,7 +121,7 @@ func resourceNsxtIPBlockUpdate(d *schema.ResourceData, m interface{}) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	cidr := d.Get("cidr").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	ipBlock := manager.IpBlock{
 		DisplayName: displayName,
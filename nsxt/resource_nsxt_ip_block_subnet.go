@@ -89,7 +89,7 @@ func resourceNsxtIPBlockSubnetCreate(d *schema.ResourceData, m interface{}) erro
 	displayName := d.Get("display_name").(string)
 	blockID := d.Get("block_id").(string)
 	size := int64(d.Get("size").(int))
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ipBlockSubnet := manager.IpBlockSubnet{
 		DisplayName: displayName,
 		Description: description,
@@ -149,7 +149,7 @@ func resourceNsxtIPBlockSubnetRead(d *schema.ResourceData, m interface{}) error
 	d.Set("description", ipBlockSubnet.Description)
 	d.Set("block_id", ipBlockSubnet.BlockId)
 	d.Set("size", ipBlockSubnet.Size)
-	setTagsInSchema(d, ipBlockSubnet.Tags)
+	setTagsInSchema(d, ipBlockSubnet.Tags, m)
 	err = setAllocationRangesInSchema(d, ipBlockSubnet.AllocationRanges)
 	if err != nil {
 		return fmt.Errorf("Error during IpBlockSubnet allocation ranges set in schema: %v", err)
@@ -74,7 +74,7 @@ func resourceNsxtIPDiscoverySwitchingProfileCreate(d *schema.ResourceData, m int
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	dhcpSnoopingEnabled := d.Get("dhcp_snooping_enabled").(bool)
 	arpSnoopingEnabled := d.Get("arp_snooping_enabled").(bool)
 	arpBindingsLimit := d.Get("arp_bindings_limit").(int)
@@ -132,7 +132,7 @@ func resourceNsxtIPDiscoverySwitchingProfileRead(d *schema.ResourceData, m inter
 	d.Set("arp_snooping_enabled", switchingProfile.ArpSnoopingEnabled)
 	d.Set("arp_bindings_limit", switchingProfile.ArpBindingsLimit)
 	d.Set("vm_tools_enabled", switchingProfile.VmToolsEnabled)
-	setTagsInSchema(d, switchingProfile.Tags)
+	setTagsInSchema(d, switchingProfile.Tags, m)
 
 	return nil
 }
@@ -150,7 +150,7 @@ func resourceNsxtIPDiscoverySwitchingProfileUpdate(d *schema.ResourceData, m int
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	dhcpSnoopingEnabled := d.Get("dhcp_snooping_enabled").(bool)
 	arpSnoopingEnabled := d.Get("arp_snooping_enabled").(bool)
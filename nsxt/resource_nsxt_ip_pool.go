@@ -158,7 +158,7 @@ func resourceNsxtIPPoolCreate(d *schema.ResourceData, m interface{}) error {
 	displayName := d.Get("display_name").(string)
 	subnets := getSubnetsFromSchema(d)
 	description := d.Get("description").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ipPool := manager.IpPool{
 		DisplayName: displayName,
 		Description: description,
@@ -204,7 +204,7 @@ func resourceNsxtIPPoolRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("display_name", ipPool.DisplayName)
 	d.Set("description", ipPool.Description)
 	d.Set("revision", ipPool.Revision)
-	setTagsInSchema(d, ipPool.Tags)
+	setTagsInSchema(d, ipPool.Tags, m)
 	err = setSubnetsInSchema(d, ipPool.Subnets)
 	if err != nil {
 		return fmt.Errorf("Error during IpPool set in schema: %v", err)
@@ -227,7 +227,7 @@ func resourceNsxtIPPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	subnets := getSubnetsFromSchema(d)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	ipPool := manager.IpPool{
 		DisplayName: displayName,
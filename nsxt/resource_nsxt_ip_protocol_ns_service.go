@@ -60,7 +60,7 @@ func resourceNsxtIPProtocolNsServiceCreate(d *schema.ResourceData, m interface{}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	protocol := int64(d.Get("protocol").(int))
 
 	nsService := manager.IpProtocolNsService{
@@ -114,7 +114,7 @@ func resourceNsxtIPProtocolNsServiceRead(d *schema.ResourceData, m interface{})
 	d.Set("revision", nsService.Revision)
 	d.Set("description", nsService.Description)
 	d.Set("display_name", nsService.DisplayName)
-	setTagsInSchema(d, nsService.Tags)
+	setTagsInSchema(d, nsService.Tags, m)
 	d.Set("default_service", nsService.DefaultService)
 	d.Set("protocol", nsserviceElement.ProtocolNumber)
 
@@ -134,7 +134,7 @@ func resourceNsxtIPProtocolNsServiceUpdate(d *schema.ResourceData, m interface{}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	protocol := int64(d.Get("protocol").(int))
 
@@ -57,7 +57,7 @@ func resourceNsxtIPSetCreate(d *schema.ResourceData, m interface{}) error {
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ipAddresses := getStringListFromSchemaSet(d, "ip_addresses")
 	ipSet := manager.IpSet{
 		Description: description,
@@ -104,7 +104,7 @@ func resourceNsxtIPSetRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", ipSet.Revision)
 	d.Set("description", ipSet.Description)
 	d.Set("display_name", ipSet.DisplayName)
-	setTagsInSchema(d, ipSet.Tags)
+	setTagsInSchema(d, ipSet.Tags, m)
 	d.Set("ip_addresses", ipSet.IpAddresses)
 
 	return nil
@@ -124,7 +124,7 @@ func resourceNsxtIPSetUpdate(d *schema.ResourceData, m interface{}) error {
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ipAddresses := interface2StringList(d.Get("ip_addresses").(*schema.Set).List())
 	ipSet := manager.IpSet{
 		Revision:    revision,
@@ -69,7 +69,7 @@ func resourceNsxtLbClientSslProfileCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ciphers := getStringListFromSchemaSet(d, "ciphers")
 	preferServerCiphers := d.Get("prefer_server_ciphers").(bool)
 	protocols := getStringListFromSchemaSet(d, "protocols")
@@ -124,7 +124,7 @@ func resourceNsxtLbClientSslProfileRead(d *schema.ResourceData, m interface{}) e
 	d.Set("revision", lbClientSslProfile.Revision)
 	d.Set("description", lbClientSslProfile.Description)
 	d.Set("display_name", lbClientSslProfile.DisplayName)
-	setTagsInSchema(d, lbClientSslProfile.Tags)
+	setTagsInSchema(d, lbClientSslProfile.Tags, m)
 	d.Set("ciphers", lbClientSslProfile.Ciphers)
 	d.Set("is_secure", lbClientSslProfile.IsSecure)
 	d.Set("prefer_server_ciphers", lbClientSslProfile.PreferServerCiphers)
@@ -149,7 +149,7 @@ func resourceNsxtLbClientSslProfileUpdate(d *schema.ResourceData, m interface{})
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ciphers := getStringListFromSchemaSet(d, "ciphers")
 	preferServerCiphers := d.Get("prefer_server_ciphers").(bool)
 	protocols := getStringListFromSchemaSet(d, "protocols")
@@ -176,7 +176,7 @@ func resourceNsxtLbCookiePersistenceProfileCreate(d *schema.ResourceData, m inte
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	persistenceShared := d.Get("persistence_shared").(bool)
 	cookieFallback := d.Get("cookie_fallback").(bool)
 	cookieGarble := d.Get("cookie_garble").(bool)
@@ -235,7 +235,7 @@ func resourceNsxtLbCookiePersistenceProfileRead(d *schema.ResourceData, m interf
 	d.Set("revision", lbCookiePersistenceProfile.Revision)
 	d.Set("description", lbCookiePersistenceProfile.Description)
 	d.Set("display_name", lbCookiePersistenceProfile.DisplayName)
-	setTagsInSchema(d, lbCookiePersistenceProfile.Tags)
+	setTagsInSchema(d, lbCookiePersistenceProfile.Tags, m)
 	d.Set("persistence_shared", lbCookiePersistenceProfile.PersistenceShared)
 	d.Set("cookie_fallback", lbCookiePersistenceProfile.CookieFallback)
 	d.Set("cookie_garble", lbCookiePersistenceProfile.CookieGarble)
@@ -260,7 +260,7 @@ func resourceNsxtLbCookiePersistenceProfileUpdate(d *schema.ResourceData, m inte
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	persistenceShared := d.Get("persistence_shared").(bool)
 	cookieFallback := d.Get("cookie_fallback").(bool)
 	cookieGarble := d.Get("cookie_garble").(bool)
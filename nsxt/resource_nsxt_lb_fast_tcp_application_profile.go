@@ -69,7 +69,7 @@ func resourceNsxtLbFastTCPApplicationProfileCreate(d *schema.ResourceData, m int
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	closeTimeout := int64(d.Get("close_timeout").(int))
 	haFlowMirroringEnabled := d.Get("ha_flow_mirroring").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
@@ -120,7 +120,7 @@ func resourceNsxtLbFastTCPApplicationProfileRead(d *schema.ResourceData, m inter
 	d.Set("revision", lbFastTCPProfile.Revision)
 	d.Set("description", lbFastTCPProfile.Description)
 	d.Set("display_name", lbFastTCPProfile.DisplayName)
-	setTagsInSchema(d, lbFastTCPProfile.Tags)
+	setTagsInSchema(d, lbFastTCPProfile.Tags, m)
 	d.Set("close_timeout", lbFastTCPProfile.CloseTimeout)
 	d.Set("ha_flow_mirroring", lbFastTCPProfile.HaFlowMirroringEnabled)
 	d.Set("idle_timeout", lbFastTCPProfile.IdleTimeout)
@@ -142,7 +142,7 @@ func resourceNsxtLbFastTCPApplicationProfileUpdate(d *schema.ResourceData, m int
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	closeTimeout := int64(d.Get("close_timeout").(int))
 	haFlowMirroringEnabled := d.Get("ha_flow_mirroring").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
@@ -62,7 +62,7 @@ func resourceNsxtLbFastUDPApplicationProfileCreate(d *schema.ResourceData, m int
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	haFlowMirroringEnabled := d.Get("ha_flow_mirroring").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
 	lbFastUDPProfile := loadbalancer.LbFastUdpProfile{
@@ -110,7 +110,7 @@ func resourceNsxtLbFastUDPApplicationProfileRead(d *schema.ResourceData, m inter
 	d.Set("revision", lbFastUDPProfile.Revision)
 	d.Set("description", lbFastUDPProfile.Description)
 	d.Set("display_name", lbFastUDPProfile.DisplayName)
-	setTagsInSchema(d, lbFastUDPProfile.Tags)
+	setTagsInSchema(d, lbFastUDPProfile.Tags, m)
 	d.Set("ha_flow_mirroring", lbFastUDPProfile.FlowMirroringEnabled)
 	d.Set("idle_timeout", lbFastUDPProfile.IdleTimeout)
 
@@ -131,7 +131,7 @@ func resourceNsxtLbFastUDPApplicationProfileUpdate(d *schema.ResourceData, m int
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	haFlowMirroringEnabled := d.Get("ha_flow_mirroring").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
 	lbFastUDPProfile := loadbalancer.LbFastUdpProfile{
@@ -100,7 +100,7 @@ func resourceNsxtLbHTTPApplicationProfileCreate(d *schema.ResourceData, m interf
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	httpRedirectTo := d.Get("http_redirect_to").(string)
 	httpRedirectToHTTPS := d.Get("http_redirect_to_https").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
@@ -161,7 +161,7 @@ func resourceNsxtLbHTTPApplicationProfileRead(d *schema.ResourceData, m interfac
 	d.Set("revision", lbHTTPApplicationProfile.Revision)
 	d.Set("description", lbHTTPApplicationProfile.Description)
 	d.Set("display_name", lbHTTPApplicationProfile.DisplayName)
-	setTagsInSchema(d, lbHTTPApplicationProfile.Tags)
+	setTagsInSchema(d, lbHTTPApplicationProfile.Tags, m)
 	d.Set("http_redirect_to", lbHTTPApplicationProfile.HttpRedirectTo)
 	d.Set("http_redirect_to_https", lbHTTPApplicationProfile.HttpRedirectToHttps)
 	d.Set("idle_timeout", lbHTTPApplicationProfile.IdleTimeout)
@@ -188,7 +188,7 @@ func resourceNsxtLbHTTPApplicationProfileUpdate(d *schema.ResourceData, m interf
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	httpRedirectTo := d.Get("http_redirect_to").(string)
 	httpRedirectToHTTPS := d.Get("http_redirect_to_https").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
@@ -401,7 +401,7 @@ func resourceNsxtLbHTTPForwardingRuleCreate(d *schema.ResourceData, m interface{
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPForwardingConditionsFromSchema(d)
 	actions := getLbRuleForwardingActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
@@ -455,7 +455,7 @@ func resourceNsxtLbHTTPForwardingRuleRead(d *schema.ResourceData, m interface{})
 	d.Set("revision", lbRule.Revision)
 	d.Set("description", lbRule.Description)
 	d.Set("display_name", lbRule.DisplayName)
-	setTagsInSchema(d, lbRule.Tags)
+	setTagsInSchema(d, lbRule.Tags, m)
 	setLbRuleHTTPForwardingConditionsInSchema(d, lbRule.MatchConditions)
 	d.Set("match_strategy", lbRule.MatchStrategy)
 	err = setLbRuleForwardingActionsInSchema(d, lbRule.Actions)
@@ -483,7 +483,7 @@ func resourceNsxtLbHTTPForwardingRuleUpdate(d *schema.ResourceData, m interface{
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPForwardingConditionsFromSchema(d)
 	actions := getLbRuleForwardingActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
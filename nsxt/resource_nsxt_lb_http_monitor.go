@@ -60,7 +60,7 @@ func resourceNsxtLbHTTPMonitorCreate(d *schema.ResourceData, m interface{}) erro
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -129,7 +129,7 @@ func resourceNsxtLbHTTPMonitorRead(d *schema.ResourceData, m interface{}) error
 	d.Set("revision", lbHTTPMonitor.Revision)
 	d.Set("description", lbHTTPMonitor.Description)
 	d.Set("display_name", lbHTTPMonitor.DisplayName)
-	setTagsInSchema(d, lbHTTPMonitor.Tags)
+	setTagsInSchema(d, lbHTTPMonitor.Tags, m)
 	d.Set("fall_count", lbHTTPMonitor.FallCount)
 	d.Set("interval", lbHTTPMonitor.Interval)
 	d.Set("monitor_port", lbHTTPMonitor.MonitorPort)
@@ -160,7 +160,7 @@ func resourceNsxtLbHTTPMonitorUpdate(d *schema.ResourceData, m interface{}) erro
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -354,7 +354,7 @@ func resourceNsxtLbHTTPRequestRewriteRuleCreate(d *schema.ResourceData, m interf
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPRequestConditionsFromSchema(d)
 	actions := getLbRuleRequestRewriteActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
@@ -408,7 +408,7 @@ func resourceNsxtLbHTTPRequestRewriteRuleRead(d *schema.ResourceData, m interfac
 	d.Set("revision", lbRule.Revision)
 	d.Set("description", lbRule.Description)
 	d.Set("display_name", lbRule.DisplayName)
-	setTagsInSchema(d, lbRule.Tags)
+	setTagsInSchema(d, lbRule.Tags, m)
 	setLbRuleHTTPRequestConditionsInSchema(d, lbRule.MatchConditions)
 	d.Set("match_strategy", lbRule.MatchStrategy)
 	err = setLbRuleRequestRewriteActionsInSchema(d, lbRule.Actions)
@@ -436,7 +436,7 @@ func resourceNsxtLbHTTPRequestRewriteRuleUpdate(d *schema.ResourceData, m interf
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPRequestConditionsFromSchema(d)
 	actions := getLbRuleRequestRewriteActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
@@ -318,7 +318,7 @@ func resourceNsxtLbHTTPResponseRewriteRuleCreate(d *schema.ResourceData, m inter
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPResponseConditionsFromSchema(d)
 	actions := getLbRuleResponseRewriteActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
@@ -372,7 +372,7 @@ func resourceNsxtLbHTTPResponseRewriteRuleRead(d *schema.ResourceData, m interfa
 	d.Set("revision", lbRule.Revision)
 	d.Set("description", lbRule.Description)
 	d.Set("display_name", lbRule.DisplayName)
-	setTagsInSchema(d, lbRule.Tags)
+	setTagsInSchema(d, lbRule.Tags, m)
 	setLbRuleHTTPResponseConditionsInSchema(d, lbRule.MatchConditions)
 	d.Set("match_strategy", lbRule.MatchStrategy)
 	err = setLbRuleResponseRewriteActionsInSchema(d, lbRule.Actions)
@@ -399,7 +399,7 @@ func resourceNsxtLbHTTPResponseRewriteRuleUpdate(d *schema.ResourceData, m inter
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPResponseConditionsFromSchema(d)
 	actions := getLbRuleResponseRewriteActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
@@ -277,7 +277,7 @@ func resourceNsxtLbHTTPVirtualServerCreate(d *schema.ResourceData, m interface{}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	clientSslProfileBinding := getClientSSLBindingFromSchema(d)
@@ -354,7 +354,7 @@ func resourceNsxtLbHTTPVirtualServerRead(d *schema.ResourceData, m interface{})
 	d.Set("revision", lbVirtualServer.Revision)
 	d.Set("description", lbVirtualServer.Description)
 	d.Set("display_name", lbVirtualServer.DisplayName)
-	setTagsInSchema(d, lbVirtualServer.Tags)
+	setTagsInSchema(d, lbVirtualServer.Tags, m)
 	d.Set("access_log_enabled", lbVirtualServer.AccessLogEnabled)
 	d.Set("application_profile_id", lbVirtualServer.ApplicationProfileId)
 	setClientSSLBindingInSchema(d, lbVirtualServer.ClientSslProfileBinding)
@@ -390,7 +390,7 @@ func resourceNsxtLbHTTPVirtualServerUpdate(d *schema.ResourceData, m interface{}
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	clientSslProfileBinding := getClientSSLBindingFromSchema(d)
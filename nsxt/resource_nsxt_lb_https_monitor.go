@@ -79,7 +79,7 @@ func resourceNsxtLbHTTPSMonitorCreate(d *schema.ResourceData, m interface{}) err
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -164,7 +164,7 @@ func resourceNsxtLbHTTPSMonitorRead(d *schema.ResourceData, m interface{}) error
 	d.Set("revision", lbHTTPSMonitor.Revision)
 	d.Set("description", lbHTTPSMonitor.Description)
 	d.Set("display_name", lbHTTPSMonitor.DisplayName)
-	setTagsInSchema(d, lbHTTPSMonitor.Tags)
+	setTagsInSchema(d, lbHTTPSMonitor.Tags, m)
 	d.Set("fall_count", lbHTTPSMonitor.FallCount)
 	d.Set("interval", lbHTTPSMonitor.Interval)
 	d.Set("monitor_port", lbHTTPSMonitor.MonitorPort)
@@ -203,7 +203,7 @@ func resourceNsxtLbHTTPSMonitorUpdate(d *schema.ResourceData, m interface{}) err
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
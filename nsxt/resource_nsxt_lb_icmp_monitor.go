@@ -61,7 +61,7 @@ func resourceNsxtLbIcmpMonitorCreate(d *schema.ResourceData, m interface{}) erro
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -117,7 +117,7 @@ func resourceNsxtLbIcmpMonitorRead(d *schema.ResourceData, m interface{}) error
 	d.Set("revision", lbIcmpMonitor.Revision)
 	d.Set("description", lbIcmpMonitor.Description)
 	d.Set("display_name", lbIcmpMonitor.DisplayName)
-	setTagsInSchema(d, lbIcmpMonitor.Tags)
+	setTagsInSchema(d, lbIcmpMonitor.Tags, m)
 	d.Set("fall_count", lbIcmpMonitor.FallCount)
 	d.Set("interval", lbIcmpMonitor.Interval)
 	d.Set("monitor_port", lbIcmpMonitor.MonitorPort)
@@ -142,7 +142,7 @@ func resourceNsxtLbIcmpMonitorUpdate(d *schema.ResourceData, m interface{}) erro
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -60,7 +60,7 @@ func resourceNsxtLbPassiveMonitorCreate(d *schema.ResourceData, m interface{}) e
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	maxFails := int64(d.Get("max_fails").(int))
 	timeout := int64(d.Get("timeout").(int))
 	lbPassiveMonitor := loadbalancer.LbPassiveMonitor{
@@ -109,7 +109,7 @@ func resourceNsxtLbPassiveMonitorRead(d *schema.ResourceData, m interface{}) err
 	d.Set("revision", lbPassiveMonitor.Revision)
 	d.Set("description", lbPassiveMonitor.Description)
 	d.Set("display_name", lbPassiveMonitor.DisplayName)
-	setTagsInSchema(d, lbPassiveMonitor.Tags)
+	setTagsInSchema(d, lbPassiveMonitor.Tags, m)
 	d.Set("max_fails", lbPassiveMonitor.MaxFails)
 	d.Set("timeout", lbPassiveMonitor.Timeout)
 
@@ -130,7 +130,7 @@ func resourceNsxtLbPassiveMonitorUpdate(d *schema.ResourceData, m interface{}) e
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	maxFails := int64(d.Get("max_fails").(int))
 	timeout := int64(d.Get("timeout").(int))
 	lbPassiveMonitor := loadbalancer.LbPassiveMonitor{
@@ -357,7 +357,7 @@ func resourceNsxtLbPoolCreate(d *schema.ResourceData, m interface{}) error {
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	activeMonitorIds := getActiveMonitorIdsFromSchema(d)
 	passiveMonitorID := d.Get("passive_monitor_id").(string)
 	algorithm := d.Get("algorithm").(string)
@@ -420,7 +420,7 @@ func resourceNsxtLbPoolRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", lbPool.Revision)
 	d.Set("description", lbPool.Description)
 	d.Set("display_name", lbPool.DisplayName)
-	setTagsInSchema(d, lbPool.Tags)
+	setTagsInSchema(d, lbPool.Tags, m)
 	if lbPool.ActiveMonitorIds != nil && len(lbPool.ActiveMonitorIds) > 0 {
 		d.Set("active_monitor_id", lbPool.ActiveMonitorIds[0])
 	} else {
@@ -461,7 +461,7 @@ func resourceNsxtLbPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	activeMonitorIds := getActiveMonitorIdsFromSchema(d)
 	passiveMonitorID := d.Get("passive_monitor_id").(string)
 	algorithm := d.Get("algorithm").(string)
@@ -57,7 +57,7 @@ func resourceNsxtLbServerSslProfileCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ciphers := getStringListFromSchemaSet(d, "ciphers")
 	protocols := getStringListFromSchemaSet(d, "protocols")
 	sessionCacheEnabled := d.Get("session_cache_enabled").(bool)
@@ -108,7 +108,7 @@ func resourceNsxtLbServerSslProfileRead(d *schema.ResourceData, m interface{}) e
 	d.Set("revision", lbServerSslProfile.Revision)
 	d.Set("description", lbServerSslProfile.Description)
 	d.Set("display_name", lbServerSslProfile.DisplayName)
-	setTagsInSchema(d, lbServerSslProfile.Tags)
+	setTagsInSchema(d, lbServerSslProfile.Tags, m)
 	d.Set("ciphers", lbServerSslProfile.Ciphers)
 	d.Set("is_secure", lbServerSslProfile.IsSecure)
 	d.Set("protocols", lbServerSslProfile.Protocols)
@@ -131,7 +131,7 @@ func resourceNsxtLbServerSslProfileUpdate(d *schema.ResourceData, m interface{})
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ciphers := getStringListFromSchemaSet(d, "ciphers")
 	protocols := getStringListFromSchemaSet(d, "protocols")
 	sessionCacheEnabled := d.Get("session_cache_enabled").(bool)
@@ -88,7 +88,7 @@ func resourceNsxtLbServiceCreate(d *schema.ResourceData, m interface{}) error {
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	enabled := d.Get("enabled").(bool)
 	errorLogLevel := d.Get("error_log_level").(string)
@@ -144,7 +144,7 @@ func resourceNsxtLbServiceRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", lbService.Revision)
 	d.Set("description", lbService.Description)
 	d.Set("display_name", lbService.DisplayName)
-	setTagsInSchema(d, lbService.Tags)
+	setTagsInSchema(d, lbService.Tags, m)
 	if lbService.Attachment != nil {
 		if lbService.Attachment.TargetType != "LogicalRouter" {
 			return fmt.Errorf("Error during LbService attachment read: attachment type %s is not supported", lbService.Attachment.TargetType)
@@ -173,7 +173,7 @@ func resourceNsxtLbServiceUpdate(d *schema.ResourceData, m interface{}) error {
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	enabled := d.Get("enabled").(bool)
 	errorLogLevel := d.Get("error_log_level").(string)
@@ -71,7 +71,7 @@ func resourceNsxtLbSourceIPPersistenceProfileCreate(d *schema.ResourceData, m in
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	persistenceShared := d.Get("persistence_shared").(bool)
 	haPersistenceMirroring := d.Get("ha_persistence_mirroring").(bool)
 	purgeFlag := d.Get("purge_when_full").(bool)
@@ -128,7 +128,7 @@ func resourceNsxtLbSourceIPPersistenceProfileRead(d *schema.ResourceData, m inte
 	d.Set("revision", lbSourceIPPersistenceProfile.Revision)
 	d.Set("description", lbSourceIPPersistenceProfile.Description)
 	d.Set("display_name", lbSourceIPPersistenceProfile.DisplayName)
-	setTagsInSchema(d, lbSourceIPPersistenceProfile.Tags)
+	setTagsInSchema(d, lbSourceIPPersistenceProfile.Tags, m)
 	d.Set("persistence_shared", lbSourceIPPersistenceProfile.PersistenceShared)
 	d.Set("ha_persistence_mirroring", lbSourceIPPersistenceProfile.HaPersistenceMirroringEnabled)
 	if lbSourceIPPersistenceProfile.Purge == "FULL" {
@@ -155,7 +155,7 @@ func resourceNsxtLbSourceIPPersistenceProfileUpdate(d *schema.ResourceData, m in
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	persistenceShared := d.Get("persistence_shared").(bool)
 	haPersistenceMirroring := d.Get("ha_persistence_mirroring").(bool)
 	purgeFlag := d.Get("purge_when_full").(bool)
@@ -34,7 +34,7 @@ func resourceNsxtLbTCPMonitorCreate(d *schema.ResourceData, m interface{}) error
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -93,7 +93,7 @@ func resourceNsxtLbTCPMonitorRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", lbTCPMonitor.Revision)
 	d.Set("description", lbTCPMonitor.Description)
 	d.Set("display_name", lbTCPMonitor.DisplayName)
-	setTagsInSchema(d, lbTCPMonitor.Tags)
+	setTagsInSchema(d, lbTCPMonitor.Tags, m)
 	d.Set("fall_count", lbTCPMonitor.FallCount)
 	d.Set("interval", lbTCPMonitor.Interval)
 	d.Set("monitor_port", lbTCPMonitor.MonitorPort)
@@ -119,7 +119,7 @@ func resourceNsxtLbTCPMonitorUpdate(d *schema.ResourceData, m interface{}) error
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -34,7 +34,7 @@ func resourceNsxtLbUDPMonitorCreate(d *schema.ResourceData, m interface{}) error
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -93,7 +93,7 @@ func resourceNsxtLbUDPMonitorRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", lbUDPMonitor.Revision)
 	d.Set("description", lbUDPMonitor.Description)
 	d.Set("display_name", lbUDPMonitor.DisplayName)
-	setTagsInSchema(d, lbUDPMonitor.Tags)
+	setTagsInSchema(d, lbUDPMonitor.Tags, m)
 	d.Set("fall_count", lbUDPMonitor.FallCount)
 	d.Set("interval", lbUDPMonitor.Interval)
 	d.Set("monitor_port", lbUDPMonitor.MonitorPort)
@@ -119,7 +119,7 @@ func resourceNsxtLbUDPMonitorUpdate(d *schema.ResourceData, m interface{}) error
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -115,7 +115,7 @@ func resourceNsxtLbUDPVirtualServerCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	defaultPoolMemberPorts := interface2StringList(d.Get("default_pool_member_ports").([]interface{}))
@@ -183,7 +183,7 @@ func resourceNsxtLbUDPVirtualServerRead(d *schema.ResourceData, m interface{}) e
 	d.Set("revision", lbVirtualServer.Revision)
 	d.Set("description", lbVirtualServer.Description)
 	d.Set("display_name", lbVirtualServer.DisplayName)
-	setTagsInSchema(d, lbVirtualServer.Tags)
+	setTagsInSchema(d, lbVirtualServer.Tags, m)
 	d.Set("access_log_enabled", lbVirtualServer.AccessLogEnabled)
 	d.Set("application_profile_id", lbVirtualServer.ApplicationProfileId)
 	d.Set("default_pool_member_ports", lbVirtualServer.DefaultPoolMemberPorts)
@@ -213,7 +213,7 @@ func resourceNsxtLbUDPVirtualServerUpdate(d *schema.ResourceData, m interface{})
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	defaultPoolMemberPorts := interface2StringList(d.Get("default_pool_member_ports").([]interface{}))
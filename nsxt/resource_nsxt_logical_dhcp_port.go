@@ -64,7 +64,7 @@ func resourceNsxtLogicalDhcpPortCreate(d *schema.ResourceData, m interface{}) er
 	description := d.Get("description").(string)
 	lsID := d.Get("logical_switch_id").(string)
 	adminState := d.Get("admin_state").(string)
-	tagList := getTagsFromSchema(d)
+	tagList := getTagsFromSchema(d, m)
 	dhcpServerID := d.Get("dhcp_server_id").(string)
 	attachment := manager.LogicalPortAttachment{
 		AttachmentType: dhcpType,
@@ -124,7 +124,7 @@ func resourceNsxtLogicalDhcpPortRead(d *schema.ResourceData, m interface{}) erro
 	d.Set("logical_switch_id", LogicalDhcpPort.LogicalSwitchId)
 	d.Set("admin_state", LogicalDhcpPort.AdminState)
 	d.Set("dhcp_server_id", LogicalDhcpPort.Attachment.Id)
-	setTagsInSchema(d, LogicalDhcpPort.Tags)
+	setTagsInSchema(d, LogicalDhcpPort.Tags, m)
 
 	return nil
 }
@@ -140,7 +140,7 @@ func resourceNsxtLogicalDhcpPortUpdate(d *schema.ResourceData, m interface{}) er
 	description := d.Get("description").(string)
 	adminState := d.Get("admin_state").(string)
 	lsID := d.Get("logical_switch_id").(string)
-	tagList := getTagsFromSchema(d)
+	tagList := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	dhcpServerID := d.Get("dhcp_server_id").(string)
 	attachment := manager.LogicalPortAttachment{
@@ -209,7 +209,7 @@ func resourceNsxtLogicalDhcpServerCreate(d *schema.ResourceData, m interface{})
 			Others:    getDhcpGenericOptions(d),
 		},
 	}
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalDhcpServer := manager.LogicalDhcpServer{
 		DisplayName:    displayName,
 		Description:    description,
@@ -256,7 +256,7 @@ func resourceNsxtLogicalDhcpServerRead(d *schema.ResourceData, m interface{}) er
 	d.Set("revision", logicalDhcpServer.Revision)
 	d.Set("description", logicalDhcpServer.Description)
 	d.Set("display_name", logicalDhcpServer.DisplayName)
-	setTagsInSchema(d, logicalDhcpServer.Tags)
+	setTagsInSchema(d, logicalDhcpServer.Tags, m)
 	d.Set("attached_logical_port_id", logicalDhcpServer.AttachedLogicalPortId)
 	d.Set("dhcp_profile_id", logicalDhcpServer.DhcpProfileId)
 	d.Set("dhcp_server_ip", logicalDhcpServer.Ipv4DhcpServer.DhcpServerIp)
@@ -298,7 +298,7 @@ func resourceNsxtLogicalDhcpServerUpdate(d *schema.ResourceData, m interface{})
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	dhcpProfileID := d.Get("dhcp_profile_id").(string)
 	revision := int64(d.Get("revision").(int))
 	opt121Routes := getDhcpOptions121(d)
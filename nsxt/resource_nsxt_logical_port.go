@@ -59,7 +59,7 @@ func resourceNsxtLogicalPortCreate(d *schema.ResourceData, m interface{}) error
 	lsID := d.Get("logical_switch_id").(string)
 	adminState := d.Get("admin_state").(string)
 	profilesList := getSwitchingProfileIdsFromSchema(d)
-	tagList := getTagsFromSchema(d)
+	tagList := getTagsFromSchema(d, m)
 
 	lp := manager.LogicalPort{
 		DisplayName:         name,
@@ -113,7 +113,7 @@ func resourceNsxtLogicalPortRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error during logical port switching profiles set in schema: %v", err)
 	}
-	setTagsInSchema(d, logicalPort.Tags)
+	setTagsInSchema(d, logicalPort.Tags, m)
 
 	return nil
 }
@@ -129,7 +129,7 @@ func resourceNsxtLogicalPortUpdate(d *schema.ResourceData, m interface{}) error
 	description := d.Get("description").(string)
 	adminState := d.Get("admin_state").(string)
 	profilesList := getSwitchingProfileIdsFromSchema(d)
-	tagList := getTagsFromSchema(d)
+	tagList := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 
 	// Some of the port attributes (attachment) are not exposed to terraform.
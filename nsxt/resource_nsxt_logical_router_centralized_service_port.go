@@ -74,7 +74,7 @@ func resourceNsxtLogicalRouterCentralizedServicePortCreate(d *schema.ResourceDat
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalSwitchPortID := d.Get("linked_logical_switch_port_id").(string)
 	subnets := getIPSubnetsFromCidr(d.Get("ip_address").(string))
@@ -127,7 +127,7 @@ func resourceNsxtLogicalRouterCentralizedServicePortRead(d *schema.ResourceData,
 	d.Set("revision", LogicalRouterCentralizedServicePort.Revision)
 	d.Set("description", LogicalRouterCentralizedServicePort.Description)
 	d.Set("display_name", LogicalRouterCentralizedServicePort.DisplayName)
-	setTagsInSchema(d, LogicalRouterCentralizedServicePort.Tags)
+	setTagsInSchema(d, LogicalRouterCentralizedServicePort.Tags, m)
 	d.Set("logical_router_id", LogicalRouterCentralizedServicePort.LogicalRouterId)
 	d.Set("linked_logical_switch_port_id", LogicalRouterCentralizedServicePort.LinkedLogicalSwitchPortId.TargetId)
 	setIPSubnetsInSchema(d, LogicalRouterCentralizedServicePort.Subnets)
@@ -150,7 +150,7 @@ func resourceNsxtLogicalRouterCentralizedServicePortUpdate(d *schema.ResourceDat
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalSwitchPortID := d.Get("linked_logical_switch_port_id").(string)
 	subnets := getIPSubnetsFromCidr(d.Get("ip_address").(string))
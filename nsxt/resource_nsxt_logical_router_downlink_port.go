@@ -105,7 +105,7 @@ func resourceNsxtLogicalRouterDownLinkPortCreate(d *schema.ResourceData, m inter
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	macAddress := d.Get("mac_address").(string)
 	linkedLogicalSwitchPortID := d.Get("linked_logical_switch_port_id").(string)
@@ -162,7 +162,7 @@ func resourceNsxtLogicalRouterDownLinkPortRead(d *schema.ResourceData, m interfa
 	d.Set("revision", logicalRouterDownLinkPort.Revision)
 	d.Set("description", logicalRouterDownLinkPort.Description)
 	d.Set("display_name", logicalRouterDownLinkPort.DisplayName)
-	setTagsInSchema(d, logicalRouterDownLinkPort.Tags)
+	setTagsInSchema(d, logicalRouterDownLinkPort.Tags, m)
 	d.Set("logical_router_id", logicalRouterDownLinkPort.LogicalRouterId)
 	d.Set("mac_address", logicalRouterDownLinkPort.MacAddress)
 	d.Set("linked_logical_switch_port_id", logicalRouterDownLinkPort.LinkedLogicalSwitchPortId.TargetId)
@@ -190,7 +190,7 @@ func resourceNsxtLogicalRouterDownLinkPortUpdate(d *schema.ResourceData, m inter
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalSwitchPortID := d.Get("linked_logical_switch_port_id").(string)
 	subnets := getIPSubnetsFromCidr(d.Get("ip_address").(string))
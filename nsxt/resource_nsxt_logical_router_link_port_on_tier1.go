@@ -60,7 +60,7 @@ func resourceNsxtLogicalRouterLinkPortOnTier1Create(d *schema.ResourceData, m in
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalRouterPortID := d.Get("linked_logical_router_port_id").(string)
 	logicalRouterLinkPort := manager.LogicalRouterLinkPortOnTier1{
@@ -108,7 +108,7 @@ func resourceNsxtLogicalRouterLinkPortOnTier1Read(d *schema.ResourceData, m inte
 	d.Set("revision", logicalRouterLinkPort.Revision)
 	d.Set("description", logicalRouterLinkPort.Description)
 	d.Set("display_name", logicalRouterLinkPort.DisplayName)
-	setTagsInSchema(d, logicalRouterLinkPort.Tags)
+	setTagsInSchema(d, logicalRouterLinkPort.Tags, m)
 	d.Set("logical_router_id", logicalRouterLinkPort.LogicalRouterId)
 	d.Set("linked_logical_router_port_id", logicalRouterLinkPort.LinkedLogicalRouterPortId.TargetId)
 
@@ -129,7 +129,7 @@ func resourceNsxtLogicalRouterLinkPortOnTier1Update(d *schema.ResourceData, m in
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalRouterPortID := d.Get("linked_logical_router_port_id").(string)
 	logicalRouterLinkPort := manager.LogicalRouterLinkPortOnTier1{
@@ -106,7 +106,7 @@ func resourceNsxtLogicalSwitchCreate(d *schema.ResourceData, m interface{}) erro
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	addressBindings := getAddressBindingsFromSchema(d)
 	adminState := d.Get("admin_state").(string)
 	ipPoolID := d.Get("ip_pool_id").(string)
@@ -227,7 +227,7 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 	d.Set("revision", logicalSwitch.Revision)
 	d.Set("description", logicalSwitch.Description)
 	d.Set("display_name", logicalSwitch.DisplayName)
-	setTagsInSchema(d, logicalSwitch.Tags)
+	setTagsInSchema(d, logicalSwitch.Tags, m)
 	err = setAddressBindingsInSchema(d, logicalSwitch.AddressBindings)
 	if err != nil {
 		return fmt.Errorf("Error during logical switch address bindings set in schema: %v", err)
@@ -260,7 +260,7 @@ func resourceNsxtLogicalSwitchUpdate(d *schema.ResourceData, m interface{}) erro
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	addressBindings := getAddressBindingsFromSchema(d)
 	adminState := d.Get("admin_state").(string)
 	ipPoolID := d.Get("ip_pool_id").(string)
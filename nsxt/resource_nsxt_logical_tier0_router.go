@@ -76,7 +76,7 @@ func resourceNsxtLogicalTier0RouterCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	highAvailabilityMode := d.Get("high_availability_mode").(string)
 	failoverMode := d.Get("failover_mode").(string)
 	routerType := "TIER0"
@@ -129,7 +129,7 @@ func resourceNsxtLogicalTier0RouterRead(d *schema.ResourceData, m interface{}) e
 	d.Set("revision", logicalRouter.Revision)
 	d.Set("description", logicalRouter.Description)
 	d.Set("display_name", logicalRouter.DisplayName)
-	setTagsInSchema(d, logicalRouter.Tags)
+	setTagsInSchema(d, logicalRouter.Tags, m)
 	d.Set("edge_cluster_id", logicalRouter.EdgeClusterId)
 	d.Set("high_availability_mode", logicalRouter.HighAvailabilityMode)
 	d.Set("failover_mode", logicalRouter.FailoverMode)
@@ -155,7 +155,7 @@ func resourceNsxtLogicalTier0RouterUpdate(d *schema.ResourceData, m interface{})
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	highAvailabilityMode := d.Get("high_availability_mode").(string)
 	failoverMode := d.Get("failover_mode").(string)
 	routerType := "TIER0"
@@ -181,7 +181,7 @@ func resourceNsxtLogicalTier1RouterCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	failoverMode := d.Get("failover_mode").(string)
 	routerType := "TIER1"
 	edgeClusterID := d.Get("edge_cluster_id").(string)
@@ -247,7 +247,7 @@ func resourceNsxtLogicalTier1RouterRead(d *schema.ResourceData, m interface{}) e
 	d.Set("revision", logicalRouter.Revision)
 	d.Set("description", logicalRouter.Description)
 	d.Set("display_name", logicalRouter.DisplayName)
-	setTagsInSchema(d, logicalRouter.Tags)
+	setTagsInSchema(d, logicalRouter.Tags, m)
 	d.Set("edge_cluster_id", logicalRouter.EdgeClusterId)
 	if logicalRouter.FailoverMode != "" {
 		d.Set("failover_mode", logicalRouter.FailoverMode)
@@ -283,7 +283,7 @@ func resourceNsxtLogicalTier1RouterUpdate(d *schema.ResourceData, m interface{})
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	failoverMode := d.Get("failover_mode").(string)
 	routerType := "TIER1"
 	edgeClusterID := d.Get("edge_cluster_id").(string)
@@ -120,7 +120,7 @@ func resourceNsxtMacManagementSwitchingProfileCreate(d *schema.ResourceData, m i
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	macChangeAllowed := d.Get("mac_change_allowed").(bool)
 	macLearning := getMacLearningFromSchema(d)
 
@@ -171,7 +171,7 @@ func resourceNsxtMacManagementSwitchingProfileRead(d *schema.ResourceData, m int
 	d.Set("description", switchingProfile.Description)
 	d.Set("display_name", switchingProfile.DisplayName)
 	d.Set("mac_change_allowed", switchingProfile.MacChangeAllowed)
-	setTagsInSchema(d, switchingProfile.Tags)
+	setTagsInSchema(d, switchingProfile.Tags, m)
 	err = setMacLearningInSchema(d, switchingProfile.MacLearning)
 	if err != nil {
 		return fmt.Errorf("Error during setting MacManagementSwitchingProfile MacLearning: %v", err)
@@ -193,7 +193,7 @@ func resourceNsxtMacManagementSwitchingProfileUpdate(d *schema.ResourceData, m i
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	macChangeAllowed := d.Get("mac_change_allowed").(bool)
 	macLearning := getMacLearningFromSchema(d)
@@ -4,15 +4,17 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"math/rand"
 	"net"
 	"strings"
 	"time"
 
 	"golang.org/x/exp/slices"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,13 +27,22 @@ import (
 const nodeConnectivityInitialDelay int = 20
 const nodeConnectivityInterval int = 16
 const nodeConnectivityTimeout int = 1800
+const managerClusterCreateTimeout = 60 * time.Minute
 
 func resourceNsxtManagerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtManagerClusterCreate,
-		Read:   resourceNsxtManagerClusterRead,
-		Update: resourceNsxtManagerClusterUpdate,
-		Delete: resourceNsxtManagerClusterDelete,
+		CreateContext: resourceNsxtManagerClusterCreate,
+		ReadContext:   resourceNsxtManagerClusterRead,
+		UpdateContext: resourceNsxtManagerClusterUpdate,
+		DeleteContext: resourceNsxtManagerClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(managerClusterCreateTimeout),
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(
+				cty.GetAttrPath("node").Index(anyListIndex).GetAttr("password"),
+				cty.GetAttrPath("node").Index(anyListIndex).GetAttr("password_wo")),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"revision": getRevisionSchema(),
@@ -98,9 +109,10 @@ func resourceNsxtManagerCluster() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Description: "The password for login",
-							Required:    true,
+							Optional:    true,
 							Sensitive:   true,
 						},
+						"password_wo": getWriteOnlySchema("The password for login, not stored in state"),
 						"username": {
 							Type:        schema.TypeString,
 							Description: "The username for login",
@@ -123,7 +135,7 @@ type NsxClusterNode struct {
 	Status    string
 }
 
-func getNodeConnectivityStateConf(connector client.Connector, delay int, interval int, timeout int) *resource.StateChangeConf {
+func getNodeConnectivityStateConf(connector client.Connector, delay int, interval int, timeout time.Duration) *resource.StateChangeConf {
 
 	return &resource.StateChangeConf{
 		Pending: []string{"notyet"},
@@ -142,12 +154,12 @@ func getNodeConnectivityStateConf(connector client.Connector, delay int, interva
 			return resp, "success", nil
 		},
 		Delay:        time.Duration(delay) * time.Second,
-		Timeout:      time.Duration(timeout) * time.Second,
+		Timeout:      timeout,
 		PollInterval: time.Duration(interval) * time.Second,
 	}
 }
 
-func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxClusterNode) error {
+func waitForNodeStatus(ctx context.Context, d *schema.ResourceData, m interface{}, nodes []NsxClusterNode, deadline time.Time) error {
 
 	delay := nodeConnectivityInitialDelay
 	interval := nodeConnectivityInterval
@@ -168,9 +180,9 @@ func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxCluster
 		log.Printf("[DEBUG]: API probing for NSX is disabled")
 		return nil
 	}
-	connector := getStandalonePolicyConnector(m, false)
-	stateConf := getNodeConnectivityStateConf(connector, delay, interval, timeout)
-	_, err := stateConf.WaitForState()
+	connector := newContextConnector(ctx, getStandalonePolicyConnector(m, false))
+	stateConf := getNodeConnectivityStateConf(connector, delay, interval, getWaitTimeout(deadline, timeout))
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Failed to connect to main NSX manager endpoint")
 	}
@@ -182,9 +194,9 @@ func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxCluster
 			return err
 		}
 		newNsxClients := c.(nsxtClients)
-		nodeConnector := getStandalonePolicyConnector(newNsxClients, false)
-		nodeConf := getNodeConnectivityStateConf(nodeConnector, 0, interval, timeout)
-		_, err = nodeConf.WaitForState()
+		nodeConnector := newContextConnector(ctx, getStandalonePolicyConnector(newNsxClients, false))
+		nodeConf := getNodeConnectivityStateConf(nodeConnector, 0, interval, getWaitTimeout(deadline, timeout))
+		_, err = nodeConf.WaitForStateContext(ctx)
 		if err != nil {
 			return fmt.Errorf("Failed to connect to NSX node endpoint %s", node.IPAddress)
 		}
@@ -196,12 +208,12 @@ func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxCluster
 func getClusterNodesFromSchema(d *schema.ResourceData) []NsxClusterNode {
 	nodes := d.Get("node").([]interface{})
 	var clusterNodes []NsxClusterNode
-	for _, node := range nodes {
+	for i, node := range nodes {
 		data := node.(map[string]interface{})
 		id := data["id"].(string)
 		ipAddress := data["ip_address"].(string)
 		userName := data["username"].(string)
-		password := data["password"].(string)
+		password := getWriteOnlyOrString(d, cty.GetAttrPath("node").IndexInt(i).GetAttr("password_wo"), data["password"].(string))
 		nodeObj := NsxClusterNode{
 			ID:        id,
 			IPAddress: ipAddress,
@@ -213,36 +225,37 @@ func getClusterNodesFromSchema(d *schema.ResourceData) []NsxClusterNode {
 	return clusterNodes
 }
 
-func resourceNsxtManagerClusterCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtManagerClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Call Joincluster function on nodes that are not in the cluster
 	nodes := getClusterNodesFromSchema(d)
 	if len(nodes) == 0 {
-		return fmt.Errorf("At least a manager appliance must be provided to form a cluster")
+		return diag.Errorf("At least a manager appliance must be provided to form a cluster")
 	}
 
-	err := waitForNodeStatus(d, m, nodes)
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+	err := waitForNodeStatus(ctx, d, m, nodes, deadline)
 	if err != nil {
-		return fmt.Errorf("Failed to establish connection to NSX API: %v", err)
+		return handleContextError(ctx, "ManagerCluster creation", fmt.Errorf("Failed to establish connection to NSX API: %v", err))
 	}
-	clusterID, certSha256Thumbprint, hostIPs, err := getClusterInfoFromHostNode(d, m)
+	clusterID, certSha256Thumbprint, hostIPs, err := getClusterInfoFromHostNode(ctx, d, m)
 	if err != nil {
-		return handleCreateError("ManagerCluster", "", err)
+		return handleContextError(ctx, "ManagerCluster creation", handleCreateError("ManagerCluster", "", err))
 	}
 
 	for _, guestNode := range nodes {
-		err := joinNodeToCluster(clusterID, certSha256Thumbprint, guestNode, hostIPs, d, m)
+		err := joinNodeToCluster(ctx, clusterID, certSha256Thumbprint, guestNode, hostIPs, d, m)
 		if err != nil {
-			return handleCreateError("ManagerCluster", clusterID, err)
+			return handleContextError(ctx, "ManagerCluster creation", handleCreateError("ManagerCluster", clusterID, err))
 		}
 	}
 	d.SetId(clusterID)
-	return resourceNsxtManagerClusterRead(d, m)
+	return resourceNsxtManagerClusterRead(ctx, d, m)
 }
 
-func getClusterInfoFromHostNode(d *schema.ResourceData, m interface{}) (string, string, []string, error) {
+func getClusterInfoFromHostNode(ctx context.Context, d *schema.ResourceData, m interface{}) (string, string, []string, error) {
 	// function return values are:
 	// clusterID, certSha256Thumbprint, hostIP, error
-	connector := getPolicyConnector(m)
+	connector := getContextPolicyConnector(ctx, m)
 	client := nsx.NewClusterClient(connector)
 	c := m.(nsxtClients)
 	min := c.CommonConfig.MinRetryInterval
@@ -270,9 +283,11 @@ func getClusterInfoFromHostNode(d *schema.ResourceData, m interface{}) (string,
 			certSha256Thumbprint := *apiListenAddr.CertificateSha256Thumbprint
 			return clusterID, certSha256Thumbprint, hostIPs, nil
 		}
-		interval := (rand.Intn(max-min) + min)
-		time.Sleep(time.Duration(interval) * time.Millisecond)
-		log.Printf("[DEBUG]: Waited %d ms before retrying getting API Listen Address, attempt %d", interval, i+1)
+		interval := getRetryBackoff(uint(i), min, max)
+		if err := sleepContext(ctx, interval); err != nil {
+			return "", "", hostIPs, err
+		}
+		log.Printf("[DEBUG]: Waited %v before retrying getting API Listen Address, attempt %d", interval, i+1)
 	}
 	return "", "", hostIPs, fmt.Errorf("Failed to read ClusterConfig after %d attempts", maxRetries)
 }
@@ -333,14 +348,14 @@ func configureNewClient(newClient *nsxtClients, oldClient *nsxtClients, host str
 	return nil
 }
 
-func joinNodeToCluster(clusterID string, certSha256Thumbprint string, guestNode NsxClusterNode, hostIPs []string, d *schema.ResourceData, m interface{}) error {
+func joinNodeToCluster(ctx context.Context, clusterID string, certSha256Thumbprint string, guestNode NsxClusterNode, hostIPs []string, d *schema.ResourceData, m interface{}) error {
 	c, err := getNewNsxtClient(guestNode, d, m)
 	if err != nil {
 		return err
 	}
 	log.Printf("[INFO] Cluster %s. Joining node %s", clusterID, guestNode.IPAddress)
 	newNsxClients := c.(nsxtClients)
-	connector := getStandalonePolicyConnector(newNsxClients, true)
+	connector := newContextConnector(ctx, getStandalonePolicyConnector(newNsxClients, true))
 	client := nsx.NewClusterClient(connector)
 	username, password := getHostCredential(m)
 	hostIP := getMatchingIPVersion(guestNode.IPAddress, hostIPs)
@@ -406,19 +421,19 @@ func isMatchingNode(node nsxModel.ClusterNodeInfo, address string) bool {
 	return false
 }
 
-func resourceNsxtManagerClusterRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtManagerClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
-	connector := getPolicyConnector(m)
+	connector := getContextPolicyConnector(ctx, m)
 	client := nsx.NewClusterClient(connector)
 	clusterConfig, err := client.Get()
 	if err != nil {
-		return handleReadError(d, "ManagerCluster", id, err)
+		return handleContextError(ctx, "ManagerCluster read", handleReadError(d, "ManagerCluster", id, err))
 	}
 	nsxNodes := clusterConfig.Nodes
 	var resultNodes []map[string]interface{}
 	schemaNodes := getClusterNodesFromSchema(d)
 	// Complete schema nodes with computed fields
-	for _, schemaNode := range schemaNodes {
+	for i, schemaNode := range schemaNodes {
 		for _, nsxNode := range nsxNodes {
 			if isMatchingNode(nsxNode, schemaNode.IPAddress) {
 				resultNode := make(map[string]interface{})
@@ -427,7 +442,8 @@ func resourceNsxtManagerClusterRead(d *schema.ResourceData, m interface{}) error
 				resultNode["status"] = nsxNode.Status
 				resultNode["ip_address"] = schemaNode.IPAddress
 				resultNode["username"] = schemaNode.UserName
-				resultNode["password"] = schemaNode.Password
+				// Write-only password is not set back to state
+				resultNode["password"] = d.Get(fmt.Sprintf("node.%d.password", i))
 
 				resultNodes = append(resultNodes, resultNode)
 			}
@@ -440,18 +456,18 @@ func resourceNsxtManagerClusterRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtManagerClusterUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtManagerClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if !d.HasChange("node") {
 		// CHanges to attributes other than "node" should be ignored
 		return nil
 	}
 	id := d.Id()
-	connector := getPolicyConnector(m)
+	connector := getContextPolicyConnector(ctx, m)
 	client := nsx.NewClusterClient(connector)
 
-	clusterID, certSha256Thumbprint, hostIPs, err := getClusterInfoFromHostNode(d, m)
+	clusterID, certSha256Thumbprint, hostIPs, err := getClusterInfoFromHostNode(ctx, d, m)
 	if err != nil {
-		return handleUpdateError("ManagerCluster", id, err)
+		return handleContextError(ctx, "ManagerCluster update", handleUpdateError("ManagerCluster", id, err))
 	}
 	oldNodes, newNodes := d.GetChange("node")
 	oldNodesIPs := getClusterNodesIPs(oldNodes)
@@ -466,30 +482,30 @@ func resourceNsxtManagerClusterUpdate(d *schema.ResourceData, m interface{}) err
 			ignoreRepositoryIPCheckParam := "false"
 			_, err := client.Removenode(id, &force, &gracefulShutdown, &ignoreRepositoryIPCheckParam)
 			if err != nil {
-				return handleUpdateError("ManagerCluster", id, err)
+				return handleContextError(ctx, "ManagerCluster update", handleUpdateError("ManagerCluster", id, err))
 			}
 		}
 	}
-	for _, node := range newNodes.([]interface{}) {
+	for i, node := range newNodes.([]interface{}) {
 		nodeMap := node.(map[string]interface{})
 		ip := nodeMap["ip_address"].(string)
 		if !slices.Contains(oldNodesIPs, ip) {
 			userName := nodeMap["username"].(string)
-			password := nodeMap["password"].(string)
+			password := getWriteOnlyOrString(d, cty.GetAttrPath("node").IndexInt(i).GetAttr("password_wo"), nodeMap["password"].(string))
 			ip := nodeMap["ip_address"].(string)
 			nodeObj := NsxClusterNode{
 				IPAddress: ip,
 				UserName:  userName,
 				Password:  password,
 			}
-			err = joinNodeToCluster(clusterID, certSha256Thumbprint, nodeObj, hostIPs, d, m)
+			err = joinNodeToCluster(ctx, clusterID, certSha256Thumbprint, nodeObj, hostIPs, d, m)
 			if err != nil {
-				return handleUpdateError("ManagerCluster", id, err)
+				return handleContextError(ctx, "ManagerCluster update", handleUpdateError("ManagerCluster", id, err))
 			}
 		}
 	}
 
-	return resourceNsxtManagerClusterRead(d, m)
+	return resourceNsxtManagerClusterRead(ctx, d, m)
 }
 
 func getClusterNodesIPs(nodes interface{}) []string {
@@ -501,8 +517,8 @@ func getClusterNodesIPs(nodes interface{}) []string {
 	return ips
 }
 
-func resourceNsxtManagerClusterDelete(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func resourceNsxtManagerClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 	client := nsx.NewClusterClient(connector)
 	nodes := getClusterNodesFromSchema(d)
 	force := "true"
@@ -512,7 +528,7 @@ func resourceNsxtManagerClusterDelete(d *schema.ResourceData, m interface{}) err
 		guestNodeID := node.ID
 		_, err := client.Removenode(guestNodeID, &force, &gracefulShutdown, &ignoreRepositoryIPCheckParam)
 		if err != nil {
-			return handleDeleteError("ManagerCluster", guestNodeID, err)
+			return handleContextError(ctx, "ManagerCluster deletion", handleDeleteError("ManagerCluster", guestNodeID, err))
 		}
 	}
 	return nil
@@ -121,7 +121,7 @@ func resourceNsxtNatRuleCreate(d *schema.ResourceData, m interface{}) error {
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	action := d.Get("action").(string)
 	if action == "NO_NAT" && util.NsxVersionHigherOrEqual("3.0.0") {
 		return fmt.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
@@ -198,7 +198,7 @@ func resourceNsxtNatRuleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", natRule.Revision)
 	d.Set("description", natRule.Description)
 	d.Set("display_name", natRule.DisplayName)
-	setMPTagsInSchema(d, natRule.Tags)
+	setMPTagsInSchema(d, natRule.Tags, m)
 	d.Set("action", natRule.Action)
 	d.Set("enabled", natRule.Enabled)
 	d.Set("logging", natRule.Logging)
@@ -230,7 +230,7 @@ func resourceNsxtNatRuleUpdate(d *schema.ResourceData, m interface{}) error {
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	action := d.Get("action").(string)
 	if action == "NO_NAT" && util.NsxVersionHigherOrEqual("3.0.0") {
 		return fmt.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
@@ -24,6 +25,9 @@ func resourceNsxtUsers() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("password"), cty.GetAttrPath("password_wo")),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"full_name": {
@@ -37,12 +41,15 @@ func resourceNsxtUsers() *schema.Resource {
 				Computed:    true,
 			},
 			"password": {
-				Type:         schema.TypeString,
-				Description:  "Password for the user",
-				Sensitive:    true,
-				Optional:     true,
-				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Type:          schema.TypeString,
+				Description:   "Password for the user",
+				Sensitive:     true,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"password_wo"},
 			},
+			"password_wo":         getWriteOnlySchema("Password for the user, not stored in state"),
+			"password_wo_version": getWriteOnlyVersionSchema("password_wo"),
 			"password_change_frequency": {
 				Type:         schema.TypeInt,
 				Description:  "Number of days password is valid before it must be changed",
@@ -118,7 +125,7 @@ func resourceNsxtNodeUserCreate(d *schema.ResourceData, m interface{}) error {
 	passwordChangeFrequency := int64(d.Get("password_change_frequency").(int))
 	passwordChangeWarning := int64(d.Get("password_change_warning").(int))
 	username := d.Get("username").(string)
-	password := d.Get("password").(string)
+	password := getWriteOnlyOrString(d, cty.GetAttrPath("password_wo"), d.Get("password").(string))
 
 	userProp := nsxModel.NodeUserProperties{
 		FullName:                &fullName,
@@ -179,6 +186,7 @@ func resourceNsxtNodeUserUpdate(d *schema.ResourceData, m interface{}) error {
 	oldPwd, pwd := d.GetChange("password")
 	password := pwd.(string)
 	oldPassword := oldPwd.(string)
+	passwordWO := getWriteOnlyString(d, cty.GetAttrPath("password_wo"))
 
 	active := d.Get("active").(bool)
 	status := d.Get("status").(string)
@@ -186,10 +194,14 @@ func resourceNsxtNodeUserUpdate(d *schema.ResourceData, m interface{}) error {
 	// Handle user status change first
 	// Password reset can be achieved by deactivating then re-activate the account
 	if status == nsxModel.NodeUserProperties_STATUS_NOT_ACTIVATED && active {
-		if len(password) == 0 {
+		activationPassword := password
+		if len(passwordWO) > 0 {
+			activationPassword = passwordWO
+		}
+		if len(activationPassword) == 0 {
 			return fmt.Errorf("must specify password to activate Nsxt Node user %s", id)
 		}
-		_, err := client.Activate(id, nsxModel.NodeUserPasswordProperty{Password: &password})
+		_, err := client.Activate(id, nsxModel.NodeUserPasswordProperty{Password: &activationPassword})
 		if err != nil {
 			return fmt.Errorf("failed to activate Nsxt Node user %s: %s", id, err)
 		}
@@ -216,6 +228,9 @@ func resourceNsxtNodeUserUpdate(d *schema.ResourceData, m interface{}) error {
 	if password != oldPassword {
 		userProp.Password = &password
 		userProp.OldPassword = &oldPassword
+	} else if len(passwordWO) > 0 && d.HasChange("password_wo_version") {
+		// Previous value of write-only password is not known
+		userProp.Password = &passwordWO
 	}
 
 	_, err := client.Update(id, userProp)
@@ -166,7 +166,7 @@ func resourceNsxtNsGroupCreate(d *schema.ResourceData, m interface{}) error {
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	members := getMembersFromSchema(d)
 	membershipCriteria := getMembershipCriteriaFromSchema(d)
 	nsGroup := manager.NsGroup{
@@ -218,7 +218,7 @@ func resourceNsxtNsGroupRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", nsGroup.Revision)
 	d.Set("description", nsGroup.Description)
 	d.Set("display_name", nsGroup.DisplayName)
-	setTagsInSchema(d, nsGroup.Tags)
+	setTagsInSchema(d, nsGroup.Tags, m)
 	err1 := setMembersInSchema(d, nsGroup.Members)
 
 	err2 := setMembershipCriteriaInSchema(d, nsGroup.MembershipCriteria)
@@ -243,7 +243,7 @@ func resourceNsxtNsGroupUpdate(d *schema.ResourceData, m interface{}) error {
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	members := getMembersFromSchema(d)
 	membershipCriteria := getMembershipCriteriaFromSchema(d)
 	nsGroup := manager.NsGroup{
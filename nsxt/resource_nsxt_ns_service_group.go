@@ -80,7 +80,7 @@ func resourceNsxtNsServiceGroupCreate(d *schema.ResourceData, m interface{}) err
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	members := getResourceReferencesFromStringsSet(d, "members")
 	nsServiceGroup := manager.NsServiceGroup{
 		Description: description,
@@ -127,7 +127,7 @@ func resourceNsxtNsServiceGroupRead(d *schema.ResourceData, m interface{}) error
 	d.Set("revision", nsServiceGroup.Revision)
 	d.Set("description", nsServiceGroup.Description)
 	d.Set("display_name", nsServiceGroup.DisplayName)
-	setTagsInSchema(d, nsServiceGroup.Tags)
+	setTagsInSchema(d, nsServiceGroup.Tags, m)
 	d.Set("members", returnResourceReferencesTargetIDs(nsServiceGroup.Members))
 
 	return nil
@@ -147,7 +147,7 @@ func resourceNsxtNsServiceGroupUpdate(d *schema.ResourceData, m interface{}) err
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	members := getResourceReferencesFromStringsSet(d, "members")
 	nsServiceGroup := manager.NsServiceGroup{
 		Revision:    revision,
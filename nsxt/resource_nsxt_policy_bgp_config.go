@@ -70,7 +70,7 @@ func resourceNsxtPolicyBgpConfigRead(d *schema.ResourceData, m interface{}) erro
 	return nil
 }
 
-func resourceNsxtPolicyBgpConfigToStruct(d *schema.ResourceData, isVRF bool) (*model.BgpRoutingConfig, error) {
+func resourceNsxtPolicyBgpConfigToStruct(d *schema.ResourceData, isVRF bool, m interface{}) (*model.BgpRoutingConfig, error) {
 	ecmp := d.Get("ecmp").(bool)
 	enabled := d.Get("enabled").(bool)
 	interSrIbgp := d.Get("inter_sr_ibgp").(bool)
@@ -79,7 +79,7 @@ func resourceNsxtPolicyBgpConfigToStruct(d *schema.ResourceData, isVRF bool) (*m
 	restartMode := d.Get("graceful_restart_mode").(string)
 	restartTimer := int64(d.Get("graceful_restart_timer").(int))
 	staleTimer := int64(d.Get("graceful_restart_stale_route_timer").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	var aggregationStructs []model.RouteAggregationEntry
 	routeAggregations := d.Get("route_aggregation").([]interface{})
@@ -155,7 +155,7 @@ func resourceNsxtPolicyBgpConfigCreate(d *schema.ResourceData, m interface{}) er
 	if err != nil {
 		return handleCreateError("BgpRoutingConfig", gwID, err)
 	}
-	obj, err := resourceNsxtPolicyBgpConfigToStruct(d, isVrf)
+	obj, err := resourceNsxtPolicyBgpConfigToStruct(d, isVrf, m)
 	if err != nil {
 		return handleCreateError("BgpRoutingConfig", gwID, err)
 	}
@@ -211,7 +211,7 @@ func resourceNsxtPolicyBgpConfigUpdate(d *schema.ResourceData, m interface{}) er
 		return handleCreateError("BgpRoutingConfig", gwID, err)
 	}
 
-	obj, err := resourceNsxtPolicyBgpConfigToStruct(d, isVrf)
+	obj, err := resourceNsxtPolicyBgpConfigToStruct(d, isVrf, m)
 	if err != nil {
 		return handleUpdateError("BgpRoutingConfig", gwID, err)
 	}
@@ -30,6 +30,10 @@ var bgpNeighborConfigRouteFilteringAddressFamilyValues = []string{
 	model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN,
 }
 
+var policyBgpNeighborMaximumRoutesGate = newAttributeVersionGate("3.0.0", map[string][]string{
+	"nsxt_policy_bgp_neighbor": {"route_filtering.maximum_routes"},
+})
+
 func resourceNsxtPolicyBgpNeighbor() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyBgpNeighborCreate,
@@ -213,12 +217,12 @@ func resourceNsxtPolicyBgpNeighborExists(t0ID string, localeServiceID string, ne
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, id string) (model.BgpNeighborConfig, error) {
+func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, id string, m interface{}) (model.BgpNeighborConfig, error) {
 	var neighborStruct model.BgpNeighborConfig
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	allowAsIn := d.Get("allow_as_in").(bool)
 	gracefulRestartMode := d.Get("graceful_restart_mode").(string)
 	holdDownTime := int64(d.Get("hold_down_time").(int))
@@ -273,7 +277,7 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 			filterStruct.OutRouteFilters = outFilters
 		}
 
-		if util.NsxVersionHigherOrEqual("3.0.0") && data["maximum_routes"] != 0 {
+		if policyBgpNeighborMaximumRoutesGate.isSupported() && data["maximum_routes"] != 0 {
 			maxRoutes := int64(data["maximum_routes"].(int))
 			filterStruct.MaximumRoutes = &maxRoutes
 		}
@@ -312,7 +316,7 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
 	}
 
-	obj, err := resourceNsxtPolicyBgpNeighborResourceDataToStruct(d, id)
+	obj, err := resourceNsxtPolicyBgpNeighborResourceDataToStruct(d, id, m)
 	if err != nil {
 		return err
 	}
@@ -409,7 +413,7 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -450,7 +454,7 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 		}
 		rf["in_route_filter"] = inFilter
 		rf["out_route_filter"] = outFilter
-		if util.NsxVersionHigherOrEqual("3.0.0") && filter.MaximumRoutes != nil {
+		if policyBgpNeighborMaximumRoutesGate.isSupported() && filter.MaximumRoutes != nil {
 			rf["maximum_routes"] = int(*filter.MaximumRoutes)
 		}
 		rFilters = append(rFilters, rf)
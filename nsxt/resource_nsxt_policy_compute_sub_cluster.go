@@ -81,7 +81,7 @@ func resourceNsxtPolicyComputeSubClusterRead(d *schema.ResourceData, m interface
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -123,7 +123,7 @@ func policyComputeSubClusterPatch(siteID, epID, id string, d *schema.ResourceDat
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
 	collectionID := d.Get("compute_collection_id").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	discoveredNodes := getStringListFromSchemaList(d, "discovered_node_ids")
 	revision := int64(d.Get("revision").(int))
 	// Only manual type is supported for now
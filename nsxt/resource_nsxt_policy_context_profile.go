@@ -11,7 +11,6 @@ import (
 	cont_prof "github.com/vmware/terraform-provider-nsxt/api/infra/context_profiles"
 	custom_attr "github.com/vmware/terraform-provider-nsxt/api/infra/context_profiles/custom_attributes"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -44,6 +43,10 @@ var subAttributeReverseKeyMap = map[string]string{
 	model.PolicySubAttributes_KEY_CIFS_SMB_VERSION: "cifs_smb_version",
 }
 
+var policyContextProfileURLPartialMatchGate = newAttributeVersionGate("4.0.0", map[string][]string{
+	"nsxt_policy_context_profile": {"custom_url.custom_url_partial_match"},
+})
+
 func resourceNsxtPolicyContextProfile() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyContextProfileCreate,
@@ -215,7 +218,7 @@ func resourceNsxtPolicyContextProfileCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("At least one attribute should be set")
 	}
 
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.PolicyContextProfile{
 		DisplayName: &displayName,
@@ -253,7 +256,7 @@ func resourceNsxtPolicyContextProfileRead(d *schema.ResourceData, m interface{})
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -287,7 +290,7 @@ func resourceNsxtPolicyContextProfileUpdate(d *schema.ResourceData, m interface{
 		}
 		attributesStructList = append(attributesStructList, attributeStructList...)
 	}
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.PolicyContextProfile{
 		DisplayName: &displayName,
@@ -471,7 +474,7 @@ func fillAttributesInSchema(d *schema.ResourceData, policyAttributes []model.Pol
 				elem["sub_attribute"] = fillSubAttributesInSchema(policyAttribute.SubAttributes)
 			}
 			elem["is_alg_type"] = policyAttribute.IsALGType
-		} else if *policyAttribute.Key == model.PolicyAttributes_KEY_CUSTOM_URL && util.NsxVersionHigherOrEqual("4.0.0") {
+		} else if *policyAttribute.Key == model.PolicyAttributes_KEY_CUSTOM_URL && policyContextProfileURLPartialMatchGate.isSupported() {
 			elem["custom_url_partial_match"] = policyAttribute.CustomUrlPartialMatch
 		}
 		attributes[key] = append(attributes[key], elem)
@@ -82,10 +82,10 @@ func resourceNsxtPolicyDhcpServerExists(sessionContext utl.SessionContext, id st
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyDhcpServerSchemaToModel(d *schema.ResourceData) model.DhcpServerConfig {
+func resourceNsxtPolicyDhcpServerSchemaToModel(d *schema.ResourceData, m interface{}) model.DhcpServerConfig {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	leaseTime := int64(d.Get("lease_time").(int))
 	preferredEdgePaths := interface2StringList(d.Get("preferred_edge_paths").([]interface{}))
@@ -125,7 +125,7 @@ func resourceNsxtPolicyDhcpServerCreate(d *schema.ResourceData, m interface{}) e
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating DhcpServer with ID %s", id)
 	client := infra.NewDhcpServerConfigsClient(getSessionContext(d, m), connector)
-	err = client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d))
+	err = client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d, m))
 	if err != nil {
 		return handleCreateError("DhcpServer", id, err)
 	}
@@ -152,7 +152,7 @@ func resourceNsxtPolicyDhcpServerRead(d *schema.ResourceData, m interface{}) err
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -178,7 +178,7 @@ func resourceNsxtPolicyDhcpServerUpdate(d *schema.ResourceData, m interface{}) e
 	}
 
 	// Update the resource using PATCH
-	err := client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d))
+	err := client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d, m))
 	if err != nil {
 		return handleUpdateError("DhcpServer", id, err)
 	}
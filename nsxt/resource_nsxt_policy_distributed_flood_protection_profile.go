@@ -4,82 +4,90 @@
 package nsxt
 
 import (
-	"fmt"
-	"log"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
 	"github.com/vmware/terraform-provider-nsxt/api/infra"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
 )
 
 func resourceNsxtPolicyDistributedFloodProtectionProfile() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNsxtPolicyDistributedFloodProtectionProfileCreate,
-		Read:   resourceNsxtPolicyDistributedFloodProtectionProfileRead,
-		Update: resourceNsxtPolicyDistributedFloodProtectionProfileUpdate,
-		Delete: resourceNsxtPolicyFloodProtectionProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: nsxtPolicyPathResourceImporter,
-		},
-		Schema: getDistributedFloodProtectionProfile(),
-	}
+	return getPolicyMetadataResource(&policyMetadataResource{
+		objectType:   "DistributedFloodProtectionProfile",
+		schema:       getDistributedFloodProtectionProfile(),
+		modelType:    reflect.TypeOf(model.DistributedFloodProtectionProfile{}),
+		bindingType:  model.DistributedFloodProtectionProfileBindingType(),
+		resourceType: model.FloodProtectionProfile_RESOURCE_TYPE_DISTRIBUTEDFLOODPROTECTIONPROFILE,
+		exists:       resourceNsxtPolicyFloodProtectionProfileExists,
+		getObject:    getPolicyFloodProtectionProfile,
+		patchObject:  patchPolicyFloodProtectionProfile,
+		deleteObject: deletePolicyFloodProtectionProfile,
+	})
 }
 
-func getFloodProtectionProfile() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"nsx_id":       getNsxIDSchema(),
-		"path":         getPathSchema(),
-		"display_name": getDisplayNameSchema(),
-		"description":  getDescriptionSchema(),
-		"revision":     getRevisionSchema(),
-		"tag":          getTagsSchema(),
-		"context":      getContextSchema(false, false),
-		"icmp_active_flow_limit": {
+func getFloodProtectionProfileLimitSchema(description string, sdkFieldName string) *metadata.ExtendedSchema {
+	return &metadata.ExtendedSchema{
+		Schema: schema.Schema{
 			Type:         schema.TypeInt,
-			Description:  "Active ICMP connections limit",
+			Description:  description,
 			Optional:     true,
 			ValidateFunc: validation.IntBetween(0, 1000000),
 		},
-		"other_active_conn_limit": {
-			Type:         schema.TypeInt,
-			Description:  "Timeout after first TN",
-			Optional:     true,
-			ValidateFunc: validation.IntBetween(0, 1000000),
-		},
-		"tcp_half_open_conn_limit": {
-			Type:         schema.TypeInt,
-			Description:  "Active half open TCP connections limit",
-			Optional:     true,
-			ValidateFunc: validation.IntBetween(0, 1000000),
-		},
-		"udp_active_flow_limit": {
-			Type:         schema.TypeInt,
-			Description:  "Active UDP connections limit",
-			Optional:     true,
-			ValidateFunc: validation.IntBetween(0, 1000000),
+		Metadata: metadata.Metadata{
+			SchemaType:   "int",
+			SdkFieldName: sdkFieldName,
+			OmitEmpty:    true,
 		},
 	}
 }
 
-func getDistributedFloodProtectionProfile() map[string]*schema.Schema {
+func getFloodProtectionProfile() map[string]*metadata.ExtendedSchema {
+	return map[string]*metadata.ExtendedSchema{
+		"nsx_id":                   metadata.GetExtendedSchema(getNsxIDSchema()),
+		"path":                     metadata.GetExtendedSchema(getPathSchema()),
+		"display_name":             metadata.GetExtendedSchema(getDisplayNameSchema()),
+		"description":              metadata.GetExtendedSchema(getDescriptionSchema()),
+		"revision":                 metadata.GetExtendedSchema(getRevisionSchema()),
+		"tag":                      metadata.GetExtendedSchema(getTagsSchema()),
+		"context":                  metadata.GetExtendedSchema(getContextSchema(false, false)),
+		"icmp_active_flow_limit":   getFloodProtectionProfileLimitSchema("Active ICMP connections limit", "IcmpActiveFlowLimit"),
+		"other_active_conn_limit":  getFloodProtectionProfileLimitSchema("Timeout after first TN", "OtherActiveConnLimit"),
+		"tcp_half_open_conn_limit": getFloodProtectionProfileLimitSchema("Active half open TCP connections limit", "TcpHalfOpenConnLimit"),
+		"udp_active_flow_limit":    getFloodProtectionProfileLimitSchema("Active UDP connections limit", "UdpActiveFlowLimit"),
+	}
+}
+
+func getDistributedFloodProtectionProfile() map[string]*metadata.ExtendedSchema {
 	baseProfile := getFloodProtectionProfile()
-	baseProfile["enable_rst_spoofing"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Description: "Flag to indicate rst spoofing is enabled",
-		Optional:    true,
-		Default:     false,
+	baseProfile["enable_rst_spoofing"] = &metadata.ExtendedSchema{
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Flag to indicate rst spoofing is enabled",
+			Optional:    true,
+			Default:     false,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "EnableRstSpoofing",
+		},
 	}
-	baseProfile["enable_syncache"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Description: "Flag to indicate syncache is enabled",
-		Optional:    true,
-		Default:     false,
+	baseProfile["enable_syncache"] = &metadata.ExtendedSchema{
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Flag to indicate syncache is enabled",
+			Optional:    true,
+			Default:     false,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "EnableSyncache",
+		},
 	}
 	return baseProfile
 }
@@ -99,135 +107,26 @@ func resourceNsxtPolicyFloodProtectionProfileExists(sessionContext utl.SessionCo
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyDistributedFloodProtectionProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
-	connector := getPolicyConnector(m)
-
-	displayName := d.Get("display_name").(string)
-	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
-	icmpActiveFlowLimit := int64(d.Get("icmp_active_flow_limit").(int))
-	otherActiveConnLimit := int64(d.Get("other_active_conn_limit").(int))
-	tcpHalfOpenConnLimit := int64(d.Get("tcp_half_open_conn_limit").(int))
-	udpActiveFlowLimit := int64(d.Get("udp_active_flow_limit").(int))
-	enableRstSpoofing := d.Get("enable_rst_spoofing").(bool)
-	enableSyncache := d.Get("enable_syncache").(bool)
-
-	obj := model.DistributedFloodProtectionProfile{
-		DisplayName:       &displayName,
-		Description:       &description,
-		Tags:              tags,
-		ResourceType:      model.FloodProtectionProfile_RESOURCE_TYPE_DISTRIBUTEDFLOODPROTECTIONPROFILE,
-		EnableRstSpoofing: &enableRstSpoofing,
-		EnableSyncache:    &enableSyncache,
-	}
-	if icmpActiveFlowLimit != 0 {
-		obj.IcmpActiveFlowLimit = &icmpActiveFlowLimit
-	}
-	if otherActiveConnLimit != 0 {
-		obj.OtherActiveConnLimit = &otherActiveConnLimit
-	}
-	if tcpHalfOpenConnLimit != 0 {
-		obj.TcpHalfOpenConnLimit = &tcpHalfOpenConnLimit
-	}
-	if udpActiveFlowLimit != 0 {
-		obj.UdpActiveFlowLimit = &udpActiveFlowLimit
-	}
-
-	converter := bindings.NewTypeConverter()
-	profileValue, errs := converter.ConvertToVapi(obj, model.DistributedFloodProtectionProfileBindingType())
-	if errs != nil {
-		return errs[0]
-	}
-	profileStruct := profileValue.(*data.StructValue)
-
-	log.Printf("[INFO] Patching DistributedFloodProtectionProfile with ID %s", id)
-	client := infra.NewFloodProtectionProfilesClient(getSessionContext(d, m), connector)
-	return client.Patch(id, profileStruct, nil)
-}
-
-func resourceNsxtPolicyDistributedFloodProtectionProfileCreate(d *schema.ResourceData, m interface{}) error {
-
-	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyFloodProtectionProfileExists)
-	if err != nil {
-		return err
-	}
-
-	err = resourceNsxtPolicyDistributedFloodProtectionProfilePatch(d, m, id)
-	if err != nil {
-		return handleCreateError("FloodProtectionProfile", id, err)
-	}
-
-	d.SetId(id)
-	d.Set("nsx_id", id)
-
-	return resourceNsxtPolicyDistributedFloodProtectionProfileRead(d, m)
-}
-
-func resourceNsxtPolicyDistributedFloodProtectionProfileRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-	converter := bindings.NewTypeConverter()
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining FloodProtectionProfile ID")
-	}
-
-	client := infra.NewFloodProtectionProfilesClient(getSessionContext(d, m), connector)
-	dpffData, err := client.Get(id)
-	if err != nil {
-		return handleReadError(d, "FloodProtectionProfile", id, err)
-	}
-
-	dfppInterface, errs := converter.ConvertToGolang(dpffData, model.DistributedFloodProtectionProfileBindingType())
-	if errs != nil {
-		return errs[0]
+func getPolicyFloodProtectionProfile(sessionContext utl.SessionContext, connector client.Connector, id string) (interface{}, error) {
+	client := infra.NewFloodProtectionProfilesClient(sessionContext, connector)
+	if client == nil {
+		return nil, policyResourceNotSupportedError()
 	}
-	obj := dfppInterface.(model.DistributedFloodProtectionProfile)
-
-	d.Set("display_name", obj.DisplayName)
-	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
-	d.Set("nsx_id", id)
-	d.Set("path", obj.Path)
-	d.Set("revision", obj.Revision)
-
-	d.Set("icmp_active_flow_limit", obj.IcmpActiveFlowLimit)
-	d.Set("other_active_conn_limit", obj.OtherActiveConnLimit)
-	d.Set("tcp_half_open_conn_limit", obj.TcpHalfOpenConnLimit)
-	d.Set("udp_active_flow_limit", obj.UdpActiveFlowLimit)
-	d.Set("enable_rst_spoofing", obj.EnableRstSpoofing)
-	d.Set("enable_syncache", obj.EnableSyncache)
-
-	return nil
+	return client.Get(id)
 }
 
-func resourceNsxtPolicyDistributedFloodProtectionProfileUpdate(d *schema.ResourceData, m interface{}) error {
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining FloodProtectionProfile ID")
-	}
-
-	err := resourceNsxtPolicyDistributedFloodProtectionProfilePatch(d, m, id)
-	if err != nil {
-		return handleUpdateError("FloodProtectionProfile", id, err)
+func patchPolicyFloodProtectionProfile(sessionContext utl.SessionContext, connector client.Connector, id string, obj interface{}) error {
+	client := infra.NewFloodProtectionProfilesClient(sessionContext, connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
 	}
-
-	return resourceNsxtPolicyDistributedFloodProtectionProfileRead(d, m)
+	return client.Patch(id, obj.(*data.StructValue), nil)
 }
 
-func resourceNsxtPolicyFloodProtectionProfileDelete(d *schema.ResourceData, m interface{}) error {
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining FloodProtectionProfile ID")
-	}
-
-	connector := getPolicyConnector(m)
-	client := infra.NewFloodProtectionProfilesClient(getSessionContext(d, m), connector)
-	err := client.Delete(id, nil)
-	if err != nil {
-		return handleDeleteError("FloodProtectionProfile", id, err)
+func deletePolicyFloodProtectionProfile(sessionContext utl.SessionContext, connector client.Connector, id string) error {
+	client := infra.NewFloodProtectionProfilesClient(sessionContext, connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
 	}
-	return nil
+	return client.Delete(id, nil)
 }
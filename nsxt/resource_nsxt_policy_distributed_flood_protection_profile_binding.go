@@ -59,7 +59,7 @@ func resourceNsxtPolicyDistributedFloodProtectionProfileBindingPatch(d *schema.R
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	profilePath := d.Get("profile_path").(string)
 	seqNum := int64(d.Get("sequence_number").(int))
 	obj := model.PolicyFirewallFloodProtectionProfileBindingMap{
@@ -142,7 +142,7 @@ func resourceNsxtPolicyDistributedFloodProtectionProfileBindingRead(d *schema.Re
 		return handleReadError(d, "FloodProtectionProfileBinding", id, err)
 	}
 
-	floodProtectionProfileBindingModelToSchema(d, *binding.DisplayName, *binding.Description, id, *binding.Path, *binding.ProfilePath, *binding.SequenceNumber, binding.Tags, *binding.Revision)
+	floodProtectionProfileBindingModelToSchema(d, *binding.DisplayName, *binding.Description, id, *binding.Path, *binding.ProfilePath, *binding.SequenceNumber, binding.Tags, *binding.Revision, m)
 
 	return nil
 }
@@ -72,7 +72,7 @@ func resourceNsxtPolicyDNSForwarderZoneExists(sessionContext utl.SessionContext,
 func policyDNSForwarderZonePatch(id string, d *schema.ResourceData, m interface{}, connector client.Connector) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	dnsDomainNames := getStringListFromSchemaList(d, "dns_domain_names")
 	sourceIP := d.Get("source_ip").(string)
 	upstreamServers := getStringListFromSchemaList(d, "upstream_servers")
@@ -135,7 +135,7 @@ func resourceNsxtPolicyDNSForwarderZoneRead(d *schema.ResourceData, m interface{
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -79,7 +79,7 @@ func resourceNsxtPolicyEvpnConfigRead(d *schema.ResourceData, m interface{}) err
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 	d.Set("mode", obj.Mode)
@@ -91,13 +91,13 @@ func resourceNsxtPolicyEvpnConfigRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func patchNsxtPolicyEvpnConfig(connector client.Connector, d *schema.ResourceData, gwID string, isGlobalManager bool) error {
+func patchNsxtPolicyEvpnConfig(connector client.Connector, d *schema.ResourceData, gwID string, isGlobalManager bool, m interface{}) error {
 
 	var obj model.EvpnConfig
 	if d != nil {
 		displayName := d.Get("display_name").(string)
 		description := d.Get("description").(string)
-		tags := getPolicyTagsFromSchema(d)
+		tags := getPolicyTagsFromSchema(d, m)
 		vniPoolPath := d.Get("vni_pool_path").(string)
 		evpnTenantPath := d.Get("evpn_tenant_path").(string)
 		mode := d.Get("mode").(string)
@@ -141,7 +141,7 @@ func resourceNsxtPolicyEvpnConfigCreate(d *schema.ResourceData, m interface{}) e
 
 	log.Printf("[INFO] Creating EVPN Config for Gateway %s", gwID)
 
-	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isGlobalManager)
+	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isGlobalManager, m)
 	if err != nil {
 		return handleCreateError("Evpn Config", gwID, err)
 	}
@@ -160,7 +160,7 @@ func resourceNsxtPolicyEvpnConfigUpdate(d *schema.ResourceData, m interface{}) e
 	}
 
 	log.Printf("[INFO] Updating Evpn Config with ID %s", gwID)
-	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isPolicyGlobalManager(m))
+	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isPolicyGlobalManager(m), m)
 	if err != nil {
 		return handleUpdateError("Evpn Config", gwID, err)
 	}
@@ -178,7 +178,7 @@ func resourceNsxtPolicyEvpnConfigDelete(d *schema.ResourceData, m interface{}) e
 	}
 
 	// There is no DELETE API for this object - we need to just disable it
-	err := patchNsxtPolicyEvpnConfig(connector, nil, gwID, isPolicyGlobalManager(m))
+	err := patchNsxtPolicyEvpnConfig(connector, nil, gwID, isPolicyGlobalManager(m), m)
 	if err != nil {
 		return handleDeleteError("Evpn Config", gwID, err)
 	}
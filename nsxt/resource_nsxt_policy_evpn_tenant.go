@@ -103,7 +103,7 @@ func policyEvpnTenantPatch(id string, d *schema.ResourceData, m interface{}) err
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	tzPath := d.Get("transport_zone_path").(string)
 	vniPoolPath := d.Get("vni_pool_path").(string)
 	mappings := getEvpnTenantMappingsFromSchema(d)
@@ -158,7 +158,7 @@ func resourceNsxtPolicyEvpnTenantRead(d *schema.ResourceData, m interface{}) err
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("transport_zone_path", obj.TransportZonePath)
 	d.Set("vni_pool_path", obj.VniPoolPath)
 
@@ -98,7 +98,7 @@ func resourceNsxtPolicyGatewayCommunityListCreate(d *schema.ResourceData, m inte
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	communities := getStringListFromSchemaSet(d, "communities")
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.CommunityList{
 		DisplayName: &displayName,
@@ -168,7 +168,7 @@ func resourceNsxtPolicyGatewayCommunityListRead(d *schema.ResourceData, m interf
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -190,7 +190,7 @@ func resourceNsxtPolicyGatewayCommunityListUpdate(d *schema.ResourceData, m inte
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	communities := getStringListFromSchemaSet(d, "communities")
 	revision := int64(d.Get("revision").(int))
 
@@ -10,7 +10,6 @@ import (
 	tier0s "github.com/vmware/terraform-provider-nsxt/api/infra/tier_0s"
 	tier1s "github.com/vmware/terraform-provider-nsxt/api/infra/tier_1s"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,12 +25,17 @@ var gatewayDNSForwarderLogLevelTypeValues = []string{
 	model.PolicyDnsForwarder_LOG_LEVEL_FATAL,
 }
 
+var policyDNSForwarderCacheSizeGate = newAttributeVersionGate("3.2.0", map[string][]string{
+	"nsxt_policy_gateway_dns_forwarder": {"cache_size"},
+})
+
 func resourceNsxtPolicyGatewayDNSForwarder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayDNSForwarderCreate,
-		Read:   resourceNsxtPolicyGatewayDNSForwarderRead,
-		Update: resourceNsxtPolicyGatewayDNSForwarderUpdate,
-		Delete: resourceNsxtPolicyGatewayDNSForwarderDelete,
+		Create:        resourceNsxtPolicyGatewayDNSForwarderCreate,
+		Read:          resourceNsxtPolicyGatewayDNSForwarderRead,
+		Update:        resourceNsxtPolicyGatewayDNSForwarderUpdate,
+		Delete:        resourceNsxtPolicyGatewayDNSForwarderDelete,
+		CustomizeDiff: validateGatewayContextDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyGatewayDNSForwarderImport,
 		},
@@ -111,7 +115,7 @@ func resourceNsxtPolicyGatewayDNSForwarderRead(d *schema.ResourceData, m interfa
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 	d.Set("listener_ip", obj.ListenerIp)
@@ -124,11 +128,11 @@ func resourceNsxtPolicyGatewayDNSForwarderRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, connector client.Connector, d *schema.ResourceData, gwID string, isT0 bool) error {
+func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, connector client.Connector, d *schema.ResourceData, gwID string, isT0 bool, m interface{}) error {
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	listenerIP := d.Get("listener_ip").(string)
 	defaultZonePath := d.Get("default_forwarder_zone_path").(string)
 	conditionalZonePaths := getStringListFromSchemaSet(d, "conditional_forwarder_zone_paths")
@@ -150,7 +154,7 @@ func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, conne
 		obj.ConditionalForwarderZonePaths = conditionalZonePaths
 	}
 
-	if util.NsxVersionHigherOrEqual("3.2.0") {
+	if policyDNSForwarderCacheSizeGate.isSupported() {
 		obj.CacheSize = &cacheSize
 	}
 
@@ -194,7 +198,7 @@ func resourceNsxtPolicyGatewayDNSForwarderCreate(d *schema.ResourceData, m inter
 
 	log.Printf("[INFO] Creating Dns Forwarder for Gateway %s", gwID)
 
-	err = patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0)
+	err = patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0, m)
 	if err != nil {
 		return handleCreateError("Gateway Dns Forwarder", gwID, err)
 	}
@@ -217,7 +221,7 @@ func resourceNsxtPolicyGatewayDNSForwarderUpdate(d *schema.ResourceData, m inter
 		return handleMultitenancyTier0Error()
 	}
 	log.Printf("[INFO] Updating Gateway Dns Forwarder with ID %s", gwID)
-	err := patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0)
+	err := patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0, m)
 	if err != nil {
 		return handleUpdateError("Gateway Dns Forwarder", gwID, err)
 	}
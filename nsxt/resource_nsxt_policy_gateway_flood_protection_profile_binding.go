@@ -77,7 +77,7 @@ func resourceNsxtPolicyGatewayFloodProtectionProfileBindingPatch(d *schema.Resou
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	profilePath := d.Get("profile_path").(string)
 	obj := model.FloodProtectionProfileBindingMap{
 		DisplayName: &displayName,
@@ -199,14 +199,14 @@ func resourceNsxtPolicyGatewayFloodProtectionProfileBindingRead(d *schema.Resour
 		return handleReadError(d, "GatewayFloodProtectionProfileBinding", id, err)
 	}
 
-	floodProtectionProfileBindingModelToSchema(d, *binding.DisplayName, *binding.Description, id, *binding.Path, *binding.ProfilePath, -1, binding.Tags, *binding.Revision)
+	floodProtectionProfileBindingModelToSchema(d, *binding.DisplayName, *binding.Description, id, *binding.Path, *binding.ProfilePath, -1, binding.Tags, *binding.Revision, m)
 	return nil
 }
 
-func floodProtectionProfileBindingModelToSchema(d *schema.ResourceData, displayName, description, nsxID, path, profilePath string, seqNum int64, tags []model.Tag, revision int64) {
+func floodProtectionProfileBindingModelToSchema(d *schema.ResourceData, displayName, description, nsxID, path, profilePath string, seqNum int64, tags []model.Tag, revision int64, m interface{}) {
 	d.Set("display_name", displayName)
 	d.Set("description", description)
-	setPolicyTagsInSchema(d, tags)
+	setPolicyTagsInSchema(d, tags, m)
 	d.Set("nsx_id", nsxID)
 	d.Set("path", path)
 	d.Set("revision", revision)
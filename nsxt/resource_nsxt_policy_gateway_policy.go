@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -17,16 +19,20 @@ import (
 )
 
 func resourceNsxtPolicyGatewayPolicy() *schema.Resource {
+	policySchema := getPolicyGatewayPolicySchema()
+
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayPolicyCreate,
-		Read:   resourceNsxtPolicyGatewayPolicyRead,
-		Update: resourceNsxtPolicyGatewayPolicyUpdate,
-		Delete: resourceNsxtPolicyGatewayPolicyDelete,
+		CreateContext: resourceNsxtPolicyGatewayPolicyCreate,
+		ReadContext:   resourceNsxtPolicyGatewayPolicyRead,
+		UpdateContext: resourceNsxtPolicyGatewayPolicyUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
 
-		Schema: getPolicyGatewayPolicySchema(),
+		Schema:         policySchema,
+		SchemaVersion:  policySecurityPolicySchemaVersion,
+		StateUpgraders: getPolicySecurityPolicyStateUpgraders(policySchema),
 	}
 }
 
@@ -126,7 +132,7 @@ func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, con
 	domain := d.Get("domain").(string)
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	category := d.Get("category").(string)
 	comments := d.Get("comments").(string)
 	locked := d.Get("locked").(bool)
@@ -169,42 +175,44 @@ func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, con
 	return gatewayPolicyInfraPatch(getSessionContext(d, m), obj, domain, m)
 }
 
-func resourceNsxtPolicyGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m = withContextPolicyConnector(ctx, m)
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyGatewayPolicyExistsPartial(d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = policyGatewayPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
 	if err != nil {
-		return handleCreateError("Gateway Policy", id, err)
+		return handleCreateErrorDiagnostics(getPolicyGatewayPolicySchema(), d, "Gateway Policy", id, err)
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m = withContextPolicyConnector(ctx, m)
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	obj, err := getGatewayPolicyInDomain(getSessionContext(d, m), id, d.Get("domain").(string), connector)
 	if err != nil {
-		return handleReadError(d, "Gateway Policy", id, err)
+		return handleReadErrorDiagnostics(d, "Gateway Policy", id, err)
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("domain", getDomainFromResourcePath(*obj.Path))
@@ -218,36 +226,37 @@ func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{})
 		d.Set("tcp_strict", *obj.TcpStrict)
 	}
 	d.Set("revision", obj.Revision)
-	return setPolicyRulesInSchema(d, obj.Rules)
+	return diag.FromErr(setPolicyRulesInSchema(d, obj.Rules))
 }
 
-func resourceNsxtPolicyGatewayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m = withContextPolicyConnector(ctx, m)
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	err := policyGatewayPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
 	if err != nil {
-		return handleUpdateError("Gateway Policy", id, err)
+		return handleUpdateErrorDiagnostics(getPolicyGatewayPolicySchema(), d, "Gateway Policy", id, err)
 	}
 
-	return resourceNsxtPolicyGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
-	connector := getPolicyConnector(m)
+	connector := getContextPolicyConnector(ctx, m)
 	client := domains.NewGatewayPoliciesClient(getSessionContext(d, m), connector)
 	err := client.Delete(d.Get("domain").(string), id)
 	if err != nil {
-		return handleDeleteError("Gateway Policy", id, err)
+		return handleDeleteErrorDiagnostics("Gateway Policy", id, err)
 	}
 
 	return nil
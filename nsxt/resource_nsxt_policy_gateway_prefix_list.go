@@ -208,7 +208,7 @@ func resourceNsxtPolicyGatewayPrefixListRead(d *schema.ResourceData, m interface
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
 	setPrefixesInSchema(d, obj.Prefixes)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -263,7 +263,7 @@ func resourceNsxtPolicyGatewayPrefixListCreate(d *schema.ResourceData, m interfa
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	prefixes := getPrefixesFromSchema(d)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	prefixListStruct := model.PrefixList{
 		Id:          &id,
@@ -303,7 +303,7 @@ func resourceNsxtPolicyGatewayPrefixListUpdate(d *schema.ResourceData, m interfa
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	prefixes := getPrefixesFromSchema(d)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	prefixListStruct := model.PrefixList{
 		Id:          &id,
@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	tier0s "github.com/vmware/terraform-provider-nsxt/api/infra/tier_0s"
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -72,7 +71,7 @@ func policyGatewayRedistributionConfigPatch(d *schema.ResourceData, m interface{
 		BgpEnabled: &bgpEnabled,
 	}
 
-	if util.NsxVersionHigherOrEqual("3.1.0") {
+	if policyGatewayOspfRedistributionGate.isSupported() {
 		redistributionStruct.OspfEnabled = &ospfEnabled
 	}
 
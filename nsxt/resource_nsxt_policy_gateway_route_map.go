@@ -224,10 +224,10 @@ func policyGatewayRouteMapBuildEntry(d *schema.ResourceData, entryNo int, schema
 	return obj
 }
 
-func resourceNsxtPolicyGatewayRouteMapPatch(gwID string, id string, d *schema.ResourceData, isGlobalManager bool, connector client.Connector) error {
+func resourceNsxtPolicyGatewayRouteMapPatch(gwID string, id string, d *schema.ResourceData, isGlobalManager bool, connector client.Connector, m interface{}) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	schemaEntries := d.Get("entry").([]interface{})
 	var entries []model.RouteMapEntry
@@ -284,7 +284,7 @@ func resourceNsxtPolicyGatewayRouteMapCreate(d *schema.ResourceData, m interface
 	}
 
 	log.Printf("[INFO] Creating Gateway Route Map with ID %s", id)
-	err := resourceNsxtPolicyGatewayRouteMapPatch(gwID, id, d, isPolicyGlobalManager(m), connector)
+	err := resourceNsxtPolicyGatewayRouteMapPatch(gwID, id, d, isPolicyGlobalManager(m), connector, m)
 	if err != nil {
 		return handleCreateError("Route Map", id, err)
 	}
@@ -332,7 +332,7 @@ func resourceNsxtPolicyGatewayRouteMapRead(d *schema.ResourceData, m interface{}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -390,7 +390,7 @@ func resourceNsxtPolicyGatewayRouteMapUpdate(d *schema.ResourceData, m interface
 	_, gwID := parseGatewayPolicyPath(gwPath)
 
 	log.Printf("[INFO] Updating Gateway Route Map with ID %s", id)
-	err := resourceNsxtPolicyGatewayRouteMapPatch(gwID, id, d, isPolicyGlobalManager(m), connector)
+	err := resourceNsxtPolicyGatewayRouteMapPatch(gwID, id, d, isPolicyGlobalManager(m), connector, m)
 	if err != nil {
 		return handleCreateError("Gateway Route Map", id, err)
 	}
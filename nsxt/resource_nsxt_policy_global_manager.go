@@ -59,10 +59,10 @@ func resourceNsxtPolicyGlobalManager() *schema.Resource {
 	}
 }
 
-func getGlobalManagerFromSchema(d *schema.ResourceData) gm_model.GlobalManager {
+func getGlobalManagerFromSchema(d *schema.ResourceData, m interface{}) gm_model.GlobalManager {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getGMTagsFromSchema(d)
+	tags := getGMTagsFromSchema(d, m)
 	failIfRttExceeded := d.Get("fail_if_rtt_exceeded").(bool)
 	maximumRtt := int64(d.Get("maximum_rtt").(int))
 	connectionInfos := getConnectionInfosFromSchema(d, "connection_info")
@@ -104,7 +104,7 @@ func resourceNsxtPolicyGlobalManagerCreate(d *schema.ResourceData, m interface{}
 
 	connector := getPolicyConnector(m)
 	client := global_infra.NewGlobalManagersClient(connector)
-	gm := getGlobalManagerFromSchema(d)
+	gm := getGlobalManagerFromSchema(d, m)
 
 	err = client.Patch(id, gm, nil)
 	if err != nil {
@@ -131,7 +131,7 @@ func resourceNsxtPolicyGlobalManagerRead(d *schema.ResourceData, m interface{})
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setGMTagsInSchema(d, obj.Tags)
+	setGMTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -154,7 +154,7 @@ func resourceNsxtPolicyGlobalManagerUpdate(d *schema.ResourceData, m interface{}
 	connector := getPolicyConnector(m)
 	client := global_infra.NewGlobalManagersClient(connector)
 
-	obj := getGlobalManagerFromSchema(d)
+	obj := getGlobalManagerFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	obj.Revision = &revision
 
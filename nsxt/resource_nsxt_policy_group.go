@@ -4,13 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/vmware/terraform-provider-nsxt/api/infra/domains"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -84,6 +84,24 @@ var groupTypeValues = []string{
 	model.Group_GROUP_TYPE_ANTREA,
 }
 
+// Condition keys that are only applicable to specific member type
+var conditionKeyMemberTypes = map[string]string{
+	model.Condition_KEY_OSNAME:       model.Condition_MEMBER_TYPE_VIRTUALMACHINE,
+	model.Condition_KEY_COMPUTERNAME: model.Condition_MEMBER_TYPE_VIRTUALMACHINE,
+	model.Condition_KEY_NODETYPE:     model.Condition_MEMBER_TYPE_TRANSPORTNODE,
+	model.Condition_KEY_GROUPTYPE:    model.Condition_MEMBER_TYPE_GROUP,
+}
+
+// Condition operators that are only applicable to specific keys
+var conditionOperatorKeys = map[string][]string{
+	model.Condition_OPERATOR_IN:    {model.Condition_KEY_IPADDRESS, model.Condition_KEY_PODCIDR},
+	model.Condition_OPERATOR_NOTIN: {model.Condition_KEY_IPADDRESS, model.Condition_KEY_PODCIDR},
+}
+
+var policyGroupTypeGate = newAttributeVersionGate("3.2.0", map[string][]string{
+	"nsxt_policy_group": {"group_type"},
+})
+
 func resourceNsxtPolicyGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyGroupCreate,
@@ -93,6 +111,7 @@ func resourceNsxtPolicyGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
+		CustomizeDiff: resourceNsxtPolicyGroupCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -339,10 +358,26 @@ func resourceNsxtPolicyGroupExistsInDomainPartial(domain string) func(sessionCon
 	}
 }
 
+func validateGroupCondition(condition map[string]interface{}) error {
+	key := condition["key"].(string)
+	memberType := condition["member_type"].(string)
+	operator := condition["operator"].(string)
+	if requiredMemberType, ok := conditionKeyMemberTypes[key]; ok && memberType != requiredMemberType {
+		return fmt.Errorf("Condition key '%v' is only supported with member_type '%v', but found '%v'", key, requiredMemberType, memberType)
+	}
+	if keys, ok := conditionOperatorKeys[operator]; ok && !stringInList(key, keys) {
+		return fmt.Errorf("Condition operator '%v' is only supported with keys %v, but found '%v'", operator, keys, key)
+	}
+	return nil
+}
+
 func validateNestedGroupConditions(conditions []interface{}) (string, error) {
 	memberType := ""
 	for _, cond := range conditions {
 		condMap := cond.(map[string]interface{})
+		if err := validateGroupCondition(condMap); err != nil {
+			return "", err
+		}
 		condMemberType := condMap["member_type"].(string)
 		if memberType != "" && condMemberType != memberType {
 			return "", fmt.Errorf("Nested conditions must all use the same member_type, but found '%v' with '%v'", condMemberType, memberType)
@@ -829,6 +864,24 @@ func validateGroupCriteriaAndConjunctions(criteriaSets []interface{}, conjunctio
 	return criteriaMeta, nil
 }
 
+// resourceNsxtPolicyGroupCustomizeDiff validates group membership definition at
+// plan time, so that invalid group is discovered before any object is changed
+func resourceNsxtPolicyGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if isResourceDiffConfigKnown(d, "criteria") && isResourceDiffConfigKnown(d, "conjunction") {
+		criteriaSets := d.Get("criteria").([]interface{})
+		conjunctions := d.Get("conjunction").([]interface{})
+		if _, err := validateGroupCriteriaAndConjunctions(criteriaSets, conjunctions); err != nil {
+			return err
+		}
+	}
+
+	if m == nil {
+		// Provider is not configured yet
+		return nil
+	}
+	return validateExtendedCriteriaLocalManager(d.Get("extended_criteria").([]interface{}), m)
+}
+
 func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 
@@ -852,17 +905,13 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	extendedCriteriaSets := d.Get("extended_criteria").([]interface{})
-	err = validateExtendedCriteriaLocalManager(extendedCriteriaSets, m)
-	if err != nil {
-		return err
-	}
 	extendedExpressionList, err := buildGroupExtendedExpressionListData(extendedCriteriaSets)
 	if err != nil {
 		return err
 	}
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	var groupTypes []string
 	groupType := d.Get("group_type").(string)
@@ -875,7 +924,7 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 		ExtendedExpression: extendedExpressionList,
 	}
 
-	if groupType != "" && util.NsxVersionHigherOrEqual("3.2.0") {
+	if groupType != "" && policyGroupTypeGate.isSupported() {
 		obj.GroupType = groupTypes
 	}
 
@@ -908,13 +957,13 @@ func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("domain", getDomainFromResourcePath(*obj.Path))
 	d.Set("revision", obj.Revision)
 	groupType := ""
-	if len(obj.GroupType) > 0 && util.NsxVersionHigherOrEqual("3.2.0") {
+	if len(obj.GroupType) > 0 && policyGroupTypeGate.isSupported() {
 		groupType = obj.GroupType[0]
 		d.Set("group_type", groupType)
 	}
@@ -963,10 +1012,6 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	extendedCriteriaSets := d.Get("extended_criteria").([]interface{})
-	err = validateExtendedCriteriaLocalManager(extendedCriteriaSets, m)
-	if err != nil {
-		return err
-	}
 	extendedExpressionList, err := buildGroupExtendedExpressionListData(extendedCriteriaSets)
 	if err != nil {
 		return err
@@ -975,7 +1020,7 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	var groupTypes []string
 	groupType := d.Get("group_type").(string)
@@ -988,7 +1033,7 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 		ExtendedExpression: extendedExpressionList,
 	}
 
-	if groupType != "" && util.NsxVersionHigherOrEqual("3.2.0") {
+	if groupType != "" && policyGroupTypeGate.isSupported() {
 		obj.GroupType = groupTypes
 	}
 
@@ -1,12 +1,16 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/vmware/terraform-provider-nsxt/api/infra/domains"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
 )
 
 func TestAccResourceNsxtPolicyGroup_basicImport(t *testing.T) {
@@ -1508,3 +1512,64 @@ resource "nsxt_policy_group" "test" {
 }
 `, name)
 }
+
+func TestProviderGroupPlanValidation(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	res := provider.ResourcesMap["nsxt_policy_group"]
+	condition := func(key string, memberType string, operator string) map[string]interface{} {
+		return map[string]interface{}{"key": key, "member_type": memberType, "operator": operator, "value": "v"}
+	}
+	ipCriteria := map[string]interface{}{
+		"ipaddress_expression": []interface{}{map[string]interface{}{"ip_addresses": []interface{}{"10.0.0.1"}}},
+	}
+	vmCriteria := map[string]interface{}{
+		"condition": []interface{}{condition("Tag", "VirtualMachine", "EQUALS")},
+	}
+	and := map[string]interface{}{"operator": "AND"}
+	or := map[string]interface{}{"operator": "OR"}
+
+	cases := []struct {
+		name        string
+		criteria    []interface{}
+		conjunction []interface{}
+		expectedErr string
+	}{
+		{"valid", []interface{}{vmCriteria, ipCriteria}, []interface{}{or}, ""},
+		{"missing conjunction", []interface{}{vmCriteria, ipCriteria}, nil, "Missing conjunction"},
+		{"missing criteria", []interface{}{vmCriteria}, []interface{}{or}, "Missing criteria"},
+		{"mixed expressions in AND", []interface{}{vmCriteria, ipCriteria}, []interface{}{and}, "AND conjunctions must use the same types"},
+		{"mixed member types in AND", []interface{}{vmCriteria, map[string]interface{}{
+			"condition": []interface{}{condition("Tag", "Segment", "EQUALS")},
+		}}, []interface{}{and}, "same member types"},
+		{"mixed member types in nested condition", []interface{}{map[string]interface{}{
+			"condition": []interface{}{condition("Tag", "VirtualMachine", "EQUALS"), condition("Tag", "Segment", "EQUALS")},
+		}}, nil, "same member_type"},
+		{"heterogeneous criteria", []interface{}{map[string]interface{}{
+			"condition":            []interface{}{condition("Tag", "VirtualMachine", "EQUALS")},
+			"ipaddress_expression": ipCriteria["ipaddress_expression"],
+		}}, nil, "homogeneous"},
+		{"key not applicable to member type", []interface{}{map[string]interface{}{
+			"condition": []interface{}{condition("OSName", "Segment", "EQUALS")},
+		}}, nil, "only supported with member_type 'VirtualMachine'"},
+		{"operator not applicable to key", []interface{}{map[string]interface{}{
+			"condition": []interface{}{condition("Tag", "VirtualMachine", "IN")},
+		}}, nil, "only supported with keys"},
+	}
+
+	for _, tc := range cases {
+		config := map[string]interface{}{"display_name": tc.name, "criteria": tc.criteria, "conjunction": tc.conjunction}
+		_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), provider.Meta())
+		if tc.expectedErr == "" && err != nil {
+			t.Errorf("%s: expected valid plan, got %v", tc.name, err)
+		}
+		if tc.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedErr)) {
+			t.Errorf("%s: expected plan error containing %q, got %v", tc.name, tc.expectedErr, err)
+		}
+	}
+	if sim.Count("/infra/domains/default/groups/") != 0 {
+		t.Errorf("Expected no groups to be created at plan time")
+	}
+}
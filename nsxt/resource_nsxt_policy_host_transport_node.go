@@ -24,6 +24,9 @@ func resourceNsxtPolicyHostTransportNode() *schema.Resource {
 		Read:   resourceNsxtPolicyHostTransportNodeRead,
 		Update: resourceNsxtPolicyHostTransportNodeUpdate,
 		Delete: resourceNsxtPolicyHostTransportNodeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(defaultTransportNodeDeleteTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyHostTransportNodeImporter,
 		},
@@ -92,7 +95,7 @@ func resourceNsxtPolicyHostTransportNodeRead(d *schema.ResourceData, m interface
 	if obj.NodeDeploymentInfo != nil {
 		d.Set("discovered_node_id", obj.NodeDeploymentInfo.DiscoveredNodeId)
 	}
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -136,7 +139,7 @@ func policyHostTransportNodePatch(siteID, epID, htnID string, d *schema.Resource
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	discoveredNodeID := d.Get("discovered_node_id").(string)
 	hostSwitchSpec, err := getHostSwitchSpecFromSchema(d, nodeTypeHost)
 	revision := int64(d.Get("revision").(int))
@@ -207,7 +210,7 @@ func resourceNsxtPolicyHostTransportNodeUpdate(d *schema.ResourceData, m interfa
 	return resourceNsxtPolicyHostTransportNodeRead(d, m)
 }
 
-func getHostTransportNodeStateConf(connector client.Connector, id, siteID, epID string) *resource.StateChangeConf {
+func getHostTransportNodeStateConf(connector client.Connector, id, siteID, epID string, timeout time.Duration) *resource.StateChangeConf {
 	return &resource.StateChangeConf{
 		Pending: []string{"notyet"},
 		Target:  []string{"success", "failed"},
@@ -227,7 +230,7 @@ func getHostTransportNodeStateConf(connector client.Connector, id, siteID, epID
 			return "notyet", "notyet", nil
 		},
 		Delay:        time.Duration(5) * time.Second,
-		Timeout:      time.Duration(1200) * time.Second,
+		Timeout:      timeout,
 		PollInterval: time.Duration(5) * time.Second,
 	}
 }
@@ -253,7 +256,7 @@ func resourceNsxtPolicyHostTransportNodeDelete(d *schema.ResourceData, m interfa
 		log.Printf("[INFO] Removing NSX from host HostTransportNode with ID %s", id)
 
 		// Busy-wait until removal is complete
-		stateConf := getHostTransportNodeStateConf(connector, id, siteID, epID)
+		stateConf := getHostTransportNodeStateConf(connector, id, siteID, epID, d.Timeout(schema.TimeoutDelete))
 		_, err := stateConf.WaitForState()
 		if err != nil {
 			return fmt.Errorf("failed to remove NSX bits from hosts: %v", err)
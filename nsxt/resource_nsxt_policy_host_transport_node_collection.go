@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -21,10 +23,13 @@ const removeOnDestroyDefault = true
 
 func resourceNsxtPolicyHostTransportNodeCollection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyHostTransportNodeCollectionCreate,
-		Read:   resourceNsxtPolicyHostTransportNodeCollectionRead,
-		Update: resourceNsxtPolicyHostTransportNodeCollectionUpdate,
-		Delete: resourceNsxtPolicyHostTransportNodeCollectionDelete,
+		CreateContext: resourceNsxtPolicyHostTransportNodeCollectionCreate,
+		ReadContext:   resourceNsxtPolicyHostTransportNodeCollectionRead,
+		UpdateContext: resourceNsxtPolicyHostTransportNodeCollectionUpdate,
+		DeleteContext: resourceNsxtPolicyHostTransportNodeCollectionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(defaultTransportNodeDeleteTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyHostTransportNodeCollectionImporter,
 		},
@@ -126,12 +131,10 @@ func resourceNsxtPolicyHostTransportNodeCollectionExists(siteID, epID, id string
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func policyHostTransportNodeCollectionUpdate(siteID, epID, id string, isCreate bool, d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
+func policyHostTransportNodeCollectionUpdate(siteID, epID, id string, isCreate bool, d *schema.ResourceData, connector client.Connector, m interface{}) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	computeCollectionID := d.Get("compute_collection_id").(string)
 	transportNodeProfileID := d.Get("transport_node_profile_path").(string)
@@ -175,8 +178,8 @@ func policyHostTransportNodeCollectionUpdate(siteID, epID, id string, isCreate b
 	return err
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionCreate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func resourceNsxtPolicyHostTransportNodeCollectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 	id := d.Get("nsx_id").(string)
 	if id == "" {
 		id = newUUID()
@@ -184,7 +187,7 @@ func resourceNsxtPolicyHostTransportNodeCollectionCreate(d *schema.ResourceData,
 	sitePath := d.Get("site_path").(string)
 	siteID := getResourceIDFromResourcePath(sitePath, "sites")
 	if siteID == "" {
-		return fmt.Errorf("error obtaining Site ID from site path %s", sitePath)
+		return diag.Errorf("error obtaining Site ID from site path %s", sitePath)
 	}
 	epID := d.Get("enforcement_point").(string)
 	if epID == "" {
@@ -193,42 +196,42 @@ func resourceNsxtPolicyHostTransportNodeCollectionCreate(d *schema.ResourceData,
 
 	exists, err := resourceNsxtPolicyHostTransportNodeCollectionExists(siteID, epID, id, connector)
 	if err != nil {
-		return err
+		return handleContextError(ctx, "HostTransportNodeCollection creation", err)
 	}
 	if exists {
-		return fmt.Errorf("resource with ID %s already exists", id)
+		return diag.Errorf("resource with ID %s already exists", id)
 	}
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating HostTransportNodeCollection with ID %s under site %s enforcement point %s", id, siteID, epID)
-	err = policyHostTransportNodeCollectionUpdate(siteID, epID, id, true, d, m)
+	err = policyHostTransportNodeCollectionUpdate(siteID, epID, id, true, d, connector, m)
 	if err != nil {
-		return handleCreateError("HostTransportNodeCollection", id, err)
+		return handleContextError(ctx, "HostTransportNodeCollection creation", handleCreateError("HostTransportNodeCollection", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyHostTransportNodeCollectionRead(d, m)
+	return resourceNsxtPolicyHostTransportNodeCollectionRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func resourceNsxtPolicyHostTransportNodeCollectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
 	// (TODO) Reusing this code here - maybe worthwhile renaming this func as it's usable for other resources
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	obj, err := client.Get(siteID, epID, id)
 	if err != nil {
-		return handleReadError(d, "HostTransportNodeCollection", id, err)
+		return handleContextError(ctx, "HostTransportNodeCollection read", handleReadError(d, "HostTransportNodeCollection", id, err))
 	}
 
 	d.Set("enforcement_point", epID)
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -258,23 +261,23 @@ func resourceNsxtPolicyHostTransportNodeCollectionRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeCollectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Updating HostTransportNodeCollection with ID %s", id)
-	err = policyHostTransportNodeCollectionUpdate(siteID, epID, id, false, d, m)
+	err = policyHostTransportNodeCollectionUpdate(siteID, epID, id, false, d, getContextPolicyConnector(ctx, m), m)
 
 	if err != nil {
-		return handleUpdateError("HostTransportNodeCollection", id, err)
+		return handleContextError(ctx, "HostTransportNodeCollection update", handleUpdateError("HostTransportNodeCollection", id, err))
 	}
 
-	return resourceNsxtPolicyHostTransportNodeCollectionRead(d, m)
+	return resourceNsxtPolicyHostTransportNodeCollectionRead(ctx, d, m)
 }
 
-func getComputeCollectionMemberStateConf(connector client.Connector, id string) *resource.StateChangeConf {
+func getComputeCollectionMemberStateConf(connector client.Connector, id string, timeout time.Duration) *resource.StateChangeConf {
 	return &resource.StateChangeConf{
 		Pending: []string{"notyet"},
 		Target:  []string{"success", "failed"},
@@ -293,17 +296,17 @@ func getComputeCollectionMemberStateConf(connector client.Connector, id string)
 			return "success", "success", nil
 		},
 		Delay:        time.Duration(5) * time.Second,
-		Timeout:      time.Duration(1200) * time.Second,
+		Timeout:      timeout,
 		PollInterval: time.Duration(5) * time.Second,
 	}
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionDelete(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func resourceNsxtPolicyHostTransportNodeCollectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getContextPolicyConnector(ctx, m)
 	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	removeNsxOnDestroy := d.Get("remove_nsx_on_destroy").(bool)
@@ -311,21 +314,21 @@ func resourceNsxtPolicyHostTransportNodeCollectionDelete(d *schema.ResourceData,
 		log.Printf("[INFO] Removing NSX from hosts associated with HostTransportNodeCollection with ID %s", id)
 		err = client.Removensx(siteID, epID, id)
 		if err != nil {
-			return handleDeleteError("HostTransportNodeCollection", id, err)
+			return handleContextError(ctx, "HostTransportNodeCollection deletion", handleDeleteError("HostTransportNodeCollection", id, err))
 		}
 
 		// Busy-wait until removal is complete
 		ccID := d.Get("compute_collection_id").(string)
-		stateConf := getComputeCollectionMemberStateConf(connector, ccID)
-		_, err := stateConf.WaitForState()
+		stateConf := getComputeCollectionMemberStateConf(connector, ccID, d.Timeout(schema.TimeoutDelete))
+		_, err := stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to remove NSX bits from hosts: %v", err)
+			return handleContextError(ctx, "HostTransportNodeCollection deletion", fmt.Errorf("failed to remove NSX bits from hosts: %v", err))
 		}
 	}
 	log.Printf("[INFO] Deleting HostTransportNodeCollection with ID %s", id)
 	err = client.Delete(siteID, epID, id)
 	if err != nil {
-		return handleDeleteError("HostTransportNodeCollection", id, err)
+		return handleContextError(ctx, "HostTransportNodeCollection deletion", handleDeleteError("HostTransportNodeCollection", id, err))
 	}
 
 	return nil
@@ -66,7 +66,7 @@ func resourceNsxtPolicyHostTransportNodeProfileCreate(d *schema.ResourceData, m
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	ignoreOverridenHosts := d.Get("ignore_overridden_hosts").(bool)
 
 	hostSwitchSpec, err := getHostSwitchSpecFromSchema(d, nodeTypeHost)
@@ -108,7 +108,7 @@ func resourceNsxtPolicyHostTransportNodeProfileRead(d *schema.ResourceData, m in
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", obj.Id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -134,7 +134,7 @@ func resourceNsxtPolicyHostTransportNodeProfileUpdate(d *schema.ResourceData, m
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	revision := int64(d.Get("revision").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	ignoreOverridenHosts := d.Get("ignore_overridden_hosts").(bool)
 
 	hostSwitchSpec, err := getHostSwitchSpecFromSchema(d, nodeTypeHost)
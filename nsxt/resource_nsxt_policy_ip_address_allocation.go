@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +29,9 @@ func resourceNsxtPolicyIPAddressAllocation() *schema.Resource {
 		Read:   resourceNsxtPolicyIPAddressAllocationRead,
 		Update: resourceNsxtPolicyIPAddressAllocationUpdate,
 		Delete: resourceNsxtPolicyIPAddressAllocationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(time.Duration(addressRealizationTimeoutDefault) * time.Second),
+		},
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyIPAddressAllocationImport,
 		},
@@ -102,7 +106,7 @@ func resourceNsxtPolicyIPAddressAllocationCreate(d *schema.ResourceData, m inter
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	allocationIP := d.Get("allocation_ip").(string)
 
 	obj := model.IpAddressAllocation{
@@ -150,7 +154,7 @@ func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interfa
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -162,7 +166,8 @@ func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interfa
 		timeout := d.Get("timeout").(int)
 		log.Printf("[DEBUG] Waiting for realization of IP Address for IP Allocation with ID %s", id)
 
-		stateConf := nsxtPolicyWaitForRealizationStateConf(connector, d, d.Get("path").(string), timeout)
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutRead))
+		stateConf := nsxtPolicyWaitForRealizationStateConf(connector, getSessionContext(d, m), d.Get("path").(string), getWaitTimeout(deadline, timeout))
 		entity, err := stateConf.WaitForState()
 		if err != nil {
 			return err
@@ -195,7 +200,7 @@ func resourceNsxtPolicyIPAddressAllocationUpdate(d *schema.ResourceData, m inter
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
 
 	obj := model.IpAddressAllocation{
@@ -9,7 +9,6 @@ import (
 
 	"github.com/vmware/terraform-provider-nsxt/api/infra"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +21,10 @@ var visibilityTypes = []string{
 	model.IpAddressBlock_VISIBILITY_PRIVATE,
 }
 
+var policyIPBlockVisibilityGate = newAttributeVersionGate("4.2.0", map[string][]string{
+	"nsxt_policy_ip_block": {"visibility"},
+})
+
 func resourceNsxtPolicyIPBlock() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyIPBlockCreate,
@@ -87,7 +90,7 @@ func resourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) error
 
 	d.Set("display_name", block.DisplayName)
 	d.Set("description", block.Description)
-	setPolicyTagsInSchema(d, block.Tags)
+	setPolicyTagsInSchema(d, block.Tags, m)
 	d.Set("nsx_id", block.Id)
 	d.Set("path", block.Path)
 	d.Set("revision", block.Revision)
@@ -110,7 +113,7 @@ func resourceNsxtPolicyIPBlockCreate(d *schema.ResourceData, m interface{}) erro
 	description := d.Get("description").(string)
 	cidr := d.Get("cidr").(string)
 	visibility := d.Get("visibility").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressBlock{
 		DisplayName: &displayName,
@@ -118,7 +121,7 @@ func resourceNsxtPolicyIPBlockCreate(d *schema.ResourceData, m interface{}) erro
 		Cidr:        &cidr,
 		Tags:        tags,
 	}
-	if util.NsxVersionHigherOrEqual("4.2.0") && len(visibility) > 0 {
+	if policyIPBlockVisibilityGate.isSupported() && len(visibility) > 0 {
 		obj.Visibility = &visibility
 	}
 	// Create the resource using PATCH
@@ -148,7 +151,7 @@ func resourceNsxtPolicyIPBlockUpdate(d *schema.ResourceData, m interface{}) erro
 	cidr := d.Get("cidr").(string)
 	visibility := d.Get("visibility").(string)
 	revision := int64(d.Get("revision").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressBlock{
 		Id:          &id,
@@ -158,7 +161,7 @@ func resourceNsxtPolicyIPBlockUpdate(d *schema.ResourceData, m interface{}) erro
 		Tags:        tags,
 		Revision:    &revision,
 	}
-	if util.NsxVersionHigherOrEqual("4.2.0") && len(visibility) > 0 {
+	if policyIPBlockVisibilityGate.isSupported() && len(visibility) > 0 {
 		obj.Visibility = &visibility
 	}
 
@@ -4,8 +4,7 @@
 package nsxt
 
 import (
-	"fmt"
-	"log"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,144 +13,184 @@ import (
 
 	"github.com/vmware/terraform-provider-nsxt/api/infra"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
 )
 
-func resourceNsxtPolicyIPDiscoveryProfile() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNsxtPolicyIPDiscoveryProfileCreate,
-		Read:   resourceNsxtPolicyIPDiscoveryProfileRead,
-		Update: resourceNsxtPolicyIPDiscoveryProfileUpdate,
-		Delete: resourceNsxtPolicyIPDiscoveryProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: nsxtPolicyPathResourceImporter,
+var ipDiscoveryProfileSchema = map[string]*metadata.ExtendedSchema{
+	"nsx_id":       metadata.GetExtendedSchema(getNsxIDSchema()),
+	"path":         metadata.GetExtendedSchema(getPathSchema()),
+	"display_name": metadata.GetExtendedSchema(getDisplayNameSchema()),
+	"description":  metadata.GetExtendedSchema(getDescriptionSchema()),
+	"revision":     metadata.GetExtendedSchema(getRevisionSchema()),
+	"tag":          metadata.GetExtendedSchema(getTagsSchema()),
+	"context":      metadata.GetExtendedSchema(getContextSchema(false, false)),
+	"arp_nd_binding_timeout": {
+		Schema: schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  "ARP and ND cache timeout (in minutes)",
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(5, 120),
 		},
-
-		Schema: map[string]*schema.Schema{
-			"nsx_id":       getNsxIDSchema(),
-			"path":         getPathSchema(),
-			"display_name": getDisplayNameSchema(),
-			"description":  getDescriptionSchema(),
-			"revision":     getRevisionSchema(),
-			"tag":          getTagsSchema(),
-			"context":      getContextSchema(false, false),
-			"arp_nd_binding_timeout": {
-				Type:         schema.TypeInt,
-				Description:  "ARP and ND cache timeout (in minutes)",
-				Optional:     true,
-				Default:      10,
-				ValidateFunc: validation.IntBetween(5, 120),
-			},
-			"duplicate_ip_detection_enabled": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Duplicate IP detection",
-			},
-			"arp_binding_limit": {
-				Type:         schema.TypeInt,
-				Description:  "Maximum number of ARP bindings",
-				Optional:     true,
-				Default:      1,
-				ValidateFunc: validation.IntBetween(1, 256),
-			},
-			"arp_snooping_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is ARP snooping enabled or not",
-				Optional:    true,
-				Default:     true,
-			},
-			"dhcp_snooping_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is DHCP snooping enabled or not",
-				Optional:    true,
-				Default:     true,
-			},
-			"vmtools_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is VM tools enabled or not",
-				Optional:    true,
-				Default:     true,
-			},
-			"dhcp_snooping_v6_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is DHCP snoping v6 enabled or not",
-				Optional:    true,
-				Default:     false,
-			},
-			"nd_snooping_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is ND snooping enabled or not",
-				Optional:    true,
-				Default:     false,
-			},
-			"nd_snooping_limit": {
-				Type:         schema.TypeInt,
-				Description:  "Maximum number of ND (Neighbor Discovery Protocol) bindings",
-				Optional:     true,
-				ValidateFunc: validation.IntBetween(2, 15),
-				Default:      3,
-			},
-			"vmtools_v6_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is VM tools enabled or not",
-				Optional:    true,
-				Default:     false,
-			},
-			"tofu_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is TOFU enabled or not",
-				Optional:    true,
-				Default:     true,
-			},
+		Metadata: metadata.Metadata{
+			SchemaType:   "int",
+			SdkFieldName: "ArpNdBindingTimeout",
 		},
-	}
+	},
+	"duplicate_ip_detection_enabled": {
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Duplicate IP detection",
+			Optional:    true,
+			Default:     false,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "DuplicateIpDetection.DuplicateIpDetectionEnabled",
+		},
+	},
+	"arp_binding_limit": {
+		Schema: schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  "Maximum number of ARP bindings",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 256),
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "int",
+			SdkFieldName: "IpV4DiscoveryOptions.ArpSnoopingConfig.ArpBindingLimit",
+		},
+	},
+	"arp_snooping_enabled": {
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Is ARP snooping enabled or not",
+			Optional:    true,
+			Default:     true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "IpV4DiscoveryOptions.ArpSnoopingConfig.ArpSnoopingEnabled",
+		},
+	},
+	"dhcp_snooping_enabled": {
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Is DHCP snooping enabled or not",
+			Optional:    true,
+			Default:     true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "IpV4DiscoveryOptions.DhcpSnoopingEnabled",
+		},
+	},
+	"vmtools_enabled": {
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Is VM tools enabled or not",
+			Optional:    true,
+			Default:     true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "IpV4DiscoveryOptions.VmtoolsEnabled",
+		},
+	},
+	"dhcp_snooping_v6_enabled": {
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Is DHCP snoping v6 enabled or not",
+			Optional:    true,
+			Default:     false,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "IpV6DiscoveryOptions.DhcpSnoopingV6Enabled",
+		},
+	},
+	"nd_snooping_enabled": {
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Is ND snooping enabled or not",
+			Optional:    true,
+			Default:     false,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "IpV6DiscoveryOptions.NdSnoopingConfig.NdSnoopingEnabled",
+		},
+	},
+	"nd_snooping_limit": {
+		Schema: schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  "Maximum number of ND (Neighbor Discovery Protocol) bindings",
+			Optional:     true,
+			Default:      3,
+			ValidateFunc: validation.IntBetween(2, 15),
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "int",
+			SdkFieldName: "IpV6DiscoveryOptions.NdSnoopingConfig.NdSnoopingLimit",
+		},
+	},
+	"vmtools_v6_enabled": {
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Is VM tools enabled or not",
+			Optional:    true,
+			Default:     false,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "IpV6DiscoveryOptions.VmtoolsV6Enabled",
+		},
+	},
+	"tofu_enabled": {
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Is TOFU enabled or not",
+			Optional:    true,
+			Default:     true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "TofuEnabled",
+		},
+	},
 }
 
-func ipDiscoveryProfileObjFromSchema(d *schema.ResourceData) model.IPDiscoveryProfile {
-	description := d.Get("description").(string)
-	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
-
-	arpNdBindingTimeout := int64(d.Get("arp_nd_binding_timeout").(int))
-	duplicateIPDetectionEnabled := d.Get("duplicate_ip_detection_enabled").(bool)
-
-	arpBindingLimit := int64(d.Get("arp_binding_limit").(int))
-	arpSnoopingEnabled := d.Get("arp_snooping_enabled").(bool)
-	dhcpSnoopingEnabled := d.Get("dhcp_snooping_enabled").(bool)
-	vmtoolsEnabled := d.Get("vmtools_enabled").(bool)
-
-	dhcpSnoopingV6Enabled := d.Get("dhcp_snooping_v6_enabled").(bool)
-	ndSnoopingEnabled := d.Get("nd_snooping_enabled").(bool)
-	ndSnoopingLimit := int64(d.Get("nd_snooping_limit").(int))
-	vmtoolsV6Enabled := d.Get("vmtools_v6_enabled").(bool)
-	toFuEnabled := d.Get("tofu_enabled").(bool)
-
-	return model.IPDiscoveryProfile{
-		DisplayName:         &displayName,
-		Description:         &description,
-		Tags:                tags,
-		ArpNdBindingTimeout: &arpNdBindingTimeout,
-		DuplicateIpDetection: &model.DuplicateIPDetectionOptions{
-			DuplicateIpDetectionEnabled: &duplicateIPDetectionEnabled,
+func resourceNsxtPolicyIPDiscoveryProfile() *schema.Resource {
+	return getPolicyMetadataResource(&policyMetadataResource{
+		objectType: "IPDiscoveryProfile",
+		schema:     ipDiscoveryProfileSchema,
+		modelType:  reflect.TypeOf(model.IPDiscoveryProfile{}),
+		exists:     resourceNsxtPolicyIPDiscoveryProfileExists,
+		getObject: func(sessionContext utl.SessionContext, connector client.Connector, id string) (interface{}, error) {
+			client := infra.NewIpDiscoveryProfilesClient(sessionContext, connector)
+			if client == nil {
+				return nil, policyResourceNotSupportedError()
+			}
+			return client.Get(id)
 		},
-		IpV4DiscoveryOptions: &model.IPv4DiscoveryOptions{
-			ArpSnoopingConfig: &model.ArpSnoopingConfig{
-				ArpBindingLimit:    &arpBindingLimit,
-				ArpSnoopingEnabled: &arpSnoopingEnabled,
-			},
-			DhcpSnoopingEnabled: &dhcpSnoopingEnabled,
-			VmtoolsEnabled:      &vmtoolsEnabled,
+		patchObject: func(sessionContext utl.SessionContext, connector client.Connector, id string, obj interface{}) error {
+			client := infra.NewIpDiscoveryProfilesClient(sessionContext, connector)
+			if client == nil {
+				return policyResourceNotSupportedError()
+			}
+			boolFalse := false
+			return client.Patch(id, obj.(model.IPDiscoveryProfile), &boolFalse)
 		},
-		IpV6DiscoveryOptions: &model.IPv6DiscoveryOptions{
-			DhcpSnoopingV6Enabled: &dhcpSnoopingV6Enabled,
-			NdSnoopingConfig: &model.NdSnoopingConfig{
-				NdSnoopingEnabled: &ndSnoopingEnabled,
-				NdSnoopingLimit:   &ndSnoopingLimit,
-			},
-			VmtoolsV6Enabled: &vmtoolsV6Enabled,
+		deleteObject: func(sessionContext utl.SessionContext, connector client.Connector, id string) error {
+			client := infra.NewIpDiscoveryProfilesClient(sessionContext, connector)
+			if client == nil {
+				return policyResourceNotSupportedError()
+			}
+			boolFalse := false
+			return client.Delete(id, &boolFalse)
 		},
-		TofuEnabled: &toFuEnabled,
-	}
+	})
 }
 
 func resourceNsxtPolicyIPDiscoveryProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
@@ -167,111 +206,3 @@ func resourceNsxtPolicyIPDiscoveryProfileExists(sessionContext utl.SessionContex
 
 	return false, logAPIError("Error retrieving resource", err)
 }
-
-func resourceNsxtPolicyIPDiscoveryProfileCreate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIPDiscoveryProfileExists)
-	if err != nil {
-		return err
-	}
-
-	obj := ipDiscoveryProfileObjFromSchema(d)
-
-	// Create the resource using PATCH
-	log.Printf("[INFO] Creating IPDiscoveryProfile with ID %s", id)
-	boolFalse := false
-	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	err = client.Patch(id, obj, &boolFalse)
-	if err != nil {
-		return handleCreateError("IPDiscoveryProfile", id, err)
-	}
-
-	d.SetId(id)
-	d.Set("nsx_id", id)
-
-	return resourceNsxtPolicyIPDiscoveryProfileRead(d, m)
-}
-
-func resourceNsxtPolicyIPDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
-	}
-
-	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	obj, err := client.Get(id)
-	if err != nil {
-		return handleReadError(d, "IPDiscoveryProfile", id, err)
-	}
-
-	d.Set("display_name", obj.DisplayName)
-	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
-	d.Set("nsx_id", id)
-	d.Set("path", obj.Path)
-	d.Set("revision", obj.Revision)
-
-	d.Set("arp_nd_binding_timeout", obj.ArpNdBindingTimeout)
-	d.Set("duplicate_ip_detection_enabled", obj.DuplicateIpDetection.DuplicateIpDetectionEnabled)
-	d.Set("arp_binding_limit", obj.IpV4DiscoveryOptions.ArpSnoopingConfig.ArpBindingLimit)
-	d.Set("arp_snooping_enabled", obj.IpV4DiscoveryOptions.ArpSnoopingConfig.ArpSnoopingEnabled)
-	d.Set("dhcp_snooping_enabled", obj.IpV4DiscoveryOptions.DhcpSnoopingEnabled)
-	d.Set("vmtools_enabled", obj.IpV4DiscoveryOptions.VmtoolsEnabled)
-	d.Set("dhcp_snooping_v6_enabled", obj.IpV6DiscoveryOptions.DhcpSnoopingV6Enabled)
-	d.Set("nd_snooping_enabled", obj.IpV6DiscoveryOptions.NdSnoopingConfig.NdSnoopingEnabled)
-	d.Set("nd_snooping_limit", obj.IpV6DiscoveryOptions.NdSnoopingConfig.NdSnoopingLimit)
-	d.Set("vmtools_v6_enabled", obj.IpV6DiscoveryOptions.VmtoolsV6Enabled)
-	d.Set("tofu_enabled", obj.TofuEnabled)
-
-	return nil
-}
-
-func resourceNsxtPolicyIPDiscoveryProfileUpdate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
-	}
-
-	// Read the rest of the configured parameters
-	obj := ipDiscoveryProfileObjFromSchema(d)
-
-	// Create the resource using PATCH
-	log.Printf("[INFO] Updating IPDiscoveryProfile with ID %s", id)
-	boolFalse := false
-	err := client.Patch(id, obj, &boolFalse)
-	if err != nil {
-		return handleUpdateError("IPDiscoveryProfile", id, err)
-	}
-
-	return resourceNsxtPolicyIPDiscoveryProfileRead(d, m)
-}
-
-func resourceNsxtPolicyIPDiscoveryProfileDelete(d *schema.ResourceData, m interface{}) error {
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
-	}
-
-	var err error
-	connector := getPolicyConnector(m)
-	boolFalse := false
-
-	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	err = client.Delete(id, &boolFalse)
-
-	if err != nil {
-		return handleDeleteError("IPDiscoveryProfile", id, err)
-	}
-
-	return nil
-}
@@ -78,7 +78,7 @@ func resourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("display_name", pool.DisplayName)
 	d.Set("description", pool.Description)
-	setPolicyTagsInSchema(d, pool.Tags)
+	setPolicyTagsInSchema(d, pool.Tags, m)
 	d.Set("nsx_id", pool.Id)
 	d.Set("path", pool.Path)
 	d.Set("revision", pool.Revision)
@@ -98,7 +98,7 @@ func resourceNsxtPolicyIPPoolCreate(d *schema.ResourceData, m interface{}) error
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressPool{
 		DisplayName: &displayName,
@@ -129,7 +129,7 @@ func resourceNsxtPolicyIPPoolUpdate(d *schema.ResourceData, m interface{}) error
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressPool{
 		DisplayName: &displayName,
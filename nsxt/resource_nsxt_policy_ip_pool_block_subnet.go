@@ -60,7 +60,7 @@ func resourceNsxtPolicyIPPoolBlockSubnet() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d *schema.ResourceData, id string) (*data.StructValue, error) {
+func resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d *schema.ResourceData, id string, m interface{}) (*data.StructValue, error) {
 	converter := bindings.NewTypeConverter()
 
 	displayName := d.Get("display_name").(string)
@@ -69,7 +69,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d *schema.ResourceDa
 	autoAssignGateway := d.Get("auto_assign_gateway").(bool)
 	size := d.Get("size").(int)
 	size64 := int64(size)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressPoolBlockSubnet{
 		DisplayName:       &displayName,
@@ -121,7 +121,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetRead(d *schema.ResourceData, m interface
 
 	d.Set("display_name", blockSubnet.DisplayName)
 	d.Set("description", blockSubnet.Description)
-	setPolicyTagsInSchema(d, blockSubnet.Tags)
+	setPolicyTagsInSchema(d, blockSubnet.Tags, m)
 	d.Set("nsx_id", blockSubnet.Id)
 	d.Set("path", blockSubnet.Path)
 	d.Set("revision", blockSubnet.Revision)
@@ -152,7 +152,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetCreate(d *schema.ResourceData, m interfa
 		}
 	}
 
-	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id)
+	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id, m)
 	if err != nil {
 		return err
 	}
@@ -180,7 +180,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error obtaining Block Subnet ID")
 	}
 
-	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id)
+	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id, m)
 	if err != nil {
 		return err
 	}
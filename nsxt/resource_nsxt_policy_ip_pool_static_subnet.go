@@ -66,7 +66,7 @@ func resourceNsxtPolicyIPPoolStaticSubnet() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d *schema.ResourceData, id string) (*data.StructValue, error) {
+func resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d *schema.ResourceData, id string, m interface{}) (*data.StructValue, error) {
 	converter := bindings.NewTypeConverter()
 
 	displayName := d.Get("display_name").(string)
@@ -75,7 +75,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d *schema.ResourceD
 	dnsNameservers := interfaceListToStringList(d.Get("dns_nameservers").([]interface{}))
 	dnsSuffix := d.Get("dns_suffix").(string)
 	gateway := d.Get("gateway").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressPoolStaticSubnet{
 		DisplayName:  &displayName,
@@ -149,7 +149,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetRead(d *schema.ResourceData, m interfac
 
 	d.Set("display_name", staticSubnet.DisplayName)
 	d.Set("description", staticSubnet.Description)
-	setPolicyTagsInSchema(d, staticSubnet.Tags)
+	setPolicyTagsInSchema(d, staticSubnet.Tags, m)
 	d.Set("nsx_id", staticSubnet.Id)
 	d.Set("path", staticSubnet.Path)
 	d.Set("revision", staticSubnet.Revision)
@@ -190,7 +190,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetCreate(d *schema.ResourceData, m interf
 		}
 	}
 
-	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id)
+	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id, m)
 	if err != nil {
 		return err
 	}
@@ -218,7 +218,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error obtaining Static Subnet ID")
 	}
 
-	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id)
+	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id, m)
 	if err != nil {
 		return err
 	}
@@ -4,14 +4,16 @@
 package nsxt
 
 import (
-	"fmt"
-	"log"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
 )
 
 var iPSecVpnDpdProfileDpdProbeModeValues = []string{
@@ -19,46 +21,62 @@ var iPSecVpnDpdProfileDpdProbeModeValues = []string{
 	model.IPSecVpnDpdProfile_DPD_PROBE_MODE_PERIODIC,
 }
 
+var iPSecVpnDpdProfileSchema = map[string]*metadata.ExtendedSchema{
+	"nsx_id":       metadata.GetExtendedSchema(getNsxIDSchema()),
+	"path":         metadata.GetExtendedSchema(getPathSchema()),
+	"display_name": metadata.GetExtendedSchema(getDisplayNameSchema()),
+	"description":  metadata.GetExtendedSchema(getDescriptionSchema()),
+	"revision":     metadata.GetExtendedSchema(getRevisionSchema()),
+	"tag":          metadata.GetExtendedSchema(getTagsSchema()),
+	"dpd_probe_interval": metadata.GetExtendedSchemaForSdkField(&schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Default:  60,
+	}, "DpdProbeInterval"),
+	"dpd_probe_mode": metadata.GetExtendedSchemaForSdkField(&schema.Schema{
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice(iPSecVpnDpdProfileDpdProbeModeValues, false),
+		Optional:     true,
+		Default:      model.IPSecVpnDpdProfile_DPD_PROBE_MODE_PERIODIC,
+	}, "DpdProbeMode"),
+	"enabled": metadata.GetExtendedSchemaForSdkField(&schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}, "Enabled"),
+	"retry_count": metadata.GetExtendedSchemaForSdkField(&schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Default:  10,
+	}, "RetryCount"),
+}
+
 func resourceNsxtPolicyIPSecVpnDpdProfile() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnDpdProfileCreate,
-		Read:   resourceNsxtPolicyIPSecVpnDpdProfileRead,
-		Update: resourceNsxtPolicyIPSecVpnDpdProfileUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnDpdProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+	return getPolicyMetadataResource(&policyMetadataResource{
+		objectType: "IPSecVpnDpdProfile",
+		schema:     iPSecVpnDpdProfileSchema,
+		modelType:  reflect.TypeOf(model.IPSecVpnDpdProfile{}),
+		exists:     getLegacyExistsWrapper(resourceNsxtPolicyIPSecVpnDpdProfileExists),
+		getObject: func(sessionContext utl.SessionContext, connector client.Connector, id string) (interface{}, error) {
+			if sessionContext.ClientType != utl.Local {
+				return nil, resourceNotSupportedError()
+			}
+			return infra.NewIpsecVpnDpdProfilesClient(connector).Get(id)
 		},
-
-		Schema: map[string]*schema.Schema{
-			"nsx_id":       getNsxIDSchema(),
-			"path":         getPathSchema(),
-			"display_name": getDisplayNameSchema(),
-			"description":  getDescriptionSchema(),
-			"revision":     getRevisionSchema(),
-			"tag":          getTagsSchema(),
-			"dpd_probe_interval": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  60,
-			},
-			"dpd_probe_mode": {
-				Type:         schema.TypeString,
-				ValidateFunc: validation.StringInSlice(iPSecVpnDpdProfileDpdProbeModeValues, false),
-				Optional:     true,
-				Default:      model.IPSecVpnDpdProfile_DPD_PROBE_MODE_PERIODIC,
-			},
-			"enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"retry_count": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  10,
-			},
+		patchObject: func(sessionContext utl.SessionContext, connector client.Connector, id string, obj interface{}) error {
+			if sessionContext.ClientType != utl.Local {
+				return resourceNotSupportedError()
+			}
+			return infra.NewIpsecVpnDpdProfilesClient(connector).Patch(id, obj.(model.IPSecVpnDpdProfile))
 		},
-	}
+		deleteObject: func(sessionContext utl.SessionContext, connector client.Connector, id string) error {
+			if sessionContext.ClientType != utl.Local {
+				return resourceNotSupportedError()
+			}
+			return infra.NewIpsecVpnDpdProfilesClient(connector).Delete(id)
+		},
+		importer: schema.ImportStatePassthrough,
+	})
 }
 
 func resourceNsxtPolicyIPSecVpnDpdProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
@@ -74,130 +92,3 @@ func resourceNsxtPolicyIPSecVpnDpdProfileExists(id string, connector client.Conn
 
 	return false, logAPIError("Error retrieving resource", err)
 }
-
-func resourceNsxtPolicyIPSecVpnDpdProfileCreate(d *schema.ResourceData, m interface{}) error {
-
-	if isPolicyGlobalManager(m) {
-		return resourceNotSupportedError()
-	}
-
-	connector := getPolicyConnector(m)
-
-	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPSecVpnDpdProfileExists)
-	if err != nil {
-		return err
-	}
-
-	displayName := d.Get("display_name").(string)
-	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
-	dpdProbeInterval := int64(d.Get("dpd_probe_interval").(int))
-	dpdProbeMode := d.Get("dpd_probe_mode").(string)
-	enabled := d.Get("enabled").(bool)
-	retryCount := int64(d.Get("retry_count").(int))
-
-	obj := model.IPSecVpnDpdProfile{
-		DisplayName:      &displayName,
-		Description:      &description,
-		Tags:             tags,
-		DpdProbeInterval: &dpdProbeInterval,
-		DpdProbeMode:     &dpdProbeMode,
-		Enabled:          &enabled,
-		RetryCount:       &retryCount,
-	}
-
-	// Create the resource using PATCH
-	log.Printf("[INFO] Creating IPSecVpnDpdProfile with ID %s", id)
-	client := infra.NewIpsecVpnDpdProfilesClient(connector)
-	err = client.Patch(id, obj)
-	if err != nil {
-		return handleCreateError("IPSecVpnDpdProfile", id, err)
-	}
-
-	d.SetId(id)
-	d.Set("nsx_id", id)
-
-	return resourceNsxtPolicyIPSecVpnDpdProfileRead(d, m)
-}
-
-func resourceNsxtPolicyIPSecVpnDpdProfileRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnDpdProfile ID")
-	}
-
-	client := infra.NewIpsecVpnDpdProfilesClient(connector)
-	obj, err := client.Get(id)
-	if err != nil {
-		return handleReadError(d, "IPSecVpnDpdProfile", id, err)
-	}
-
-	d.Set("display_name", obj.DisplayName)
-	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
-	d.Set("nsx_id", id)
-	d.Set("path", obj.Path)
-	d.Set("revision", obj.Revision)
-	d.Set("dpd_probe_interval", obj.DpdProbeInterval)
-	d.Set("dpd_probe_mode", obj.DpdProbeMode)
-	d.Set("enabled", obj.Enabled)
-	d.Set("retry_count", obj.RetryCount)
-
-	return nil
-}
-
-func resourceNsxtPolicyIPSecVpnDpdProfileUpdate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnDpdProfile ID")
-	}
-
-	// Read the rest of the configured parameters
-	description := d.Get("description").(string)
-	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
-	dpdProbeInterval := int64(d.Get("dpd_probe_interval").(int))
-	dpdProbeMode := d.Get("dpd_probe_mode").(string)
-	enabled := d.Get("enabled").(bool)
-	retryCount := int64(d.Get("retry_count").(int))
-
-	obj := model.IPSecVpnDpdProfile{
-		DisplayName:      &displayName,
-		Description:      &description,
-		Tags:             tags,
-		DpdProbeInterval: &dpdProbeInterval,
-		DpdProbeMode:     &dpdProbeMode,
-		Enabled:          &enabled,
-		RetryCount:       &retryCount,
-	}
-
-	client := infra.NewIpsecVpnDpdProfilesClient(connector)
-	err := client.Patch(id, obj)
-	if err != nil {
-		return handleUpdateError("IPSecVpnDpdProfile", id, err)
-	}
-
-	return resourceNsxtPolicyIPSecVpnDpdProfileRead(d, m)
-}
-
-func resourceNsxtPolicyIPSecVpnDpdProfileDelete(d *schema.ResourceData, m interface{}) error {
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnDpdProfile ID")
-	}
-
-	connector := getPolicyConnector(m)
-	client := infra.NewIpsecVpnDpdProfilesClient(connector)
-	err := client.Delete(id)
-
-	if err != nil {
-		return handleDeleteError("IPSecVpnDpdProfile", id, err)
-	}
-
-	return nil
-}
@@ -187,10 +187,10 @@ func resourceNsxtPolicyIPSecVpnLocalEndpointExists(servicePath string) func(id s
 	}
 }
 
-func ipSecVpnLocalEndpointInitStruct(d *schema.ResourceData) model.IPSecVpnLocalEndpoint {
+func ipSecVpnLocalEndpointInitStruct(d *schema.ResourceData, m interface{}) model.IPSecVpnLocalEndpoint {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	certificatePath := d.Get("certificate_path").(string)
 	localAddress := d.Get("local_address").(string)
 	localID := d.Get("local_id").(string)
@@ -232,7 +232,7 @@ func resourceNsxtPolicyIPSecVpnLocalEndpointCreate(d *schema.ResourceData, m int
 		return err
 	}
 
-	obj := ipSecVpnLocalEndpointInitStruct(d)
+	obj := ipSecVpnLocalEndpointInitStruct(d, m)
 
 	log.Printf("[INFO] Creating IPSecVpnLocalEndpoint with ID %s", id)
 	client, err := newLocalEndpointClient(servicePath)
@@ -270,7 +270,7 @@ func resourceNsxtPolicyIPSecVpnLocalEndpointRead(d *schema.ResourceData, m inter
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -298,7 +298,7 @@ func resourceNsxtPolicyIPSecVpnLocalEndpointUpdate(d *schema.ResourceData, m int
 		return handleUpdateError("IPSecVpnLocalEndpoint", id, err)
 	}
 
-	obj := ipSecVpnLocalEndpointInitStruct(d)
+	obj := ipSecVpnLocalEndpointInitStruct(d, m)
 	revision := int64(d.Get("revision").(int))
 	obj.Revision = &revision
 
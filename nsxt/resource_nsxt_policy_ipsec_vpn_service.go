@@ -279,7 +279,7 @@ func resourceNsxtPolicyIPSecVpnServiceRead(d *schema.ResourceData, m interface{}
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -323,7 +323,7 @@ func resourceNsxtPolicyIPSecVpnServiceCreate(d *schema.ResourceData, m interface
 	enabled := d.Get("enabled").(bool)
 	haSync := d.Get("ha_sync").(bool)
 	rules := getIPSecVPNBypassRulesFromSchema(d)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	ipSecVpnService := model.IPSecVpnService{
 		Id:          &id,
@@ -373,7 +373,7 @@ func resourceNsxtPolicyIPSecVpnServiceUpdate(d *schema.ResourceData, m interface
 	enabled := d.Get("enabled").(bool)
 	haSync := d.Get("ha_sync").(bool)
 	rules := getIPSecVPNBypassRulesFromSchema(d)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	ipSecVpnService := model.IPSecVpnService{
 		Id:          &id,
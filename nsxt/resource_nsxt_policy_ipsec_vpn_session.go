@@ -8,8 +8,7 @@ import (
 	"log"
 	"strings"
 
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
-
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -62,6 +61,10 @@ var IPSecRulesActionValues = []string{
 	model.IPSecVpnRule_ACTION_BYPASS,
 }
 
+var policyIPSecVpnSessionTCPMssClampingGate = newAttributeVersionGate("3.2.0", map[string][]string{
+	"nsxt_policy_ipsec_vpn_session": {"direction", "max_segment_size"},
+})
+
 func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyIPSecVpnSessionCreate,
@@ -71,6 +74,9 @@ func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: nsxtVpnSessionImporter,
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("psk"), cty.GetAttrPath("psk_wo")),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":              getNsxIDSchema(),
@@ -117,11 +123,14 @@ func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 				Default:     true,
 			},
 			"psk": {
-				Type:        schema.TypeString,
-				Description: "IPSec Pre-shared key. Maximum length of this field is 128 characters.",
-				Optional:    true,
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Description:   "IPSec Pre-shared key. Maximum length of this field is 128 characters.",
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"psk_wo"},
 			},
+			"psk_wo":         getWriteOnlySchema("IPSec Pre-shared key, not stored in state. Maximum length of this field is 128 characters."),
+			"psk_wo_version": getWriteOnlyVersionSchema("psk_wo"),
 			"peer_id": {
 				Type:         schema.TypeString,
 				Description:  "Peer ID to uniquely identify the peer site. The peer ID is the public IP address of the remote device terminating the VPN tunnel. When NAT is configured for the peer, enter the private IP address of the peer.",
@@ -167,10 +176,10 @@ func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 	}
 }
 
-func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+func getIPSecVPNSessionFromSchema(d *schema.ResourceData, m interface{}) (*data.StructValue, error) {
 	converter := bindings.NewTypeConverter()
 
-	psk := d.Get("psk").(string)
+	psk := getWriteOnlyOrString(d, cty.GetAttrPath("psk_wo"), d.Get("psk").(string))
 	peerID := d.Get("peer_id").(string)
 	peerAddress := d.Get("peer_address").(string)
 	displayName := d.Get("display_name").(string)
@@ -187,7 +196,7 @@ func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, er
 	enabled := d.Get("enabled").(bool)
 	direction := d.Get("direction").(string)
 	mss := int64(d.Get("max_segment_size").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	if resourceType == routeBasedIPSecVpnSession {
 		tunnelInterface := interfaceListToStringList(d.Get("ip_addresses").([]interface{}))
@@ -224,7 +233,7 @@ func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, er
 			Psk:                      &psk,
 			Tags:                     tags,
 		}
-		if util.NsxVersionHigherOrEqual("3.2.0") {
+		if policyIPSecVpnSessionTCPMssClampingGate.isSupported() {
 			if direction != "" {
 				tcpMSSClamping := model.TcpMaximumSegmentSizeClamping{
 					Direction: &direction,
@@ -272,7 +281,7 @@ func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, er
 			Psk:                      &psk,
 			Tags:                     tags,
 		}
-		if util.NsxVersionHigherOrEqual("3.2.0") {
+		if policyIPSecVpnSessionTCPMssClampingGate.isSupported() {
 			if direction != "" {
 				tcpMSSClamping := model.TcpMaximumSegmentSizeClamping{
 					Direction: &direction,
@@ -541,7 +550,7 @@ func resourceNsxtPolicyIPSecVpnSessionCreate(d *schema.ResourceData, m interface
 		return err
 	}
 
-	obj, err := getIPSecVPNSessionFromSchema(d)
+	obj, err := getIPSecVPNSessionFromSchema(d, m)
 	if err != nil {
 		return err
 	}
@@ -613,7 +622,7 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 
 		d.Set("display_name", blockVPN.DisplayName)
 		d.Set("description", blockVPN.Description)
-		setPolicyTagsInSchema(d, blockVPN.Tags)
+		setPolicyTagsInSchema(d, blockVPN.Tags, m)
 		d.Set("nsx_id", blockVPN.Id)
 		d.Set("path", blockVPN.Path)
 		d.Set("revision", blockVPN.Revision)
@@ -628,7 +637,7 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 		d.Set("tunnel_profile_path", blockVPN.TunnelProfilePath)
 		d.Set("peer_address", blockVPN.PeerAddress)
 		d.Set("peer_id", blockVPN.PeerId)
-		if util.NsxVersionHigherOrEqual("3.2.0") {
+		if policyIPSecVpnSessionTCPMssClampingGate.isSupported() {
 			if blockVPN.TcpMssClamping != nil {
 				direction := blockVPN.TcpMssClamping.Direction
 				mss := blockVPN.TcpMssClamping.MaxSegmentSize
@@ -667,7 +676,7 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 
 		d.Set("display_name", blockVPN.DisplayName)
 		d.Set("description", blockVPN.Description)
-		setPolicyTagsInSchema(d, blockVPN.Tags)
+		setPolicyTagsInSchema(d, blockVPN.Tags, m)
 		d.Set("nsx_id", blockVPN.Id)
 		d.Set("path", blockVPN.Path)
 		d.Set("revision", blockVPN.Revision)
@@ -685,7 +694,7 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 		if blockVPN.Rules != nil {
 			setRuleInSchema(d, blockVPN.Rules)
 		}
-		if util.NsxVersionHigherOrEqual("3.2.0") {
+		if policyIPSecVpnSessionTCPMssClampingGate.isSupported() {
 			if blockVPN.TcpMssClamping != nil {
 				direction := blockVPN.TcpMssClamping.Direction
 				mss := blockVPN.TcpMssClamping.MaxSegmentSize
@@ -710,7 +719,7 @@ func resourceNsxtPolicyIPSecVpnSessionUpdate(d *schema.ResourceData, m interface
 	if err != nil {
 		return handleUpdateError("IPSecVpnSession", id, err)
 	}
-	obj, err := getIPSecVPNSessionFromSchema(d)
+	obj, err := getIPSecVPNSessionFromSchema(d, m)
 	if err != nil {
 		return err
 	}
@@ -175,7 +175,7 @@ func resourceNsxtPolicyL2VpnServiceRead(d *schema.ResourceData, m interface{}) e
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -218,7 +218,7 @@ func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{})
 	description := d.Get("description").(string)
 	enableHub := d.Get("enable_hub").(bool)
 	mode := d.Get("mode").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	l2VpnService := model.L2VPNService{
 		Id:          &id,
@@ -271,7 +271,7 @@ func resourceNsxtPolicyL2VpnServiceUpdate(d *schema.ResourceData, m interface{})
 	enableHub := d.Get("enable_hub").(bool)
 	revision := int64(d.Get("revision").(int))
 	mode := d.Get("mode").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	l2VpnService := model.L2VPNService{
 		Id:          &id,
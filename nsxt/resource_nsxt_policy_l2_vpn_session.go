@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -28,6 +26,10 @@ var L2VpnTunnelEncapsulationProtocal = []string{
 	model.L2VPNTunnelEncapsulation_PROTOCOL_GRE,
 }
 
+var policyL2VPNSessionTCPMssClampingGate = newAttributeVersionGate("3.2.0", map[string][]string{
+	"nsxt_policy_l2_vpn_session": {"direction", "max_segment_size"},
+})
+
 func resourceNsxtPolicyL2VPNSession() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyL2VPNSessionCreate,
@@ -117,7 +119,7 @@ func resourceNsxtPolicyL2VPNSessionCreate(d *schema.ResourceData, m interface{})
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	enabled := d.Get("enabled").(bool)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.L2VPNSession{
 		DisplayName:      &displayName,
@@ -127,7 +129,7 @@ func resourceNsxtPolicyL2VPNSessionCreate(d *schema.ResourceData, m interface{})
 		TransportTunnels: transportTunnel,
 	}
 
-	if util.NsxVersionHigherOrEqual("3.2.0") {
+	if policyL2VPNSessionTCPMssClampingGate.isSupported() {
 		direction := d.Get("direction").(string)
 		maxSegmentSize := int64(d.Get("max_segment_size").(int))
 		if direction != "" {
@@ -237,7 +239,7 @@ func resourceNsxtPolicyL2VPNSessionRead(d *schema.ResourceData, m interface{}) e
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -246,7 +248,7 @@ func resourceNsxtPolicyL2VPNSessionRead(d *schema.ResourceData, m interface{}) e
 	if len(obj.TransportTunnels) > 0 {
 		d.Set("transport_tunnels", obj.TransportTunnels)
 	}
-	if util.NsxVersionHigherOrEqual("3.2.0") {
+	if policyL2VPNSessionTCPMssClampingGate.isSupported() {
 		if obj.TcpMssClamping != nil {
 			direction := obj.TcpMssClamping.Direction
 			mss := obj.TcpMssClamping.MaxSegmentSize
@@ -317,7 +319,7 @@ func resourceNsxtPolicyL2VPNSessionUpdate(d *schema.ResourceData, m interface{})
 	displayName := d.Get("display_name").(string)
 	revision := int64(d.Get("revision").(int))
 	enabled := d.Get("enabled").(bool)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	obj := model.L2VPNSession{
 		DisplayName:      &displayName,
 		Description:      &description,
@@ -326,7 +328,7 @@ func resourceNsxtPolicyL2VPNSessionUpdate(d *schema.ResourceData, m interface{})
 		Revision:         &revision,
 		Enabled:          &enabled,
 	}
-	if util.NsxVersionHigherOrEqual("3.2.0") {
+	if policyL2VPNSessionTCPMssClampingGate.isSupported() {
 		direction := d.Get("direction").(string)
 		maxSegmentSize := int64(d.Get("max_segment_size").(int))
 		if direction != "" {
@@ -95,7 +95,7 @@ func resourceNsxtPolicyLBClientSslProfilePatch(d *schema.ResourceData, m interfa
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	cipherGroupLabel := d.Get("cipher_group_label").(string)
 	ciphers := getStringListFromSchemaSet(d, "ciphers")
 	preferServerCiphers := d.Get("prefer_server_ciphers").(bool)
@@ -158,7 +158,7 @@ func resourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interfac
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
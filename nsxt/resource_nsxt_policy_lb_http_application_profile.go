@@ -114,7 +114,7 @@ func resourceNsxtPolicyLBHttpApplicationProfilePatch(d *schema.ResourceData, m i
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	httpRedirectTo := d.Get("http_redirect_to").(string)
 	httpRedirectToHTTPS := d.Get("http_redirect_to_https").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
@@ -201,7 +201,7 @@ func resourceNsxtPolicyLBHttpApplicationProfileRead(d *schema.ResourceData, m in
 
 	d.Set("display_name", lbHTTPProfile.DisplayName)
 	d.Set("description", lbHTTPProfile.Description)
-	setPolicyTagsInSchema(d, lbHTTPProfile.Tags)
+	setPolicyTagsInSchema(d, lbHTTPProfile.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", lbHTTPProfile.Path)
 	d.Set("revision", lbHTTPProfile.Revision)
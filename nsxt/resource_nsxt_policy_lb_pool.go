@@ -438,7 +438,7 @@ func resourceNsxtPolicyLBPoolCreate(d *schema.ResourceData, m interface{}) error
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	activeMonitorPaths := interfaceListToStringList(d.Get("active_monitor_paths").([]interface{}))
 	if activeMonitorPaths == nil && d.Get("active_monitor_path") != "" {
 		activeMonitorPath := d.Get("active_monitor_path").(string)
@@ -513,7 +513,7 @@ func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -563,7 +563,7 @@ func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	activeMonitorPaths := interfaceListToStringList(d.Get("active_monitor_paths").([]interface{}))
 	if activeMonitorPaths == nil && d.Get("active_monitor_path") != "" {
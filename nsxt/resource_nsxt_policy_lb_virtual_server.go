@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -135,6 +133,10 @@ var lbSslModeSelectionActionValues = []string{
 	model.LBSslModeSelectionAction_SSL_MODE_OFFLOAD,
 }
 
+var policyLBVirtualServerVersionDependentGate = newAttributeVersionGate("3.0.0", map[string][]string{
+	"nsxt_policy_lb_virtual_server": {"log_significant_event_only", "access_list_control"},
+})
+
 func resourceNsxtPolicyLBVirtualServer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyLBVirtualServerCreate,
@@ -1563,7 +1565,7 @@ func getPolicyLbRulesFromSchema(d *schema.ResourceData) []model.LBRule {
 }
 
 func policyLBVirtualServerVersionDependantSet(d *schema.ResourceData, obj *model.LBVirtualServer) {
-	if util.NsxVersionHigherOrEqual("3.0.0") {
+	if policyLBVirtualServerVersionDependentGate.isSupported() {
 		logSignificantOnly := d.Get("log_significant_event_only").(bool)
 		obj.LogSignificantEventOnly = &logSignificantOnly
 		obj.AccessListControl = getPolicyAccessListControlFromSchema(d)
@@ -1601,7 +1603,7 @@ func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfilePath := d.Get("application_profile_path").(string)
 	clientSSLProfileBinding := getPolicyClientSSLBindingFromSchema(d)
@@ -1680,7 +1682,7 @@ func resourceNsxtPolicyLBVirtualServerRead(d *schema.ResourceData, m interface{}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -1736,7 +1738,7 @@ func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	clientSSLProfileBinding := getPolicyClientSSLBindingFromSchema(d)
@@ -8,6 +8,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -33,6 +34,11 @@ func resourceNsxtPolicyLdapIdentitySource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(
+				cty.GetAttrPath("ldap_server").Index(anyListIndex).GetAttr("password"),
+				cty.GetAttrPath("ldap_server").Index(anyListIndex).GetAttr("password_wo")),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -97,6 +103,8 @@ func resourceNsxtPolicyLdapIdentitySource() *schema.Resource {
 							Optional:    true,
 							Sensitive:   true,
 						},
+						"password_wo":         getWriteOnlySchema("The authentication password for login, not stored in state"),
+						"password_wo_version": getWriteOnlyVersionSchema("password_wo"),
 						"url": {
 							Type:         schema.TypeString,
 							Description:  "The URL for the LDAP server",
@@ -119,12 +127,12 @@ func resourceNsxtPolicyLdapIdentitySource() *schema.Resource {
 func getLdapServersFromSchema(d *schema.ResourceData) []nsxModel.IdentitySourceLdapServer {
 	servers := d.Get("ldap_server").([]interface{})
 	serverList := make([]nsxModel.IdentitySourceLdapServer, 0)
-	for _, server := range servers {
+	for i, server := range servers {
 		data := server.(map[string]interface{})
 		bindIdentity := data["bind_identity"].(string)
 		certificates := interface2StringList(data["certificates"].([]interface{}))
 		enabled := data["enabled"].(bool)
-		password := data["password"].(string)
+		password := getWriteOnlyOrString(d, cty.GetAttrPath("ldap_server").IndexInt(i).GetAttr("password_wo"), data["password"].(string))
 		url := data["url"].(string)
 		useStarttls := data["use_starttls"].(bool)
 		elem := nsxModel.IdentitySourceLdapServer{
@@ -141,21 +149,24 @@ func getLdapServersFromSchema(d *schema.ResourceData) []nsxModel.IdentitySourceL
 	return serverList
 }
 
-// getLdapServerPasswordMap caches password of ldap servers for setting back to schema after read
-func getLdapServerPasswordMap(d *schema.ResourceData) map[string]string {
-	passwordMap := make(map[string]string)
+// getLdapServerPasswordMap caches password of ldap servers, along with version
+// of write-only password, for setting back to schema after read
+func getLdapServerPasswordMap(d *schema.ResourceData) map[string]map[string]interface{} {
+	passwordMap := make(map[string]map[string]interface{})
 	servers := d.Get("ldap_server").([]interface{})
 	for _, server := range servers {
 		data := server.(map[string]interface{})
-		password := data["password"].(string)
 		url := data["url"].(string)
-		passwordMap[url] = password
+		passwordMap[url] = map[string]interface{}{
+			"password":            data["password"],
+			"password_wo_version": data["password_wo_version"],
+		}
 	}
 
 	return passwordMap
 }
 
-func setLdapServersInSchema(d *schema.ResourceData, nsxLdapServerList []nsxModel.IdentitySourceLdapServer, passwordMap map[string]string) {
+func setLdapServersInSchema(d *schema.ResourceData, nsxLdapServerList []nsxModel.IdentitySourceLdapServer, passwordMap map[string]map[string]interface{}) {
 	var ldapServerList []map[string]interface{}
 	for _, ldapServer := range nsxLdapServerList {
 		elem := make(map[string]interface{})
@@ -164,8 +175,8 @@ func setLdapServersInSchema(d *schema.ResourceData, nsxLdapServerList []nsxModel
 		elem["enabled"] = ldapServer.Enabled
 		elem["url"] = ldapServer.Url
 		elem["use_starttls"] = ldapServer.UseStarttls
-		if val, ok := passwordMap[*ldapServer.Url]; ok {
-			elem["password"] = val
+		for key, val := range passwordMap[*ldapServer.Url] {
+			elem[key] = val
 		}
 		ldapServerList = append(ldapServerList, elem)
 	}
@@ -199,7 +210,7 @@ func resourceNsxtPolicyLdapIdentitySourceProbeAndUpdate(d *schema.ResourceData,
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	revision := int64(d.Get("revision").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	domainName := d.Get("domain_name").(string)
 	baseDn := d.Get("base_dn").(string)
 	altDomainNames := getStringListFromSchemaList(d, "alternative_domain_names")
@@ -318,7 +329,7 @@ func resourceNsxtPolicyLdapIdentitySourceRead(d *schema.ResourceData, m interfac
 	d.Set("display_name", ldapObj.DisplayName)
 	d.Set("description", ldapObj.Description)
 	d.Set("revision", ldapObj.Revision)
-	setPolicyTagsInSchema(d, ldapObj.Tags)
+	setPolicyTagsInSchema(d, ldapObj.Tags, m)
 	d.Set("type", dServerType)
 	d.Set("domain_name", ldapObj.DomainName)
 	d.Set("base_dn", ldapObj.BaseDn)
@@ -134,7 +134,8 @@ func resourceNsxtPolicyMacDiscoveryProfile() *schema.Resource {
 			State: nsxtPolicyPathResourceImporter,
 		},
 
-		Schema: metadata.GetSchemaFromExtendedSchema(macDiscoveryProfileSchema),
+		Schema:        metadata.GetSchemaFromExtendedSchema(macDiscoveryProfileSchema),
+		CustomizeDiff: getExtendedSchemaVersionGatingCustomizeDiff(macDiscoveryProfileSchema),
 	}
 }
 
@@ -163,7 +164,7 @@ func resourceNsxtPolicyMacDiscoveryProfileCreate(d *schema.ResourceData, m inter
 	}
 
 	// TODO - consider including standard object attributes in the schema
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 
@@ -207,7 +208,7 @@ func resourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interfa
 		return handleReadError(d, "MacDiscoveryProfile", id, err)
 	}
 
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
@@ -229,7 +230,7 @@ func resourceNsxtPolicyMacDiscoveryProfileUpdate(d *schema.ResourceData, m inter
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	revision := int64(d.Get("revision").(int))
 
@@ -102,10 +102,10 @@ func resourceNsxtPolicyMetadataProxyExists(id string, connector client.Connector
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func getMetadataProxyFromSchema(d *schema.ResourceData) model.MetadataProxyConfig {
+func getMetadataProxyFromSchema(d *schema.ResourceData, m interface{}) model.MetadataProxyConfig {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	cryptoProtocols := interfaceListToStringList(d.Get("crypto_protocols").([]interface{}))
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
@@ -137,7 +137,7 @@ func resourceNsxtPolicyMetadataProxyCreate(d *schema.ResourceData, m interface{}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewMetadataProxiesClient(connector)
-	obj := getMetadataProxyFromSchema(d)
+	obj := getMetadataProxyFromSchema(d, m)
 	err = client.Patch(id, obj)
 	if err != nil {
 		return handleCreateError("PolicyMetadataProxy", id, err)
@@ -164,7 +164,7 @@ func resourceNsxtPolicyMetadataProxyRead(d *schema.ResourceData, m interface{})
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -187,7 +187,7 @@ func resourceNsxtPolicyMetadataProxyUpdate(d *schema.ResourceData, m interface{}
 	connector := getPolicyConnector(m)
 	client := infra.NewMetadataProxiesClient(connector)
 
-	obj := getMetadataProxyFromSchema(d)
+	obj := getMetadataProxyFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	obj.Revision = &revision
 	_, err := client.Update(id, obj)
@@ -13,7 +13,6 @@ import (
 	t0nat "github.com/vmware/terraform-provider-nsxt/api/infra/tier_0s/nat"
 	t1nat "github.com/vmware/terraform-provider-nsxt/api/infra/tier_1s/nat"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -40,12 +39,17 @@ var policyNATRulePolicyBasedVpnModeTypeValues = []string{
 	model.PolicyNatRule_POLICY_BASED_VPN_MODE_MATCH,
 }
 
+var policyNATRuleVpnModeGate = newAttributeVersionGate("4.0.0", map[string][]string{
+	"nsxt_policy_nat_rule": {"policy_based_vpn_mode"},
+})
+
 func resourceNsxtPolicyNATRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyNATRuleCreate,
-		Read:   resourceNsxtPolicyNATRuleRead,
-		Update: resourceNsxtPolicyNATRuleUpdate,
-		Delete: resourceNsxtPolicyNATRuleDelete,
+		Create:        resourceNsxtPolicyNATRuleCreate,
+		Read:          resourceNsxtPolicyNATRuleRead,
+		Update:        resourceNsxtPolicyNATRuleUpdate,
+		Delete:        resourceNsxtPolicyNATRuleDelete,
+		CustomizeDiff: validateGatewayContextDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyNATRuleImport,
 		},
@@ -198,7 +202,7 @@ func patchNsxtPolicyNATRule(sessionContext utl.SessionContext, connector client.
 	if err != nil {
 		return err
 	}
-	if util.NsxVersionHigherOrEqual("4.0.0") {
+	if policyNATRuleVpnModeGate.isSupported() {
 		_, err = getPolicyBasedVpnMode(rule)
 		if err != nil {
 			return err
@@ -275,7 +279,7 @@ func resourceNsxtPolicyNATRuleRead(d *schema.ResourceData, m interface{}) error
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -296,7 +300,7 @@ func resourceNsxtPolicyNATRuleRead(d *schema.ResourceData, m interface{}) error
 	}
 	d.Set("translated_ports", obj.TranslatedPorts)
 	d.Set("scope", obj.Scope)
-	if util.NsxVersionHigherOrEqual("4.0.0") {
+	if policyNATRuleVpnModeGate.isSupported() {
 		d.Set("policy_based_vpn_mode", obj.PolicyBasedVpnMode)
 	}
 	d.SetId(id)
@@ -345,7 +349,7 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 	sNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("source_networks").([]interface{})))
 	tNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("translated_networks").([]interface{})))
 	scope := getStringListFromSchemaSet(d, "scope")
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	ruleStruct := model.PolicyNatRule{
 		Id:                 &id,
@@ -370,7 +374,7 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 	if ports != "" {
 		ruleStruct.TranslatedPorts = &ports
 	}
-	if pbvmMatch != "" && util.NsxVersionHigherOrEqual("4.0.0") {
+	if pbvmMatch != "" && policyNATRuleVpnModeGate.isSupported() {
 		ruleStruct.PolicyBasedVpnMode = &pbvmMatch
 	}
 
@@ -415,7 +419,7 @@ func resourceNsxtPolicyNATRuleUpdate(d *schema.ResourceData, m interface{}) erro
 	dNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("destination_networks").([]interface{})))
 	sNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("source_networks").([]interface{})))
 	tNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("translated_networks").([]interface{})))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	scope := getStringListFromSchemaSet(d, "scope")
 
 	ruleStruct := model.PolicyNatRule{
@@ -444,7 +448,7 @@ func resourceNsxtPolicyNATRuleUpdate(d *schema.ResourceData, m interface{}) erro
 		ruleStruct.TranslatedPorts = &tPorts
 	}
 	pbvmMatch := d.Get("policy_based_vpn_mode").(string)
-	if pbvmMatch != "" && util.NsxVersionHigherOrEqual("4.0.0") {
+	if pbvmMatch != "" && policyNATRuleVpnModeGate.isSupported() {
 		ruleStruct.PolicyBasedVpnMode = &pbvmMatch
 	}
 
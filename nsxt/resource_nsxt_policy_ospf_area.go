@@ -109,7 +109,7 @@ func policyOspfAreaPatch(d *schema.ResourceData, m interface{}, id string) error
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	ospfPath := d.Get("ospf_path").(string)
 	areaID := d.Get("area_id").(string)
 	areaType := d.Get("area_type").(string)
@@ -192,7 +192,7 @@ func resourceNsxtPolicyOspfAreaRead(d *schema.ResourceData, m interface{}) error
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("area_id", obj.AreaId)
 	d.Set("area_type", obj.AreaType)
 	if obj.Authentication == nil {
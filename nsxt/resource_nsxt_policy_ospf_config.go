@@ -91,7 +91,7 @@ func getPolicyOspfConfigSchema() map[string]*schema.Schema {
 func policyOspfConfigPatch(d *schema.ResourceData, m interface{}, gwID string, localeServiceID string) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	ecmp := d.Get("ecmp").(bool)
 	enabled := d.Get("enabled").(bool)
 	defaultOriginate := d.Get("default_originate").(bool)
@@ -185,7 +185,7 @@ func resourceNsxtPolicyOspfConfigRead(d *schema.ResourceData, m interface{}) err
 	d.Set("description", obj.Description)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("enabled", obj.Enabled)
 	d.Set("ecmp", obj.Ecmp)
 	d.Set("default_originate", obj.DefaultOriginate)
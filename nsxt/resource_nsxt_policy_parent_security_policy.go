@@ -4,8 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
@@ -14,10 +16,10 @@ import (
 
 func resourceNsxtPolicyParentSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyParentSecurityPolicyCreate,
-		Read:   resourceNsxtPolicyParentSecurityPolicyRead,
-		Update: resourceNsxtPolicyParentSecurityPolicyUpdate,
-		Delete: resourceNsxtPolicyParentSecurityPolicyDelete,
+		CreateContext: resourceNsxtPolicyParentSecurityPolicyCreate,
+		ReadContext:   resourceNsxtPolicyParentSecurityPolicyRead,
+		UpdateContext: resourceNsxtPolicyParentSecurityPolicyUpdate,
+		DeleteContext: resourceNsxtPolicyParentSecurityPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
@@ -25,10 +27,10 @@ func resourceNsxtPolicyParentSecurityPolicy() *schema.Resource {
 	}
 }
 
-func parentSecurityPolicySchemaToModel(d *schema.ResourceData, id string) model.SecurityPolicy {
+func parentSecurityPolicySchemaToModel(d *schema.ResourceData, id string, m interface{}) model.SecurityPolicy {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	category := d.Get("category").(string)
 	comments := d.Get("comments").(string)
 	locked := d.Get("locked").(bool)
@@ -69,7 +71,7 @@ func parentSecurityPolicyModelToSchema(d *schema.ResourceData, m interface{}) (*
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("domain", getDomainFromResourcePath(*obj.Path))
@@ -88,18 +90,18 @@ func parentSecurityPolicyModelToSchema(d *schema.ResourceData, m interface{}) (*
 	return &obj, nil
 }
 
-func resourceNsxtPolicyParentSecurityPolicyCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtPolicySecurityPolicyGeneralCreate(d, m, false)
+func resourceNsxtPolicyParentSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNsxtPolicySecurityPolicyGeneralCreate(ctx, d, m, false)
 }
 
-func resourceNsxtPolicyParentSecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtPolicySecurityPolicyGeneralRead(d, m, false)
+func resourceNsxtPolicyParentSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNsxtPolicySecurityPolicyGeneralRead(ctx, d, m, false)
 }
 
-func resourceNsxtPolicyParentSecurityPolicyUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtPolicySecurityPolicyGeneralUpdate(d, m, false)
+func resourceNsxtPolicyParentSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNsxtPolicySecurityPolicyGeneralUpdate(ctx, d, m, false)
 }
 
-func resourceNsxtPolicyParentSecurityPolicyDelete(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtPolicySecurityPolicyDelete(d, m)
+func resourceNsxtPolicyParentSecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNsxtPolicySecurityPolicyDelete(ctx, d, m)
 }
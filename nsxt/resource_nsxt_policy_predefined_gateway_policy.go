@@ -308,7 +308,7 @@ func updatePolicyPredefinedGatewayPolicy(id string, d *schema.ResourceData, m in
 	}
 
 	if d.HasChange("tag") {
-		predefinedPolicy.Tags = getPolicyTagsFromSchema(d)
+		predefinedPolicy.Tags = getPolicyTagsFromSchema(d, m)
 	}
 
 	var childRules []*data.StructValue
@@ -420,7 +420,7 @@ func resourceNsxtPolicyPredefinedGatewayPolicyRead(d *schema.ResourceData, m int
 	}
 
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 
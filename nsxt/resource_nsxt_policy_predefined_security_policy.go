@@ -230,7 +230,7 @@ func updatePolicyPredefinedSecurityPolicy(id string, d *schema.ResourceData, m i
 	}
 
 	if d.HasChange("tag") {
-		predefinedPolicy.Tags = getPolicyTagsFromSchema(d)
+		predefinedPolicy.Tags = getPolicyTagsFromSchema(d, m)
 	}
 
 	var childRules []*data.StructValue
@@ -342,7 +342,7 @@ func resourceNsxtPolicyPredefinedSecurityPolicyRead(d *schema.ResourceData, m in
 	}
 
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 
@@ -78,7 +78,7 @@ func resourceNsxtPolicyProjectExists(id string, connector client.Connector, isGl
 func resourceNsxtPolicyProjectPatch(connector client.Connector, d *schema.ResourceData, m interface{}, id string) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	shortID := d.Get("short_id").(string)
 	siteInfosList := d.Get("site_info").([]interface{})
 	var siteInfos []model.SiteInfo
@@ -153,7 +153,7 @@ func resourceNsxtPolicyProjectRead(d *schema.ResourceData, m interface{}) error
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
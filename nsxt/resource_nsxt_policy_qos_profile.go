@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"log"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,52 +17,86 @@ import (
 
 	"github.com/vmware/terraform-provider-nsxt/api/infra"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
 )
 
 var rateLimiterResourceTypes = []string{model.QosBaseRateLimiter_RESOURCE_TYPE_INGRESSRATELIMITER, model.QosBaseRateLimiter_RESOURCE_TYPE_INGRESSBROADCASTRATELIMITER, model.QosBaseRateLimiter_RESOURCE_TYPE_EGRESSRATELIMITER}
 
-func resourceNsxtPolicyQosProfile() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNsxtPolicyQosProfileCreate,
-		Read:   resourceNsxtPolicyQosProfileRead,
-		Update: resourceNsxtPolicyQosProfileUpdate,
-		Delete: resourceNsxtPolicyQosProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: nsxtPolicyPathResourceImporter,
+var qosProfileSchema = map[string]*metadata.ExtendedSchema{
+	"nsx_id":       metadata.GetExtendedSchema(getNsxIDSchema()),
+	"path":         metadata.GetExtendedSchema(getPathSchema()),
+	"display_name": metadata.GetExtendedSchema(getDisplayNameSchema()),
+	"description":  metadata.GetExtendedSchema(getDescriptionSchema()),
+	"revision":     metadata.GetExtendedSchema(getRevisionSchema()),
+	"tag":          metadata.GetExtendedSchema(getTagsSchema()),
+	"context":      metadata.GetExtendedSchema(getContextSchema(false, false)),
+	"class_of_service": {
+		Schema: schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  "Class of service",
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 7),
 		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "int",
+			SdkFieldName: "ClassOfService",
+		},
+	},
+	"dscp_trusted": metadata.GetExtendedSchema(&schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Trust mode for DSCP",
+		Optional:    true,
+		Default:     false,
+	}),
+	"dscp_priority": {
+		Schema: schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  "DSCP Priority",
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 63),
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "int",
+			SdkFieldName: "Dscp.Priority",
+		},
+	},
+	"ingress_rate_shaper":           metadata.GetExtendedSchema(getQosRateShaperSchema(ingressRateShaperIndex)),
+	"ingress_broadcast_rate_shaper": metadata.GetExtendedSchema(getQosRateShaperSchema(ingressBroadcastRateShaperIndex)),
+	"egress_rate_shaper":            metadata.GetExtendedSchema(getQosRateShaperSchema(egressRateShaperIndex)),
+}
 
-		Schema: map[string]*schema.Schema{
-			"nsx_id":       getNsxIDSchema(),
-			"path":         getPathSchema(),
-			"display_name": getDisplayNameSchema(),
-			"description":  getDescriptionSchema(),
-			"revision":     getRevisionSchema(),
-			"tag":          getTagsSchema(),
-			"context":      getContextSchema(false, false),
-			"class_of_service": {
-				Type:         schema.TypeInt,
-				Description:  "Class of service",
-				Optional:     true,
-				ValidateFunc: validation.IntBetween(0, 7),
-			},
-			"dscp_trusted": {
-				Type:        schema.TypeBool,
-				Description: "Trust mode for DSCP",
-				Optional:    true,
-				Default:     false,
-			},
-
-			"dscp_priority": {
-				Type:         schema.TypeInt,
-				Description:  "DSCP Priority",
-				Optional:     true,
-				ValidateFunc: validation.IntBetween(0, 63),
-			},
-			"ingress_rate_shaper":           getQosRateShaperSchema(ingressRateShaperIndex),
-			"ingress_broadcast_rate_shaper": getQosRateShaperSchema(ingressBroadcastRateShaperIndex),
-			"egress_rate_shaper":            getQosRateShaperSchema(egressRateShaperIndex),
+func resourceNsxtPolicyQosProfile() *schema.Resource {
+	return getPolicyMetadataResource(&policyMetadataResource{
+		objectType: "QosProfile",
+		schema:     qosProfileSchema,
+		modelType:  reflect.TypeOf(model.QosProfile{}),
+		exists:     resourceNsxtPolicyQosProfileExists,
+		getObject: func(sessionContext utl.SessionContext, connector client.Connector, id string) (interface{}, error) {
+			client := infra.NewQosProfilesClient(sessionContext, connector)
+			if client == nil {
+				return nil, policyResourceNotSupportedError()
+			}
+			return client.Get(id)
 		},
-	}
+		patchObject: func(sessionContext utl.SessionContext, connector client.Connector, id string, obj interface{}) error {
+			client := infra.NewQosProfilesClient(sessionContext, connector)
+			if client == nil {
+				return policyResourceNotSupportedError()
+			}
+			boolFalse := false
+			return client.Patch(id, obj.(model.QosProfile), &boolFalse)
+		},
+		deleteObject: func(sessionContext utl.SessionContext, connector client.Connector, id string) error {
+			client := infra.NewQosProfilesClient(sessionContext, connector)
+			if client == nil {
+				return policyResourceNotSupportedError()
+			}
+			boolFalse := false
+			return client.Delete(id, &boolFalse)
+		},
+		schemaToStruct: qosProfileSchemaToStruct,
+		structToSchema: qosProfileStructToSchema,
+	})
 }
 
 func resourceNsxtPolicyQosProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
@@ -131,165 +166,35 @@ func setPolicyQosRateShaperInSchema(d *schema.ResourceData, shaperConf []*data.S
 	}
 }
 
-func resourceNsxtPolicyQosProfileCreate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyQosProfileExists)
-	if err != nil {
-		return err
-	}
-
-	displayName := d.Get("display_name").(string)
-	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
-
-	classOfService := int64(d.Get("class_of_service").(int))
+func qosProfileSchemaToStruct(d *schema.ResourceData, obj interface{}) error {
+	profile := obj.(*model.QosProfile)
 	dscpTrusted := "UNTRUSTED"
 	if d.Get("dscp_trusted").(bool) {
 		dscpTrusted = "TRUSTED"
 	}
-	dscpPriority := int64(d.Get("dscp_priority").(int))
-	var shapers []*data.StructValue
-	for index := ingressRateShaperIndex; index <= egressRateShaperIndex; index++ {
+	if profile.Dscp == nil {
+		profile.Dscp = &model.QosDscp{}
+	}
+	profile.Dscp.Mode = &dscpTrusted
 
+	for index := ingressRateShaperIndex; index <= egressRateShaperIndex; index++ {
 		shaper := getPolicyQosRateShaperFromSchema(d, index)
 		if shaper != nil {
-			shapers = append(shapers, shaper)
+			profile.ShaperConfigurations = append(profile.ShaperConfigurations, shaper)
 		}
 	}
-
-	obj := model.QosProfile{
-		DisplayName:    &displayName,
-		Description:    &description,
-		Tags:           tags,
-		ClassOfService: &classOfService,
-		Dscp: &model.QosDscp{
-			Mode:     &dscpTrusted,
-			Priority: &dscpPriority,
-		},
-		ShaperConfigurations: shapers,
-	}
-
-	// Create the resource using PATCH
-	log.Printf("[INFO] Creating QosProfile with ID %s", id)
-	boolFalse := false
-	client := infra.NewQosProfilesClient(getSessionContext(d, m), connector)
-	err = client.Patch(id, obj, &boolFalse)
-	if err != nil {
-		return handleCreateError("QosProfile", id, err)
-	}
-
-	d.SetId(id)
-	d.Set("nsx_id", id)
-
-	return resourceNsxtPolicyQosProfileRead(d, m)
+	return nil
 }
 
-func resourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID")
-	}
-
-	client := infra.NewQosProfilesClient(getSessionContext(d, m), connector)
-	obj, err := client.Get(id)
-	if err != nil {
-		return handleReadError(d, "QosProfile", id, err)
-	}
-
-	d.Set("display_name", obj.DisplayName)
-	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
-	d.Set("nsx_id", id)
-	d.Set("path", obj.Path)
-	d.Set("revision", obj.Revision)
-	d.Set("class_of_service", obj.ClassOfService)
-	if *obj.Dscp.Mode == "TRUSTED" {
+func qosProfileStructToSchema(d *schema.ResourceData, obj interface{}) error {
+	profile := obj.(*model.QosProfile)
+	if profile.Dscp != nil && profile.Dscp.Mode != nil && *profile.Dscp.Mode == "TRUSTED" {
 		d.Set("dscp_trusted", true)
 	} else {
 		d.Set("dscp_trusted", false)
 	}
-	d.Set("dscp_priority", obj.Dscp.Priority)
 	for index := ingressRateShaperIndex; index <= egressRateShaperIndex; index++ {
-		setPolicyQosRateShaperInSchema(d, obj.ShaperConfigurations, index)
+		setPolicyQosRateShaperInSchema(d, profile.ShaperConfigurations, index)
 	}
-
-	return nil
-}
-
-func resourceNsxtPolicyQosProfileUpdate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-	client := infra.NewQosProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID")
-	}
-
-	// Read the rest of the configured parameters
-	description := d.Get("description").(string)
-	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
-
-	classOfService := int64(d.Get("class_of_service").(int))
-	dscpTrusted := "UNTRUSTED"
-	if d.Get("dscp_trusted").(bool) {
-		dscpTrusted = "TRUSTED"
-	}
-	dscpPriority := int64(d.Get("dscp_priority").(int))
-	var shapers []*data.StructValue
-	for index := ingressRateShaperIndex; index <= egressRateShaperIndex; index++ {
-
-		shaper := getPolicyQosRateShaperFromSchema(d, index)
-		if shaper != nil {
-			shapers = append(shapers, shaper)
-		}
-	}
-
-	obj := model.QosProfile{
-		DisplayName:    &displayName,
-		Description:    &description,
-		Tags:           tags,
-		ClassOfService: &classOfService,
-		Dscp: &model.QosDscp{
-			Mode:     &dscpTrusted,
-			Priority: &dscpPriority,
-		},
-		ShaperConfigurations: shapers,
-	}
-
-	// Create the resource using PATCH
-	log.Printf("[INFO] Updating QosProfile with ID %s", id)
-	boolFalse := false
-	err := client.Patch(id, obj, &boolFalse)
-	if err != nil {
-		d.Partial(true)
-		return handleUpdateError("QosProfile", id, err)
-	}
-
-	return resourceNsxtPolicyQosProfileRead(d, m)
-}
-
-func resourceNsxtPolicyQosProfileDelete(d *schema.ResourceData, m interface{}) error {
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID")
-	}
-
-	connector := getPolicyConnector(m)
-	boolFalse := false
-	client := infra.NewQosProfilesClient(getSessionContext(d, m), connector)
-	err := client.Delete(id, &boolFalse)
-
-	if err != nil {
-		return handleDeleteError("QosProfile", id, err)
-	}
-
 	return nil
 }
@@ -0,0 +1,288 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+const defaultPolicyRawObjectRealizationTimeout = 10 * time.Minute
+
+var policyRawObjectUpdateMethods = []string{
+	http.MethodPatch,
+	http.MethodPut,
+}
+
+func resourceNsxtPolicyRawObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyRawObjectCreate,
+		Read:   resourceNsxtPolicyRawObjectRead,
+		Update: resourceNsxtPolicyRawObjectUpdate,
+		Delete: resourceNsxtPolicyRawObjectDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultPolicyRawObjectRealizationTimeout),
+			Update: schema.DefaultTimeout(defaultPolicyRawObjectRealizationTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyRawObjectImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the object, relative to the project in multitenancy context",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^/.*[^/]$"), "Policy path should start with / and should not end with /"),
+			},
+			"context": getContextSchema(false, false),
+			"body": {
+				Type:             schema.TypeString,
+				Description:      "JSON body of the object. Only keys specified here are tracked for drift",
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: policyRawObjectBodyDiffSuppress,
+			},
+			"update_method": {
+				Type:         schema.TypeString,
+				Description:  "HTTP method used to create and update the object",
+				Optional:     true,
+				Default:      http.MethodPatch,
+				ValidateFunc: validation.StringInSlice(policyRawObjectUpdateMethods, false),
+			},
+			"wait_for_realization": {
+				Type:        schema.TypeBool,
+				Description: "Wait for realization of the object after create and update",
+				Optional:    true,
+				Default:     false,
+			},
+			"response": {
+				Type:        schema.TypeString,
+				Description: "Full object as read from NSX, in JSON format",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// policyRawObjectBodyDiffSuppress ignores formatting and key order
+// differences in JSON body
+func policyRawObjectBodyDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+// filterPolicyRawObjectValue returns part of the remote value that corresponds
+// to keys present in configured value, so that attributes computed or
+// defaulted by NSX do not show as diff
+func filterPolicyRawObjectValue(configured interface{}, remote interface{}) interface{} {
+	switch configuredValue := configured.(type) {
+	case map[string]interface{}:
+		remoteValue, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+		result := make(map[string]interface{})
+		for key, value := range configuredValue {
+			if remoteElem, ok := remoteValue[key]; ok {
+				result[key] = filterPolicyRawObjectValue(value, remoteElem)
+			}
+		}
+		return result
+	case []interface{}:
+		remoteValue, ok := remote.([]interface{})
+		if !ok || len(remoteValue) != len(configuredValue) {
+			return remote
+		}
+		result := make([]interface{}, len(remoteValue))
+		for i := range remoteValue {
+			result[i] = filterPolicyRawObjectValue(configuredValue[i], remoteValue[i])
+		}
+		return result
+	}
+	return remote
+}
+
+// getPolicyRawObjectBodyFromResponse returns configured keys of the object
+// as read from NSX. If body is not known, as is the case after import, all
+// keys except system ones are returned.
+func getPolicyRawObjectBodyFromResponse(configuredBody string, response string) (string, error) {
+	var remote map[string]interface{}
+	if err := json.Unmarshal([]byte(response), &remote); err != nil {
+		return "", err
+	}
+
+	var result interface{}
+	if configuredBody == "" {
+		for key := range remote {
+			if strings.HasPrefix(key, "_") {
+				delete(remote, key)
+			}
+		}
+		result = remote
+	} else {
+		var configured interface{}
+		if err := json.Unmarshal([]byte(configuredBody), &configured); err != nil {
+			return "", err
+		}
+		result = filterPolicyRawObjectValue(configured, remote)
+	}
+
+	body, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+func policyRawObjectWrite(d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	objPath := d.Get("path").(string)
+	method := d.Get("update_method").(string)
+
+	body, err := decodePolicyRawAPIBody(d.Get("body").(string))
+	if err != nil {
+		return fmt.Errorf("Failed to decode body of %s: %v", objPath, err)
+	}
+	if method == http.MethodPut && !body.HasField("_revision") {
+		// PUT requires revision for existing objects
+		current, err := policyRawAPIRequest(connector, context, http.MethodGet, objPath, nil)
+		if err == nil {
+			if currentStruct, ok := current.(*data.StructValue); ok && currentStruct.HasField("_revision") {
+				revision, _ := currentStruct.Field("_revision")
+				body.SetField("_revision", revision)
+			}
+		} else if !isNotFoundError(err) {
+			return logAPIError(fmt.Sprintf("Failed to read %s", objPath), err)
+		}
+	}
+
+	log.Printf("[INFO] Sending %s request for %s", method, objPath)
+	if _, err := policyRawAPIRequest(connector, context, method, objPath, body); err != nil {
+		return err
+	}
+
+	if d.Get("wait_for_realization").(bool) {
+		return nsxtPolicyWaitForRealization(d, m, getPolicyRawAPIObjectPath(context, objPath), timeout)
+	}
+	return nil
+}
+
+func resourceNsxtPolicyRawObjectCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	objPath := d.Get("path").(string)
+
+	_, err := policyRawAPIRequest(connector, context, http.MethodGet, objPath, nil)
+	if err == nil {
+		return fmt.Errorf("Object %s already exists", objPath)
+	}
+	if !isNotFoundError(err) {
+		return logAPIError(fmt.Sprintf("Failed to read %s", objPath), err)
+	}
+
+	if err := policyRawObjectWrite(d, m, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return handleCreateError("Policy Object", objPath, err)
+	}
+
+	d.SetId(getPolicyRawAPIObjectPath(context, objPath))
+	return resourceNsxtPolicyRawObjectRead(d, m)
+}
+
+func resourceNsxtPolicyRawObjectRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	objPath := d.Get("path").(string)
+
+	obj, err := policyRawAPIRequest(connector, getSessionContext(d, m), http.MethodGet, objPath, nil)
+	if err != nil {
+		return handleReadError(d, "Policy Object", objPath, err)
+	}
+
+	response, err := cleanjson.NewDataValueToJsonEncoder().Encode(obj)
+	if err != nil {
+		return fmt.Errorf("Failed to encode %s: %v", objPath, err)
+	}
+	body, err := getPolicyRawObjectBodyFromResponse(d.Get("body").(string), response)
+	if err != nil {
+		return fmt.Errorf("Failed to read body of %s: %v", objPath, err)
+	}
+
+	d.Set("body", body)
+	d.Set("response", response)
+
+	return nil
+}
+
+func resourceNsxtPolicyRawObjectUpdate(d *schema.ResourceData, m interface{}) error {
+	objPath := d.Get("path").(string)
+	if d.HasChange("body") {
+		if err := policyRawObjectWrite(d, m, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return handleUpdateError("Policy Object", objPath, err)
+		}
+	}
+
+	return resourceNsxtPolicyRawObjectRead(d, m)
+}
+
+func resourceNsxtPolicyRawObjectDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	objPath := d.Get("path").(string)
+
+	log.Printf("[INFO] Deleting %s", objPath)
+	_, err := policyRawAPIRequest(connector, getSessionContext(d, m), http.MethodDelete, objPath, nil)
+	if err != nil {
+		return handleDeleteError("Policy Object", objPath, err)
+	}
+
+	return nil
+}
+
+// resourceNsxtPolicyRawObjectImport imports object by its full policy path.
+// For objects that belong to a project, context is set from the path.
+func resourceNsxtPolicyRawObjectImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if !isPolicyPath(importID) {
+		return nil, fmt.Errorf("Policy path expected, got %s", importID)
+	}
+
+	objPath := importID
+	pathSegs := strings.Split(importID, "/")
+	if pathSegs[1] == "orgs" && pathSegs[3] == "projects" {
+		if len(pathSegs) < 6 || pathSegs[2] != utl.DefaultOrgID {
+			return nil, fmt.Errorf("invalid policy multitenancy path %s", importID)
+		}
+		contexts := make([]interface{}, 1)
+		ctxMap := make(map[string]interface{})
+		ctxMap["project_id"] = pathSegs[4]
+		contexts[0] = ctxMap
+		d.Set("context", contexts)
+		objPath = "/" + strings.Join(pathSegs[5:], "/")
+	}
+
+	d.Set("path", objPath)
+	d.Set("update_method", http.MethodPatch)
+	d.Set("wait_for_realization", false)
+	return []*schema.ResourceData{d}, nil
+}
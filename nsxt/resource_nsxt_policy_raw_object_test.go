@@ -0,0 +1,233 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestAccResourceNsxtPolicyRawObject_basic(t *testing.T) {
+	testAccResourceNsxtPolicyRawObjectBasic(t, false, func() {
+		testAccPreCheck(t)
+		testAccOnlyLocalManager(t)
+	})
+}
+
+func TestAccResourceNsxtPolicyRawObject_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicyRawObjectBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicyRawObjectBasic(t *testing.T, withContext bool, preCheck func()) {
+	testResourceName := "nsxt_policy_raw_object.test"
+	testDataSourceName := "data.nsxt_policy_group.check"
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGroupCheckDestroy(state, name, defaultDomain)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRawObjectTemplate(name, "terraform created", withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "path", "/infra/domains/default/groups/"+name),
+					resource.TestCheckResourceAttrSet(testResourceName, "response"),
+					resource.TestCheckResourceAttr(testDataSourceName, "description", "terraform created"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyRawObjectTemplate(name, "terraform updated", withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testDataSourceName, "description", "terraform updated"),
+				),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "wait_for_realization"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyRawObjectTemplate(name string, description string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_raw_object" "test" {
+%s
+  path = "/infra/domains/default/groups/%s"
+  body = jsonencode({
+    display_name = "%s"
+    description  = "%s"
+    tags = [{
+      scope = "color"
+      tag   = "orange"
+    }]
+  })
+  wait_for_realization = true
+}
+
+data "nsxt_policy_group" "check" {
+%s
+  display_name = "%s"
+  depends_on   = [nsxt_policy_raw_object.test]
+}`, context, name, name, description, context, name)
+}
+
+// testPolicyRawObjectOperation runs resource operation and converts diagnostics to error
+func testPolicyRawObjectOperation(operation func(*schema.Resource, *schema.ResourceData, interface{}) diag.Diagnostics, res *schema.Resource, d *schema.ResourceData, m interface{}) error {
+	diags := operation(res, d, m)
+	if diags.HasError() {
+		return fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+	}
+	return nil
+}
+
+func testCheckPolicyRawObjectBody(t *testing.T, d *schema.ResourceData, expected string) {
+	t.Helper()
+	if !policyRawObjectBodyDiffSuppress("body", d.Get("body").(string), expected, d) {
+		t.Errorf("Expected body %s, got %s", expected, d.Get("body"))
+	}
+}
+
+func TestResourceNsxtPolicyRawObjectSimulator(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	provider := testConfigureSimulatorProvider(t, sim, nil)
+	rawResource := provider.ResourcesMap["nsxt_policy_raw_object"]
+	m := provider.Meta()
+	objPath := "/infra/ipfix-collector-profiles/profile1"
+	body := `{"display_name": "profile1", "ipfix_collectors": [{"collector_ip_address": "1.1.1.1", "collector_port": 4739}]}`
+
+	d := schema.TestResourceDataRaw(t, rawResource.Schema, map[string]interface{}{
+		"path":                 objPath,
+		"body":                 body,
+		"wait_for_realization": true,
+	})
+	if err := testPolicyRawObjectOperation(testResourceCreate, rawResource, d, m); err != nil {
+		t.Fatalf("Failed to create raw object: %v", err)
+	}
+	if d.Id() != objPath {
+		t.Errorf("Expected ID %s, got %s", objPath, d.Id())
+	}
+	testCheckPolicyRawObjectBody(t, d, body)
+	var response map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("response").(string)), &response); err != nil {
+		t.Fatal(err)
+	}
+	if response["path"] != objPath || response["_revision"] == nil {
+		t.Errorf("Expected full object in response, got %v", response)
+	}
+
+	// Object with same path can not be created twice
+	duplicate := schema.TestResourceDataRaw(t, rawResource.Schema, map[string]interface{}{"path": objPath, "body": body})
+	if err := testPolicyRawObjectOperation(testResourceCreate, rawResource, duplicate, m); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Expected error on duplicate create, got %v", err)
+	}
+
+	// Keys that were not configured are not tracked, while drift of configured keys is
+	connector := getPolicyConnector(m)
+	drift, _ := decodePolicyRawAPIBody(`{"display_name": "changed", "description": "set outside"}`)
+	if _, err := policyRawAPIRequest(connector, utl.SessionContext{ClientType: utl.Local}, http.MethodPatch, objPath, drift); err != nil {
+		t.Fatal(err)
+	}
+	if err := testPolicyRawObjectOperation(testResourceRead, rawResource, d, m); err != nil {
+		t.Fatal(err)
+	}
+	testCheckPolicyRawObjectBody(t, d, `{"display_name": "changed", "ipfix_collectors": [{"collector_ip_address": "1.1.1.1", "collector_port": 4739}]}`)
+
+	update := schema.TestResourceDataRaw(t, rawResource.Schema, map[string]interface{}{
+		"path":          objPath,
+		"body":          `{"display_name": "profile1", "description": "updated"}`,
+		"update_method": http.MethodPut,
+	})
+	update.SetId(objPath)
+	if err := testPolicyRawObjectOperation(testResourceUpdate, rawResource, update, m); err != nil {
+		t.Fatalf("Failed to update raw object: %v", err)
+	}
+	testCheckPolicyRawObjectBody(t, update, `{"display_name": "profile1", "description": "updated"}`)
+
+	if err := testPolicyRawObjectOperation(testResourceDelete, rawResource, update, m); err != nil {
+		t.Fatalf("Failed to delete raw object: %v", err)
+	}
+	if err := testPolicyRawObjectOperation(testResourceRead, rawResource, update, m); err != nil || update.Id() != "" {
+		t.Errorf("Expected deleted object to be removed from state, got %v", err)
+	}
+
+	project := schema.TestResourceDataRaw(t, rawResource.Schema, map[string]interface{}{
+		"path":    objPath,
+		"body":    body,
+		"context": []interface{}{map[string]interface{}{"project_id": "p1"}},
+	})
+	if err := testPolicyRawObjectOperation(testResourceCreate, rawResource, project, m); err != nil {
+		t.Fatalf("Failed to create raw object in project: %v", err)
+	}
+	if expected := "/orgs/default/projects/p1" + objPath; project.Id() != expected {
+		t.Errorf("Expected ID %s, got %s", expected, project.Id())
+	}
+}
+
+func TestResourceNsxtPolicyRawObjectImport(t *testing.T) {
+	rawResource := resourceNsxtPolicyRawObject()
+
+	d := rawResource.TestResourceData()
+	d.SetId("/orgs/default/projects/p1/infra/ipfix-collector-profiles/profile1")
+	if _, err := resourceNsxtPolicyRawObjectImport(d, nil); err != nil {
+		t.Fatal(err)
+	}
+	if path := d.Get("path").(string); path != "/infra/ipfix-collector-profiles/profile1" {
+		t.Errorf("Expected path relative to project, got %s", path)
+	}
+	if projectID := getProjectIDFromSchema(d); projectID != "p1" {
+		t.Errorf("Expected project p1 in context, got %s", projectID)
+	}
+
+	d.SetId("profile1")
+	if _, err := resourceNsxtPolicyRawObjectImport(d, nil); err == nil {
+		t.Errorf("Expected error for import ID that is not a policy path")
+	}
+}
+
+func TestGetPolicyRawObjectBodyFromResponse(t *testing.T) {
+	response := `{"_revision": 2, "id": "p1", "display_name": "p1", "nested": {"a": 1, "b": 2}, "list": [{"x": 1, "y": 2}], "other": [1, 2]}`
+
+	body, err := getPolicyRawObjectBodyFromResponse(`{"nested": {"a": 0}, "list": [{"x": 0}], "other": [1], "missing": true}`, response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"list":[{"x":1}],"nested":{"a":1},"other":[1,2]}`
+	if body != expected {
+		t.Errorf("Expected body %s, got %s", expected, body)
+	}
+
+	body, err = getPolicyRawObjectBodyFromResponse("", response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(body, "_revision") || !strings.Contains(body, `"display_name":"p1"`) {
+		t.Errorf("Expected body without system attributes, got %s", body)
+	}
+}
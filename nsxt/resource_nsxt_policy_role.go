@@ -141,7 +141,7 @@ func policyUserManagementRoleUpdate(id string, d *schema.ResourceData, m interfa
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	role := d.Get("role").(string)
 	features := getFeaturePermissionFromSchema(d)
 	revision := int64(d.Get("revision").(int))
@@ -220,7 +220,7 @@ func resourceNsxtPolicyUserManagementRoleRead(d *schema.ResourceData, m interfac
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("revision", obj.Revision)
 	d.Set("role", obj.Role)
 	setFeaturePermissionInSchema(d, obj.Features)
@@ -225,11 +225,11 @@ func getRolesForPathList(d *schema.ResourceData, rolesToRemove rolesForPath) []n
 	return nsxRolesForPaths
 }
 
-func getRoleBindingObject(d *schema.ResourceData, removeRoles rolesForPath) *nsxModel.RoleBinding {
+func getRoleBindingObject(d *schema.ResourceData, removeRoles rolesForPath, m interface{}) *nsxModel.RoleBinding {
 	boolTrue := true
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	name := d.Get("name").(string)
 	identitySrcID := d.Get("identity_source_id").(string)
 	identitySrcType := d.Get("identity_source_type").(string)
@@ -289,7 +289,7 @@ func overwriteRoleBinding(d *schema.ResourceData, m interface{}, existingRoleBin
 	}
 
 	log.Printf("[INFO] Overwriting RoleBinding with ID %s", id)
-	obj := getRoleBindingObject(d, existingRoles)
+	obj := getRoleBindingObject(d, existingRoles, m)
 	_, err := rbClient.Update(id, *obj)
 	if err != nil {
 		return handleUpdateError("RoleBinding", id, err)
@@ -362,7 +362,7 @@ func resourceNsxtPolicyUserManagementRoleBindingCreate(d *schema.ResourceData, m
 
 	// Create the resource using POST
 	log.Printf("[INFO] Creating RoleBinding for %s %s", roleBindingType, username)
-	obj := getRoleBindingObject(d, rolesForPath{})
+	obj := getRoleBindingObject(d, rolesForPath{}, m)
 
 	rbObj, err := rbClient.Create(*obj)
 	if err != nil {
@@ -392,7 +392,7 @@ func resourceNsxtPolicyUserManagementRoleBindingRead(d *schema.ResourceData, m i
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("revision", obj.Revision)
 	d.Set("name", obj.Name)
 	d.Set("type", obj.Type_)
@@ -420,7 +420,7 @@ func resourceNsxtPolicyUserManagementRoleBindingUpdate(d *schema.ResourceData, m
 	log.Printf("[INFO] Updateing RoleBinding with ID %s", id)
 	connector := getPolicyConnector(m)
 	rbClient := aaa.NewRoleBindingsClient(connector)
-	obj := getRoleBindingObject(d, rolesForPath{})
+	obj := getRoleBindingObject(d, rolesForPath{}, m)
 	_, err := rbClient.Update(id, *obj)
 	if err != nil {
 		return handleCreateError("RoleBinding", id, err)
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -16,15 +17,19 @@ import (
 )
 
 func resourceNsxtPolicySecurityPolicy() *schema.Resource {
+	policySchema := getPolicySecurityPolicySchema(false, true, true)
+
 	return &schema.Resource{
-		Create: resourceNsxtPolicySecurityPolicyCreate,
-		Read:   resourceNsxtPolicySecurityPolicyRead,
-		Update: resourceNsxtPolicySecurityPolicyUpdate,
-		Delete: resourceNsxtPolicySecurityPolicyDelete,
+		CreateContext: resourceNsxtPolicySecurityPolicyCreate,
+		ReadContext:   resourceNsxtPolicySecurityPolicyRead,
+		UpdateContext: resourceNsxtPolicySecurityPolicyUpdate,
+		DeleteContext: resourceNsxtPolicySecurityPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
-		Schema: getPolicySecurityPolicySchema(false, true, true),
+		Schema:         policySchema,
+		SchemaVersion:  policySecurityPolicySchemaVersion,
+		StateUpgraders: getPolicySecurityPolicyStateUpgraders(policySchema),
 	}
 }
 
@@ -56,7 +61,7 @@ func resourceNsxtPolicySecurityPolicyExistsPartial(domainName string) func(sessi
 }
 
 func policySecurityPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, id string, createFlow, withRule bool) error {
-	obj := parentSecurityPolicySchemaToModel(d, id)
+	obj := parentSecurityPolicySchemaToModel(d, id, m)
 	domain := d.Get("domain").(string)
 	revision := int64(d.Get("revision").(int))
 	log.Printf("[INFO] Creating Security Policy with ID %s", id)
@@ -85,75 +90,76 @@ func policySecurityPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, id
 	return securityPolicyInfraPatch(getSessionContext(d, m), obj, domain, m)
 }
 
-func resourceNsxtPolicySecurityPolicyCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtPolicySecurityPolicyGeneralCreate(d, m, true)
+func resourceNsxtPolicySecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNsxtPolicySecurityPolicyGeneralCreate(ctx, d, m, true)
 }
 
-func resourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtPolicySecurityPolicyGeneralRead(d, m, true)
+func resourceNsxtPolicySecurityPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNsxtPolicySecurityPolicyGeneralRead(ctx, d, m, true)
 }
 
-func resourceNsxtPolicySecurityPolicyUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtPolicySecurityPolicyGeneralUpdate(d, m, true)
+func resourceNsxtPolicySecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNsxtPolicySecurityPolicyGeneralUpdate(ctx, d, m, true)
 }
 
-func resourceNsxtPolicySecurityPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy id")
+		return diag.Errorf("Error obtaining Security Policy id")
 	}
 
-	connector := getPolicyConnector(m)
+	connector := getContextPolicyConnector(ctx, m)
 
 	client := domains.NewSecurityPoliciesClient(getSessionContext(d, m), connector)
 	err := client.Delete(d.Get("domain").(string), id)
 
 	if err != nil {
-		return handleDeleteError("Security Policy", id, err)
+		return handleDeleteErrorDiagnostics("Security Policy", id, err)
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicySecurityPolicyGeneralCreate(d *schema.ResourceData, m interface{}, withRule bool) error {
+func resourceNsxtPolicySecurityPolicyGeneralCreate(ctx context.Context, d *schema.ResourceData, m interface{}, withRule bool) diag.Diagnostics {
+	m = withContextPolicyConnector(ctx, m)
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicySecurityPolicyExistsPartial(d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = policySecurityPolicyBuildAndPatch(d, m, id, true, withRule)
 
 	if err != nil {
-		return handleCreateError("Security Policy", id, err)
+		return handleCreateErrorDiagnostics(getPolicySecurityPolicySchema(false, true, withRule), d, "Security Policy", id, err)
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicySecurityPolicyGeneralRead(d, m, withRule)
+	return resourceNsxtPolicySecurityPolicyGeneralRead(ctx, d, m, withRule)
 }
 
-func resourceNsxtPolicySecurityPolicyGeneralRead(d *schema.ResourceData, m interface{}, withRule bool) error {
-	obj, err := parentSecurityPolicyModelToSchema(d, m)
+func resourceNsxtPolicySecurityPolicyGeneralRead(ctx context.Context, d *schema.ResourceData, m interface{}, withRule bool) diag.Diagnostics {
+	obj, err := parentSecurityPolicyModelToSchema(d, withContextPolicyConnector(ctx, m))
 	if err != nil {
-		return handleReadError(d, "SecurityPolicy", d.Id(), err)
+		return handleReadErrorDiagnostics(d, "SecurityPolicy", d.Id(), err)
 	}
 	if withRule {
-		return setPolicyRulesInSchema(d, obj.Rules)
+		return diag.FromErr(setPolicyRulesInSchema(d, obj.Rules))
 	}
 	return nil
 }
 
-func resourceNsxtPolicySecurityPolicyGeneralUpdate(d *schema.ResourceData, m interface{}, withRule bool) error {
+func resourceNsxtPolicySecurityPolicyGeneralUpdate(ctx context.Context, d *schema.ResourceData, m interface{}, withRule bool) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy id")
+		return diag.Errorf("Error obtaining Security Policy id")
 	}
-	err := policySecurityPolicyBuildAndPatch(d, m, id, false, withRule)
+	err := policySecurityPolicyBuildAndPatch(d, withContextPolicyConnector(ctx, m), id, false, withRule)
 	if err != nil {
-		return handleUpdateError("Security Policy", id, err)
+		return handleUpdateErrorDiagnostics(getPolicySecurityPolicySchema(false, true, withRule), d, "Security Policy", id, err)
 	}
 
-	return resourceNsxtPolicySecurityPolicyGeneralRead(d, m, withRule)
+	return resourceNsxtPolicySecurityPolicyGeneralRead(ctx, d, m, withRule)
 }
@@ -167,11 +167,11 @@ func resourceNsxtPolicySecurityPolicyRuleRead(d *schema.ResourceData, m interfac
 		return handleReadError(d, "SecurityPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
 	}
 
-	securityPolicyRuleModelToSchema(d, rule)
+	securityPolicyRuleModelToSchema(d, rule, m)
 	return nil
 }
 
-func securityPolicyRuleModelToSchema(d *schema.ResourceData, rule model.Rule) {
+func securityPolicyRuleModelToSchema(d *schema.ResourceData, rule model.Rule, m interface{}) {
 	d.Set("display_name", rule.DisplayName)
 	d.Set("description", rule.Description)
 	d.Set("path", rule.Path)
@@ -198,7 +198,7 @@ func securityPolicyRuleModelToSchema(d *schema.ResourceData, rule model.Rule) {
 	d.Set("nsx_id", rule.Id)
 	d.Set("rule_id", rule.RuleId)
 
-	setPolicyTagsInSchema(d, rule.Tags)
+	setPolicyTagsInSchema(d, rule.Tags, m)
 }
 
 func resourceNsxtPolicySecurityPolicyRuleUpdate(d *schema.ResourceData, m interface{}) error {
@@ -247,7 +247,8 @@ func resourceNsxtPolicySegmentSecurityProfile() *schema.Resource {
 			State: nsxtPolicyPathResourceImporter,
 		},
 
-		Schema: metadata.GetSchemaFromExtendedSchema(segmentSecurityProfileSchema),
+		Schema:        metadata.GetSchemaFromExtendedSchema(segmentSecurityProfileSchema),
+		CustomizeDiff: getExtendedSchemaVersionGatingCustomizeDiff(segmentSecurityProfileSchema),
 	}
 }
 
@@ -270,7 +271,7 @@ func resourceNsxtPolicySegmentSecurityProfilePatch(d *schema.ResourceData, m int
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	// example of attribute that we prefer to handle manually in the code
 	// it is marked with `skip` in metadata
 	bpduFilterAllow := getStringListFromSchemaSet(d, "bpdu_filter_allow")
@@ -331,7 +332,7 @@ func resourceNsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m inte
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
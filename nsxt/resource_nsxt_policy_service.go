@@ -454,7 +454,7 @@ func resourceNsxtPolicyServiceCreate(d *schema.ResourceData, m interface{}) erro
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	serviceEntries, errc := resourceNsxtPolicyServiceGetEntriesFromSchema(d)
 	if errc != nil {
 		return fmt.Errorf("Error during Service entries conversion: %v", errc)
@@ -497,7 +497,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -666,7 +666,7 @@ func resourceNsxtPolicyServiceUpdate(d *schema.ResourceData, m interface{}) erro
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	revision := int64(d.Get("revision").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	serviceEntries, errc := resourceNsxtPolicyServiceGetEntriesFromSchema(d)
 	if errc != nil {
 		return fmt.Errorf("Error during Service entries conversion: %v", errc)
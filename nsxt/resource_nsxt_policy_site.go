@@ -142,10 +142,10 @@ func setConnectionInfosInSchema(d *schema.ResourceData, connectionInfo []gm_mode
 	d.Set(key, connectionInfos)
 }
 
-func getSiteFromSchema(d *schema.ResourceData) gm_model.Site {
+func getSiteFromSchema(d *schema.ResourceData, m interface{}) gm_model.Site {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getGMTagsFromSchema(d)
+	tags := getGMTagsFromSchema(d, m)
 	failIfRtepMisconfigured := d.Get("fail_if_rtep_misconfigured").(bool)
 	failIfRttExceeded := d.Get("fail_if_rtt_exceeded").(bool)
 	maximumRtt := int64(d.Get("maximum_rtt").(int))
@@ -189,7 +189,7 @@ func resourceNsxtPolicySiteCreate(d *schema.ResourceData, m interface{}) error {
 
 	connector := getPolicyConnector(m)
 	client := global_infra.NewSitesClient(connector)
-	site := getSiteFromSchema(d)
+	site := getSiteFromSchema(d, m)
 
 	err = client.Patch(id, site)
 	if err != nil {
@@ -216,7 +216,7 @@ func resourceNsxtPolicySiteRead(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setGMTagsInSchema(d, obj.Tags)
+	setGMTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -240,7 +240,7 @@ func resourceNsxtPolicySiteUpdate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 	client := global_infra.NewSitesClient(connector)
 
-	obj := getSiteFromSchema(d)
+	obj := getSiteFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	obj.Revision = &revision
 
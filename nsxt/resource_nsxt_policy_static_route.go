@@ -22,10 +22,11 @@ import (
 
 func resourceNsxtPolicyStaticRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyStaticRouteCreate,
-		Read:   resourceNsxtPolicyStaticRouteRead,
-		Update: resourceNsxtPolicyStaticRouteUpdate,
-		Delete: resourceNsxtPolicyStaticRouteDelete,
+		Create:        resourceNsxtPolicyStaticRouteCreate,
+		Read:          resourceNsxtPolicyStaticRouteRead,
+		Update:        resourceNsxtPolicyStaticRouteUpdate,
+		Delete:        resourceNsxtPolicyStaticRouteDelete,
+		CustomizeDiff: validateGatewayContextDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyStaticRouteImport,
 		},
@@ -133,7 +134,7 @@ func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{})
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	network := d.Get("network").(string)
 
 	var nextHopsStructs []model.RouterNexthop
@@ -207,7 +208,7 @@ func resourceNsxtPolicyStaticRouteRead(d *schema.ResourceData, m interface{}) er
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -260,7 +261,7 @@ func resourceNsxtPolicyStaticRouteUpdate(d *schema.ResourceData, m interface{})
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	network := d.Get("network").(string)
 
 	var nextHopsStructs []model.RouterNexthop
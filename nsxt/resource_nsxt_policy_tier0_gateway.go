@@ -8,7 +8,6 @@ import (
 	"log"
 
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -41,9 +40,19 @@ var policyVRFRouteValues = []string{
 var policyBGPGracefulRestartTimerDefault = 180
 var policyBGPGracefulRestartStaleRouteTimerDefault = 600
 
+var (
+	// VRF Lite is supported from 3.0.0 onwards
+	policyTier0VRFGate = newAttributeVersionGate("3.0.0", map[string][]string{
+		"nsxt_policy_tier0_gateway": {"vrf_config", "rd_admin_address"},
+	})
+	policyTier0VrfTransitSubnetsGate = newAttributeVersionGate("4.1.0", map[string][]string{
+		"nsxt_policy_tier0_gateway": {"vrf_transit_subnets"},
+	})
+)
+
 func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceNsxtPolicyTier0GatewayCreate,
 		Read:   resourceNsxtPolicyTier0GatewayRead,
 		Update: resourceNsxtPolicyTier0GatewayUpdate,
@@ -142,6 +151,29 @@ func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 			},
 		},
 	}
+
+	resource.SchemaVersion = 1
+	resource.StateUpgraders = []schema.StateUpgrader{
+		getPolicyStateUpgrader(0, resource.Schema, resource.Schema, policyTier0GatewayStateUpgradeV0),
+	}
+	return resource
+}
+
+// State of version 0 might lack redistribution_set flag, in which case
+// redistribution_config would be ignored on read. The flag is derived from
+// redistribution config present in state.
+func policyTier0GatewayStateUpgradeV0(rawState map[string]interface{}) error {
+	if _, ok := rawState["redistribution_set"].(bool); ok {
+		return nil
+	}
+	redistributionSet := len(getStateBlocks(rawState, "redistribution_config")) > 0
+	for _, service := range getStateBlocks(rawState, "locale_service") {
+		if len(getStateBlocks(service, "redistribution_config")) > 0 {
+			redistributionSet = true
+		}
+	}
+	rawState["redistribution_set"] = redistributionSet
+	return nil
 }
 
 func getPolicyTier0BGPConfigSchema() *schema.Schema {
@@ -439,7 +471,7 @@ func resourceNsxtPolicyTier0GatewayReadBGPConfig(d *schema.ResourceData, connect
 
 func getPolicyVRFConfigFromSchema(d *schema.ResourceData) *model.Tier0VrfConfig {
 
-	if util.NsxVersionLower("3.0.0") {
+	if !policyTier0VRFGate.isSupported() {
 		// VRF Lite is supported from 3.0.0 onwards
 		return nil
 	}
@@ -714,7 +746,7 @@ func initPolicyTier0ChildBgpConfig(config *model.BgpRoutingConfig) (*data.Struct
 	return dataValue.(*data.StructValue), nil
 }
 
-func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string) (model.Infra, error) {
+func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string, m interface{}) (model.Infra, error) {
 	var infraChildren, gwChildren, lsChildren []*data.StructValue
 	var infraStruct model.Infra
 	converter := bindings.NewTypeConverter()
@@ -722,7 +754,7 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	failoverMode := d.Get("failover_mode").(string)
 	defaultRuleLogging := d.Get("default_rule_logging").(bool)
 	disableFirewall := !d.Get("enable_firewall").(bool)
@@ -759,11 +791,11 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		VrfConfig:              vrfConfig,
 	}
 
-	if util.NsxVersionHigherOrEqual("3.0.0") {
+	if policyTier0VRFGate.isSupported() {
 		t0Struct.RdAdminField = rdAdminField
 	}
 
-	if util.NsxVersionHigherOrEqual("4.1.0") {
+	if policyTier0VrfTransitSubnetsGate.isSupported() {
 		t0Struct.VrfTransitSubnets = vrfTransitSubnets
 	}
 
@@ -864,7 +896,7 @@ func resourceNsxtPolicyTier0GatewayCreate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
-	obj, err := policyTier0GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
+	obj, err := policyTier0GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id, m)
 	if err != nil {
 		return err
 	}
@@ -917,7 +949,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("failover_mode", obj.FailoverMode)
@@ -929,7 +961,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 	d.Set("transit_subnets", obj.TransitSubnets)
 	d.Set("vrf_transit_subnets", obj.VrfTransitSubnets)
 	d.Set("revision", obj.Revision)
-	if util.NsxVersionHigherOrEqual("3.0.0") {
+	if policyTier0VRFGate.isSupported() {
 		d.Set("rd_admin_address", obj.RdAdminField)
 	}
 	vrfErr := setPolicyVRFConfigInSchema(d, obj.VrfConfig)
@@ -1039,7 +1071,7 @@ func resourceNsxtPolicyTier0GatewayUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error obtaining Tier0 ID")
 	}
 
-	obj, err := policyTier0GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
+	obj, err := policyTier0GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id, m)
 	if err != nil {
 		return handleUpdateError("Tier0", id, err)
 	}
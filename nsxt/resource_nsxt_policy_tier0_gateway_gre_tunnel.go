@@ -147,12 +147,12 @@ func resourceNsxtPolicyTier0GatewayGRETunnel() *schema.Resource {
 	}
 }
 
-func tier0GatewayGRETunnelFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+func tier0GatewayGRETunnelFromSchema(d *schema.ResourceData, m interface{}) (*data.StructValue, error) {
 	converter := bindings.NewTypeConverter()
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	destinationAddress := d.Get("destination_address").(string)
 	enabled := d.Get("enabled").(bool)
 	mtu := int64(d.Get("mtu").(int))
@@ -255,7 +255,7 @@ func resourceNsxtPolicyTier0GatewayGRETunnelCreate(d *schema.ResourceData, m int
 	}
 	client := locale_services.NewTunnelsClient(connector)
 
-	obj, err := tier0GatewayGRETunnelFromSchema(d)
+	obj, err := tier0GatewayGRETunnelFromSchema(d, m)
 	if err != nil {
 		return fmt.Errorf("unable to create the GRE Tunnel: %v", err)
 	}
@@ -303,7 +303,7 @@ func resourceNsxtPolicyTier0GatewayGRETunnelRead(d *schema.ResourceData, m inter
 	d.Set("display_name", greTunnel.DisplayName)
 	d.Set("description", greTunnel.Description)
 	d.Set("revision", greTunnel.Revision)
-	setPolicyTagsInSchema(d, greTunnel.Tags)
+	setPolicyTagsInSchema(d, greTunnel.Tags, m)
 	d.Set("destination_address", greTunnel.DestinationAddress)
 	d.Set("enabled", greTunnel.Enabled)
 	d.Set("mtu", greTunnel.Mtu)
@@ -350,7 +350,7 @@ func resourceNsxtPolicyTier0GatewayGRETunnelUpdate(d *schema.ResourceData, m int
 	}
 	client := locale_services.NewTunnelsClient(connector)
 
-	obj, err := tier0GatewayGRETunnelFromSchema(d)
+	obj, err := tier0GatewayGRETunnelFromSchema(d, m)
 	if err != nil {
 		return fmt.Errorf("failed to update GRE Tunnel: %v", err)
 	}
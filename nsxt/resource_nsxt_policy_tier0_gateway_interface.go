@@ -8,8 +8,6 @@ import (
 	"log"
 	"strings"
 
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
@@ -32,6 +30,10 @@ var gatewayInterfaceOspfNetworkTypeValues = []string{
 	model.PolicyInterfaceOspfConfig_NETWORK_TYPE_P2P,
 }
 
+var policyTier0InterfaceVersionDependentGate = newAttributeVersionGate("3.0.0", map[string][]string{
+	"nsxt_policy_tier0_gateway_interface": {"enable_pim", "access_vlan_id", "urpf_mode", "ospf"},
+})
+
 func resourceNsxtPolicyTier0GatewayInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyTier0GatewayInterfaceCreate,
@@ -146,7 +148,7 @@ func getGatewayInterfaceOspfSchema() *schema.Schema {
 }
 
 func gatewayInterfaceVersionDepenantSet(d *schema.ResourceData, m interface{}, obj *model.Tier0Interface) error {
-	if util.NsxVersionLower("3.0.0") {
+	if !policyTier0InterfaceVersionDependentGate.isSupported() {
 		return nil
 	}
 	interfaceType := d.Get("type").(string)
@@ -300,7 +302,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
 	var ipv6ProfilePaths []string
 	if d.Get("ipv6_ndra_profile_path").(string) != "" {
@@ -401,7 +403,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -468,7 +470,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
 	segmentPath := d.Get("segment_path").(string)
 	var ipv6ProfilePaths []string
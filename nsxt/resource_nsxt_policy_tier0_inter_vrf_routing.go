@@ -146,10 +146,10 @@ func resourceNsxtPolicyTier0InterVRFRouting() *schema.Resource {
 	}
 }
 
-func getPolicyInterVRFRoutingFromSchema(d *schema.ResourceData) model.PolicyInterVrfRoutingConfig {
+func getPolicyInterVRFRoutingFromSchema(d *schema.ResourceData, m interface{}) model.PolicyInterVrfRoutingConfig {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	var bgpRouteLeaking []model.BgpRouteLeaking
 	bpgRouteLeakingList := d.Get("bgp_route_leaking")
 	if bpgRouteLeakingList != nil {
@@ -255,7 +255,7 @@ func resourceNsxtPolicyTier0InterVRFRoutingCreate(d *schema.ResourceData, m inte
 		}
 	}
 
-	obj := getPolicyInterVRFRoutingFromSchema(d)
+	obj := getPolicyInterVRFRoutingFromSchema(d, m)
 	err := client.Patch(gwID, id, obj)
 	if err != nil {
 		return handleCreateError("InterVRFRouting", *obj.DisplayName, err)
@@ -291,7 +291,7 @@ func resourceNsxtPolicyTier0InterVRFRoutingRead(d *schema.ResourceData, m interf
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
 	d.Set("revision", obj.Revision)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 
 	var brlList []interface{}
 	for _, brl := range obj.BgpRouteLeaking {
@@ -344,7 +344,7 @@ func resourceNsxtPolicyTier0InterVRFRoutingUpdate(d *schema.ResourceData, m inte
 
 	id := d.Get("nsx_id").(string)
 
-	obj := getPolicyInterVRFRoutingFromSchema(d)
+	obj := getPolicyInterVRFRoutingFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	obj.Revision = &revision
 
@@ -55,6 +55,15 @@ var t1TypeValues = []string{
 	model.Tier1_TYPE_NATTED,
 }
 
+var (
+	policyTier1VersionDependentGate = newAttributeVersionGate("3.0.0", map[string][]string{
+		"nsxt_policy_tier1_gateway": {"ingress_qos_profile_path", "egress_qos_profile_path", "pool_allocation"},
+	})
+	policyTier1HaModeGate = newAttributeVersionGate("3.2.0", map[string][]string{
+		"nsxt_policy_tier1_gateway": {"ha_mode"},
+	})
+)
+
 func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyTier1GatewayCreate,
@@ -319,7 +328,7 @@ func resourceNsxtPolicyTier1GatewaySetQos(d *schema.ResourceData, obj *model.Tie
 }
 
 func resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d *schema.ResourceData, obj *model.Tier1) {
-	if util.NsxVersionLower("3.0.0") {
+	if !policyTier1VersionDependentGate.isSupported() {
 		return
 	}
 
@@ -361,14 +370,14 @@ func initSingleTier1GatewayLocaleService(context utl.SessionContext, d *schema.R
 	return initChildLocaleService(serviceStruct, false)
 }
 
-func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string) (model.Infra, error) {
+func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string, m interface{}) (model.Infra, error) {
 	var infraChildren, gwChildren []*data.StructValue
 	var infraStruct model.Infra
 	converter := bindings.NewTypeConverter()
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	failoverMode := d.Get("failover_mode").(string)
 	defaultRuleLogging := d.Get("default_rule_logging").(bool)
 	disableFirewall := !d.Get("enable_firewall").(bool)
@@ -405,7 +414,7 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		ResourceType:            &t1Type,
 	}
 
-	if util.NsxVersionHigherOrEqual("3.2.0") {
+	if policyTier1HaModeGate.isSupported() {
 		if haMode != "NONE" && haMode != "" {
 			obj.HaMode = &haMode
 		}
@@ -502,7 +511,7 @@ func resourceNsxtPolicyTier1GatewayCreate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
-	obj, err := policyTier1GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
+	obj, err := policyTier1GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id, m)
 
 	if err != nil {
 		return err
@@ -539,7 +548,7 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("failover_mode", obj.FailoverMode)
@@ -547,7 +556,7 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 	d.Set("enable_firewall", !(*obj.DisableFirewall))
 	d.Set("enable_standby_relocation", obj.EnableStandbyRelocation)
 	d.Set("force_whitelisting", obj.ForceWhitelisting)
-	if util.NsxVersionHigherOrEqual("3.2.0") {
+	if policyTier1HaModeGate.isSupported() {
 		if obj.HaMode == nil {
 			d.Set("ha_mode", "NONE")
 		} else {
@@ -652,7 +661,7 @@ func resourceNsxtPolicyTier1GatewayUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error obtaining Tier1 id")
 	}
 
-	obj, err := policyTier1GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
+	obj, err := policyTier1GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id, m)
 	if err != nil {
 		return err
 	}
@@ -122,7 +122,7 @@ func policyTransportZonePatch(siteID, epID, tzID string, d *schema.ResourceData,
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	isDefault := d.Get("is_default").(bool)
 	transportType := d.Get("transport_type").(string)
 	uplinkTeamingNames := getStringListFromSchemaList(d, "uplink_teaming_policy_names")
@@ -225,7 +225,7 @@ func resourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{})
 	d.Set("enforcement_point", epID)
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
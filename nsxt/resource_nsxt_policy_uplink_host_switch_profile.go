@@ -327,7 +327,7 @@ func resourceNsxtUplinkHostSwitchProfileRead(d *schema.ResourceData, m interface
 	uplinkHostSwitchProfile := uplinkInterface.(model.PolicyUplinkHostSwitchProfile)
 	d.Set("display_name", uplinkHostSwitchProfile.DisplayName)
 	d.Set("description", uplinkHostSwitchProfile.Description)
-	setPolicyTagsInSchema(d, uplinkHostSwitchProfile.Tags)
+	setPolicyTagsInSchema(d, uplinkHostSwitchProfile.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", uplinkHostSwitchProfile.Path)
 	d.Set("revision", uplinkHostSwitchProfile.Revision)
@@ -352,10 +352,10 @@ func uplinkSchemaToModelList(schema interface{}) []model.Uplink {
 	return uplinkList
 }
 
-func uplinkHostSwitchProfileSchemaToModel(d *schema.ResourceData) model.PolicyUplinkHostSwitchProfile {
+func uplinkHostSwitchProfileSchemaToModel(d *schema.ResourceData, m interface{}) model.PolicyUplinkHostSwitchProfile {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	var lags []model.Lag
 	lagsList := d.Get("lag").([]interface{})
@@ -436,7 +436,7 @@ func resourceNsxtUplinkHostSwitchProfileCreate(d *schema.ResourceData, m interfa
 	client := infra.NewHostSwitchProfilesClient(connector)
 	converter := bindings.NewTypeConverter()
 
-	uplinkHostSwitchProfile := uplinkHostSwitchProfileSchemaToModel(d)
+	uplinkHostSwitchProfile := uplinkHostSwitchProfileSchemaToModel(d, m)
 	profileValue, errs := converter.ConvertToVapi(uplinkHostSwitchProfile, model.PolicyUplinkHostSwitchProfileBindingType())
 	if errs != nil {
 		return errs[0]
@@ -463,7 +463,7 @@ func resourceNsxtUplinkHostSwitchProfileUpdate(d *schema.ResourceData, m interfa
 	client := infra.NewHostSwitchProfilesClient(connector)
 	converter := bindings.NewTypeConverter()
 
-	uplinkHostSwitchProfile := uplinkHostSwitchProfileSchemaToModel(d)
+	uplinkHostSwitchProfile := uplinkHostSwitchProfileSchemaToModel(d, m)
 	revision := int64(d.Get("revision").(int))
 	uplinkHostSwitchProfile.Revision = &revision
 	profileValue, errs := converter.ConvertToVapi(uplinkHostSwitchProfile, model.PolicyUplinkHostSwitchProfileBindingType())
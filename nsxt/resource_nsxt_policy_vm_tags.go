@@ -383,7 +383,7 @@ func resourceNsxtPolicyVMTagsRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 
-	setPolicyTagsInSchema(d, vm.Tags)
+	setPolicyTagsInSchema(d, vm.Tags, m)
 
 	if d.Get("instance_id") == "" {
 		// for import
@@ -410,7 +410,7 @@ func resourceNsxtPolicyVMTagsCreate(d *schema.ResourceData, m interface{}) error
 		return nil
 	}
 
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	if tags == nil {
 		tags = make([]model.Tag, 0)
 	}
@@ -67,7 +67,7 @@ func policyVniPoolPatch(id string, d *schema.ResourceData, m interface{}) error
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	start := int64(d.Get("start").(int))
 	end := int64(d.Get("end").(int))
 
@@ -119,7 +119,7 @@ func resourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) error
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 
 	d.Set("start", obj.Start)
 	d.Set("end", obj.End)
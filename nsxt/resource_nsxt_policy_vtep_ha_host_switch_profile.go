@@ -88,10 +88,10 @@ func resourceNsxtVtepHAHostSwitchProfileExists(id string, connector client.Conne
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func vtepHAHostSwitchProfileSchemaToModel(d *schema.ResourceData) model.PolicyVtepHAHostSwitchProfile {
+func vtepHAHostSwitchProfileSchemaToModel(d *schema.ResourceData, m interface{}) model.PolicyVtepHAHostSwitchProfile {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	autoRecovery := d.Get("auto_recovery").(bool)
 	autoRecoveryInitialWait := int64(d.Get("auto_recovery_initial_wait").(int))
@@ -124,7 +124,7 @@ func resourceNsxtVtepHAHostSwitchProfileCreate(d *schema.ResourceData, m interfa
 	client := infra.NewHostSwitchProfilesClient(connector)
 	converter := bindings.NewTypeConverter()
 
-	vtepHAHostSwitchProfile := vtepHAHostSwitchProfileSchemaToModel(d)
+	vtepHAHostSwitchProfile := vtepHAHostSwitchProfileSchemaToModel(d, m)
 	profileValue, errs := converter.ConvertToVapi(vtepHAHostSwitchProfile, model.PolicyVtepHAHostSwitchProfileBindingType())
 	if errs != nil {
 		return errs[0]
@@ -173,7 +173,7 @@ func resourceNsxtVtepHAHostSwitchProfileRead(d *schema.ResourceData, m interface
 	vtepHAHostSwitchProfile := vtepHAInterface.(model.PolicyVtepHAHostSwitchProfile)
 	d.Set("display_name", vtepHAHostSwitchProfile.DisplayName)
 	d.Set("description", vtepHAHostSwitchProfile.Description)
-	setPolicyTagsInSchema(d, vtepHAHostSwitchProfile.Tags)
+	setPolicyTagsInSchema(d, vtepHAHostSwitchProfile.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", vtepHAHostSwitchProfile.Path)
 	d.Set("revision", vtepHAHostSwitchProfile.Revision)
@@ -198,7 +198,7 @@ func resourceNsxtVtepHAHostSwitchProfileUpdate(d *schema.ResourceData, m interfa
 	client := infra.NewHostSwitchProfilesClient(connector)
 	converter := bindings.NewTypeConverter()
 
-	vtepHAHostSwitchProfile := vtepHAHostSwitchProfileSchemaToModel(d)
+	vtepHAHostSwitchProfile := vtepHAHostSwitchProfileSchemaToModel(d, m)
 	revision := int64(d.Get("revision").(int))
 	vtepHAHostSwitchProfile.Revision = &revision
 	profileValue, errs := converter.ConvertToVapi(vtepHAHostSwitchProfile, model.PolicyVtepHAHostSwitchProfileBindingType())
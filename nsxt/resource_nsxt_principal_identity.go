@@ -117,7 +117,7 @@ func resourceNsxtPrincipalIdentityCreate(d *schema.ResourceData, m interface{})
 	connector := getPolicyConnector(m)
 	client := principal_identities.NewWithCertificateClient(connector)
 
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	isProtected := d.Get("is_protected").(bool)
 	name := d.Get("name").(string)
 	nodeID := d.Get("node_id").(string)
@@ -154,7 +154,7 @@ func resourceNsxtPrincipalIdentityRead(d *schema.ResourceData, m interface{}) er
 		return handleReadError(d, "PrincipalIdentity", id, err)
 	}
 
-	setMPTagsInSchema(d, piObj.Tags)
+	setMPTagsInSchema(d, piObj.Tags, m)
 	d.Set("is_protected", piObj.IsProtected)
 	d.Set("name", piObj.Name)
 	d.Set("node_id", piObj.NodeId)
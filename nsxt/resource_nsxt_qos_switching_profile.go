@@ -181,7 +181,7 @@ func resourceNsxtQosSwitchingProfileCreate(d *schema.ResourceData, m interface{}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	classOfService := int32(d.Get("class_of_service").(int))
 	dscpTrusted := "UNTRUSTED"
 	if d.Get("dscp_trusted").(bool) {
@@ -247,7 +247,7 @@ func resourceNsxtQosSwitchingProfileRead(d *schema.ResourceData, m interface{})
 	d.Set("revision", qosSwitchingProfile.Revision)
 	d.Set("description", qosSwitchingProfile.Description)
 	d.Set("display_name", qosSwitchingProfile.DisplayName)
-	setTagsInSchema(d, qosSwitchingProfile.Tags)
+	setTagsInSchema(d, qosSwitchingProfile.Tags, m)
 	d.Set("class_of_service", qosSwitchingProfile.ClassOfService)
 	if qosSwitchingProfile.Dscp.Mode == "TRUSTED" {
 		d.Set("dscp_trusted", true)
@@ -275,7 +275,7 @@ func resourceNsxtQosSwitchingProfileUpdate(d *schema.ResourceData, m interface{}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	classOfService := int32(d.Get("class_of_service").(int))
 	dscpTrusted := "UNTRUSTED"
@@ -54,7 +54,7 @@ func resourceNsxtSpoofGuardSwitchingProfileCreate(d *schema.ResourceData, m inte
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	whiteListProviders := []string{}
 	if d.Get("address_binding_whitelist_enabled").(bool) {
 		whiteListProviders = append(whiteListProviders, "LPORT_BINDINGS")
@@ -105,7 +105,7 @@ func resourceNsxtSpoofGuardSwitchingProfileRead(d *schema.ResourceData, m interf
 	d.Set("revision", sgSwitchingProfile.Revision)
 	d.Set("description", sgSwitchingProfile.Description)
 	d.Set("display_name", sgSwitchingProfile.DisplayName)
-	setTagsInSchema(d, sgSwitchingProfile.Tags)
+	setTagsInSchema(d, sgSwitchingProfile.Tags, m)
 	if len(sgSwitchingProfile.WhiteListProviders) == 1 && sgSwitchingProfile.WhiteListProviders[0] == "LPORT_BINDINGS" {
 		d.Set("address_binding_whitelist_enabled", true)
 	} else {
@@ -128,7 +128,7 @@ func resourceNsxtSpoofGuardSwitchingProfileUpdate(d *schema.ResourceData, m inte
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	whiteListProviders := []string{}
 	if d.Get("address_binding_whitelist_enabled").(bool) {
@@ -147,7 +147,7 @@ func resourceNsxtStaticRouteCreate(d *schema.ResourceData, m interface{}) error
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	network := d.Get("network").(string)
 	nextHops := getNextHopsFromSchema(d)
 	staticRoute := manager.StaticRoute{
@@ -202,7 +202,7 @@ func resourceNsxtStaticRouteRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("revision", staticRoute.Revision)
 	d.Set("description", staticRoute.Description)
 	d.Set("display_name", staticRoute.DisplayName)
-	setTagsInSchema(d, staticRoute.Tags)
+	setTagsInSchema(d, staticRoute.Tags, m)
 	d.Set("logical_router_id", staticRoute.LogicalRouterId)
 	d.Set("network", staticRoute.Network)
 	err = setNextHopsInSchema(d, staticRoute.NextHops)
@@ -232,7 +232,7 @@ func resourceNsxtStaticRouteUpdate(d *schema.ResourceData, m interface{}) error
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	network := d.Get("network").(string)
 	nextHops := getNextHopsFromSchema(d)
 	staticRoute := manager.StaticRoute{
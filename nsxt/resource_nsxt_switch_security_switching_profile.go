@@ -175,7 +175,7 @@ func resourceNsxtSwitchSecuritySwitchingProfileCreate(d *schema.ResourceData, m
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	blockNonIP := d.Get("block_non_ip").(bool)
 	blockClientDHCP := d.Get("block_client_dhcp").(bool)
 	blockServerDHCP := d.Get("block_server_dhcp").(bool)
@@ -237,7 +237,7 @@ func resourceNsxtSwitchSecuritySwitchingProfileRead(d *schema.ResourceData, m in
 	d.Set("revision", switchSecurityProfile.Revision)
 	d.Set("description", switchSecurityProfile.Description)
 	d.Set("display_name", switchSecurityProfile.DisplayName)
-	setTagsInSchema(d, switchSecurityProfile.Tags)
+	setTagsInSchema(d, switchSecurityProfile.Tags, m)
 	d.Set("block_non_ip", switchSecurityProfile.BlockNonIpTraffic)
 	if switchSecurityProfile.DhcpFilter != nil {
 		d.Set("block_client_dhcp", switchSecurityProfile.DhcpFilter.ClientBlockEnabled)
@@ -265,7 +265,7 @@ func resourceNsxtSwitchSecuritySwitchingProfileUpdate(d *schema.ResourceData, m
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	blockNonIP := d.Get("block_non_ip").(bool)
 	blockClientDHCP := d.Get("block_client_dhcp").(bool)
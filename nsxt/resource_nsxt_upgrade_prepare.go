@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
@@ -27,13 +29,19 @@ var precheckComponentTypes = []string{"EDGE", "HOST", "MP"}
 const bundleUploadTimeout int = 3600
 const ucUpgradeTimeout int = 3600
 const precheckTimeout int = 3600
+const upgradePrepareTimeout = 3 * time.Hour
 
 func resourceNsxtUpgradePrepare() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtUpgradePrepareCreate,
-		Read:   resourceNsxtUpgradePrepareRead,
-		Update: resourceNsxtUpgradePrepareUpdate,
-		Delete: resourceNsxtUpgradePrepareDelete,
+		CreateContext: resourceNsxtUpgradePrepareCreate,
+		ReadContext:   resourceNsxtUpgradePrepareRead,
+		UpdateContext: resourceNsxtUpgradePrepareUpdate,
+		DeleteContext: resourceNsxtUpgradePrepareDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(upgradePrepareTimeout),
+			Read:   schema.DefaultTimeout(upgradePrepareTimeout),
+			Update: schema.DefaultTimeout(upgradePrepareTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"version": {
@@ -128,40 +136,40 @@ func resourceNsxtUpgradePrepare() *schema.Resource {
 	}
 }
 
-func resourceNsxtUpgradePrepareCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUpgradePrepareCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
 		id = newUUID()
 	}
-	err := prepareForUpgrade(d, m)
+	err := prepareForUpgrade(ctx, d, m, time.Now().Add(d.Timeout(schema.TimeoutCreate)))
 	if err != nil {
-		return handleCreateError("NsxtUpgradePrepare", id, err)
+		return handleContextError(ctx, "Upgrade preparation", handleCreateError("NsxtUpgradePrepare", id, err))
 	}
 	d.SetId(id)
-	return resourceNsxtUpgradePrepareRead(d, m)
+	return resourceNsxtUpgradePrepareRead(ctx, d, m)
 }
 
-func prepareForUpgrade(d *schema.ResourceData, m interface{}) error {
+func prepareForUpgrade(ctx context.Context, d *schema.ResourceData, m interface{}, deadline time.Time) error {
 	// 1. Upload upgrade bundle and wait for upload to complete
-	err := uploadPrecheckAndUpgradeBundle(d, m)
+	err := uploadPrecheckAndUpgradeBundle(ctx, d, m, deadline)
 	if err != nil {
 		return logAPIError("Failed to upload bundle", err)
 	}
 	// 2. Accept eula
-	err = acceptUserAgreement(d, m)
+	err = acceptUserAgreement(ctx, d, m)
 	if err != nil {
 		return err
 	}
 	// 3. Upgrade UC and check for its upgrade status
-	err = upgradeUc(d, m)
+	err = upgradeUc(ctx, d, m, deadline)
 	if err != nil {
 		return logAPIError("Failed to upgrade Upgrade Coordinator", err)
 	}
 	return nil
 }
 
-func getSummaryInfo(m interface{}) (string, bool, error) {
-	connector := getPolicyConnector(m)
+func getSummaryInfo(ctx context.Context, m interface{}) (string, bool, error) {
+	connector := getContextPolicyConnector(ctx, m)
 	summaryClient := upgrade.NewSummaryClient(connector)
 	summary, err := summaryClient.Get()
 	if err != nil {
@@ -182,54 +190,54 @@ func getSummaryInfo(m interface{}) (string, bool, error) {
 	return targetVersion, true, nil
 }
 
-func resourceNsxtUpgradePrepareRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUpgradePrepareRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	var err error
 	// Execute precheck in Read function if upload bundle has been uploaded and upgrade not started
-	targetVersion, precheckNeeded, err := getSummaryInfo(m)
+	targetVersion, precheckNeeded, err := getSummaryInfo(ctx, m)
 	if err != nil {
-		return logAPIError("Failed to get previous precheck result", err)
+		return handleContextError(ctx, "Upgrade precheck", logAPIError("Failed to get previous precheck result", err))
 	}
 	d.Set("target_version", targetVersion)
 	if precheckNeeded {
 		previousAcknowledgedPrecheckIDs, err := getAcknowledgedPrecheckIDs(m)
 		if err != nil {
-			return logAPIError("Failed to get previous precheck result", err)
+			return diag.FromErr(logAPIError("Failed to get previous precheck result", err))
 		}
-		err = executePreupgradeChecks(d, m)
+		err = executePreupgradeChecks(ctx, d, m, time.Now().Add(d.Timeout(schema.TimeoutRead)))
 		if err != nil {
-			return logAPIError("Failed to execute pre-upgrade checks", err)
+			return handleContextError(ctx, "Upgrade precheck", logAPIError("Failed to execute pre-upgrade checks", err))
 		}
 		err = acknowledgePrecheckWarnings(m, previousAcknowledgedPrecheckIDs)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	precheckFailures, err := getPrecheckErrors(m, nil)
 	if err != nil {
-		return handleReadError(d, "NsxtUpgradePrepare", id, err)
+		return diag.FromErr(handleReadError(d, "NsxtUpgradePrepare", id, err))
 	}
 	err = setFailedPrechecksInSchema(d, precheckFailures)
 	if err != nil {
-		return handleReadError(d, "NsxtUpgradePrepare", id, err)
+		return diag.FromErr(handleReadError(d, "NsxtUpgradePrepare", id, err))
 	}
 	return nil
 }
 
-func resourceNsxtUpgradePrepareUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUpgradePrepareUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := prepareForUpgrade(d, m)
+	err := prepareForUpgrade(ctx, d, m, time.Now().Add(d.Timeout(schema.TimeoutUpdate)))
 	if err != nil {
-		return handleUpdateError("NsxtUpgradePrepare", id, err)
+		return handleContextError(ctx, "Upgrade preparation", handleUpdateError("NsxtUpgradePrepare", id, err))
 	}
-	return resourceNsxtUpgradePrepareRead(d, m)
+	return resourceNsxtUpgradePrepareRead(ctx, d, m)
 }
 
-func resourceNsxtUpgradePrepareDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUpgradePrepareDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func uploadPrecheckAndUpgradeBundle(d *schema.ResourceData, m interface{}) error {
+func uploadPrecheckAndUpgradeBundle(ctx context.Context, d *schema.ResourceData, m interface{}, deadline time.Time) error {
 	upgradeBundleType := nsxModel.UpgradeBundleFetchRequest_BUNDLE_TYPE_UPGRADE
 	precheckBundleType := nsxModel.UpgradeBundleFetchRequest_BUNDLE_TYPE_PRE_UPGRADE
 	precheckBundleURL := d.Get("precheck_bundle_url").(string)
@@ -237,12 +245,12 @@ func uploadPrecheckAndUpgradeBundle(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Precheck bundle is only supported and is required for NSXT version >= 4.1.1")
 	}
 	if len(precheckBundleURL) > 0 {
-		err := uploadUpgradeBundle(d, m, precheckBundleType)
+		err := uploadUpgradeBundle(ctx, d, m, precheckBundleType, deadline)
 		if err != nil {
 			return fmt.Errorf("Failed to upload precheck bundle: %s", err)
 		}
 	}
-	err := uploadUpgradeBundle(d, m, upgradeBundleType)
+	err := uploadUpgradeBundle(ctx, d, m, upgradeBundleType, deadline)
 	if err != nil {
 		return fmt.Errorf("Failed to upload upgrade bundle: %s", err)
 	}
@@ -259,7 +267,7 @@ func precheckBundleCompatibilityCheck(precheckBundleURL string) bool {
 	return true
 }
 
-func uploadUpgradeBundle(d *schema.ResourceData, m interface{}, bundleType string) error {
+func uploadUpgradeBundle(ctx context.Context, d *schema.ResourceData, m interface{}, bundleType string, deadline time.Time) error {
 	upgradeBundleURL := d.Get("upgrade_bundle_url").(string)
 	precheckBundleURL := d.Get("precheck_bundle_url").(string)
 	var url string
@@ -267,7 +275,7 @@ func uploadUpgradeBundle(d *schema.ResourceData, m interface{}, bundleType strin
 	c := m.(nsxtClients)
 	userName := c.NsxtClientConfig.UserName
 	password := c.NsxtClientConfig.Password
-	connector := getPolicyConnector(m)
+	connector := getContextPolicyConnector(ctx, m)
 	summaryClient := upgrade.NewSummaryClient(connector)
 	summary, err := summaryClient.Get()
 	if err != nil {
@@ -310,11 +318,11 @@ func uploadUpgradeBundle(d *schema.ResourceData, m interface{}, bundleType strin
 	if err != nil {
 		return fmt.Errorf("Failed to upload upgrade bundle of type %s: %v", bundleType, err)
 	}
-	return waitForBundleUpload(m, *bundleID.BundleId, timeout)
+	return waitForBundleUpload(ctx, m, *bundleID.BundleId, getWaitTimeout(deadline, timeout))
 }
 
-func acceptUserAgreement(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func acceptUserAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	connector := getContextPolicyConnector(ctx, m)
 	acceptUserAgreement := d.Get("accept_user_agreement").(bool)
 	if !acceptUserAgreement {
 		return fmt.Errorf("To proceed with upgrade, you must accept user agreement")
@@ -327,8 +335,8 @@ func acceptUserAgreement(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func upgradeUc(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func upgradeUc(ctx context.Context, d *schema.ResourceData, m interface{}, deadline time.Time) error {
+	connector := getContextPolicyConnector(ctx, m)
 	summaryClient := upgrade.NewSummaryClient(connector)
 	summary, err := summaryClient.Get()
 	if err != nil {
@@ -344,11 +352,11 @@ func upgradeUc(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 	timeout := d.Get("uc_upgrade_timeout").(int)
-	return waitForUcUpgrade(m, timeout)
+	return waitForUcUpgrade(ctx, m, getWaitTimeout(deadline, timeout))
 }
 
-func executePreupgradeChecks(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+func executePreupgradeChecks(ctx context.Context, d *schema.ResourceData, m interface{}, deadline time.Time) error {
+	connector := getContextPolicyConnector(ctx, m)
 	client := nsx.NewUpgradeClient(connector)
 	err := client.Executepreupgradechecks(nil, nil, nil, nil, nil, nil)
 	if err != nil {
@@ -357,7 +365,7 @@ func executePreupgradeChecks(d *schema.ResourceData, m interface{}) error {
 	timeout := d.Get("precheck_timeout").(int)
 	for _, componentType := range precheckComponentTypes {
 		log.Printf("Execute pre-upgrade check on %s", componentType)
-		err = waitForPrecheckComplete(m, componentType, timeout)
+		err = waitForPrecheckComplete(ctx, m, componentType, getWaitTimeout(deadline, timeout))
 		if err != nil {
 			return err
 		}
@@ -391,8 +399,8 @@ func setFailedPrechecksInSchema(d *schema.ResourceData, precheckErrors []nsxMode
 	return d.Set("failed_prechecks", failedPrechecksList)
 }
 
-func waitForBundleUpload(m interface{}, bundleID string, timeout int) error {
-	connector := getPolicyConnector(m)
+func waitForBundleUpload(ctx context.Context, m interface{}, bundleID string, timeout time.Duration) error {
+	connector := getContextPolicyConnector(ctx, m)
 	client := bundles.NewUploadStatusClient(connector)
 	pendingStates := []string{
 		nsxModel.UpgradeBundleUploadStatus_STATUS_UPLOADING,
@@ -416,19 +424,19 @@ func waitForBundleUpload(m interface{}, bundleID string, timeout int) error {
 
 			return state, *state.Status, nil
 		},
-		Timeout:    time.Duration(timeout) * time.Second,
+		Timeout:    timeout,
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Failed to upload bundle %s: %s", bundleID, err)
 	}
 	return nil
 }
 
-func waitForUcUpgrade(m interface{}, timeout int) error {
-	connector := getPolicyConnector(m)
+func waitForUcUpgrade(ctx context.Context, m interface{}, timeout time.Duration) error {
+	connector := getContextPolicyConnector(ctx, m)
 	client := upgrade.NewUcUpgradeStatusClient(connector)
 	pendingStates := []string{
 		nsxModel.UcUpgradeStatus_STATE_NOT_STARTED,
@@ -456,19 +464,19 @@ func waitForUcUpgrade(m interface{}, timeout int) error {
 			}
 			return state, nsxModel.UcUpgradeStatus_STATE_IN_PROGRESS, nil
 		},
-		Timeout:    time.Duration(timeout) * time.Second,
+		Timeout:    timeout,
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Failed to upgrade UC: %s", err)
 	}
 	return nil
 }
 
-func waitForPrecheckComplete(m interface{}, componentType string, timeout int) error {
-	connector := getPolicyConnector(m)
+func waitForPrecheckComplete(ctx context.Context, m interface{}, componentType string, timeout time.Duration) error {
+	connector := getContextPolicyConnector(ctx, m)
 	client := upgrade.NewStatusSummaryClient(connector)
 	pendingStates := []string{
 		nsxModel.UpgradeChecksExecutionStatus_STATUS_NOT_STARTED,
@@ -493,11 +501,11 @@ func waitForPrecheckComplete(m interface{}, componentType string, timeout int) e
 			}
 			return state, *componentStatus[0].PreUpgradeStatus.Status, nil
 		},
-		Timeout:    time.Duration(timeout) * time.Second,
+		Timeout:    timeout,
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Encounter error while running precheck on component type %s: %s", componentType, err)
 	}
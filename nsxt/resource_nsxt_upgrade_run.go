@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -43,6 +45,8 @@ var (
 	defaultUpgradeStatusCheckInterval = 30
 	defaultUpgradeStatusCheckTimeout  = 3600
 	defaultUpgradeStatusCheckDelay    = 30
+	// Default time for the whole upgrade run
+	defaultUpgradeRunTimeout = 24 * time.Hour
 )
 
 var staticComponentUpgradeStatus = []string{
@@ -67,6 +71,8 @@ type upgradeClientSet struct {
 	Timeout  int
 	Delay    int
 	Interval int
+	// Deadline for the whole create or update operation
+	Deadline time.Time
 }
 
 func newUpgradeClientSet(connector client.Connector, d *schema.ResourceData) *upgradeClientSet {
@@ -86,10 +92,14 @@ func newUpgradeClientSet(connector client.Connector, d *schema.ResourceData) *up
 
 func resourceNsxtUpgradeRun() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtUpgradeRunCreate,
-		Read:   resourceNsxtUpgradeRunRead,
-		Update: resourceNsxtUpgradeRunUpdate,
-		Delete: resourceNsxtUpgradeRunDelete,
+		CreateContext: resourceNsxtUpgradeRunCreate,
+		ReadContext:   resourceNsxtUpgradeRunRead,
+		UpdateContext: resourceNsxtUpgradeRunUpdate,
+		DeleteContext: resourceNsxtUpgradeRunDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultUpgradeRunTimeout),
+			Update: schema.DefaultTimeout(defaultUpgradeRunTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"upgrade_prepare_ready_id": {
@@ -327,38 +337,39 @@ func getUpgradeSettingSchema(isEdge bool) *schema.Schema {
 	}
 }
 
-func resourceNsxtUpgradeRunCreate(d *schema.ResourceData, m interface{}) error {
-	return upgradeRunCreateOrUpdate(d, m)
+func resourceNsxtUpgradeRunCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return upgradeRunCreateOrUpdate(ctx, d, m, d.Timeout(schema.TimeoutCreate))
 }
 
-func upgradeRunCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+func upgradeRunCreateOrUpdate(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
 		id = newUUID()
 	}
-	connector := getPolicyConnectorWithHeaders(m, nil, false, false)
+	connector := newContextConnector(ctx, getPolicyConnectorWithHeaders(m, nil, false, false))
 	upgradeClientSet := newUpgradeClientSet(connector, d)
+	upgradeClientSet.Deadline = time.Now().Add(timeout)
 
 	log.Printf("[INFO] Updating UpgradeUnitGroup and UpgradePlanSetting.")
-	err := prepareUpgrade(upgradeClientSet, d)
+	err := prepareUpgrade(ctx, upgradeClientSet, d)
 	if err != nil {
-		return handleCreateError("NsxtUpgradeRun", id, err)
+		return handleContextError(ctx, "Upgrade run", handleCreateError("NsxtUpgradeRun", id, err))
 	}
 
 	log.Printf("[INFO] Successfully update UpgradeUnitGroup and UpgradePlanSetting. Start Upgrade.")
 
-	err = runUpgrade(upgradeClientSet, getPartialUpgradeMap(d))
+	err = runUpgrade(ctx, upgradeClientSet, getPartialUpgradeMap(d))
 	if err != nil {
-		return handleCreateError("NsxtUpgradeRun", id, err)
+		return handleContextError(ctx, "Upgrade run", handleCreateError("NsxtUpgradeRun", id, err))
 	}
 
 	runPostcheck(upgradeClientSet.UpgradeClient, d)
 
 	d.SetId(id)
-	return resourceNsxtUpgradeRunRead(d, m)
+	return resourceNsxtUpgradeRunRead(ctx, d, m)
 }
 
-func prepareUpgrade(upgradeClientSet *upgradeClientSet, d *schema.ResourceData) error {
+func prepareUpgrade(ctx context.Context, upgradeClientSet *upgradeClientSet, d *schema.ResourceData) error {
 	for i := range upgradeComponentList {
 		component := upgradeComponentList[i]
 		// Customize MP upgrade is not allowed
@@ -384,7 +395,7 @@ func prepareUpgrade(upgradeClientSet *upgradeClientSet, d *schema.ResourceData)
 		if status.Status == model.ComponentUpgradeStatus_STATUS_IN_PROGRESS {
 			upgradeClientSet.PlanClient.Pause()
 		}
-		err = waitUpgradeForStatus(upgradeClientSet, &component, inFlightComponentUpgradeStatus, staticComponentUpgradeStatus)
+		err = waitUpgradeForStatus(ctx, upgradeClientSet, &component, inFlightComponentUpgradeStatus, staticComponentUpgradeStatus)
 		if err != nil {
 			return err
 		}
@@ -457,7 +468,7 @@ func getUpgradeStatus(statusClient upgrade.StatusSummaryClient, component *strin
 }
 
 // Wait component upgrade status to become target status. Using nil component for overall upgrade status.
-func waitUpgradeForStatus(upgradeClientSet *upgradeClientSet, component *string, pending, target []string) error {
+func waitUpgradeForStatus(ctx context.Context, upgradeClientSet *upgradeClientSet, component *string, pending, target []string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
@@ -474,11 +485,11 @@ func waitUpgradeForStatus(upgradeClientSet *upgradeClientSet, component *string,
 			log.Printf("[DEBUG] Current upgrade status: %s", status.Status)
 			return status, status.Status, nil
 		},
-		Timeout:      time.Duration(upgradeClientSet.Timeout) * time.Second,
+		Timeout:      getWaitTimeout(upgradeClientSet.Deadline, upgradeClientSet.Timeout),
 		PollInterval: time.Duration(upgradeClientSet.Interval) * time.Second,
 		Delay:        time.Duration(upgradeClientSet.Delay) * time.Second,
 	}
-	statusI, err := stateConf.WaitForState()
+	statusI, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		statusDetail := ""
 		if statusI != nil {
@@ -592,14 +603,14 @@ func updateComponentUpgradePlanSetting(settingClient plan.SettingsClient, d *sch
 	return err
 }
 
-func runUpgrade(upgradeClientSet *upgradeClientSet, partialUpgradeMap map[string]bool) error {
+func runUpgrade(ctx context.Context, upgradeClientSet *upgradeClientSet, partialUpgradeMap map[string]bool) error {
 	partialUpgradeExist := false
 	for i := range upgradeComponentList {
 		// After one component upgrade is completed, although the status of our next component is NOT_STARTED,
 		// there is a period that overall status is still IN_PROGRESS, which will prevent us to start the upgrade of next component.
 		// Wait here for the overall status become stable. Because there is potential upgrade triggered before, we wait here also
 		// for the first component for safety.
-		err := waitUpgradeForStatus(upgradeClientSet, nil, inFlightComponentUpgradeStatus, staticComponentUpgradeStatus)
+		err := waitUpgradeForStatus(ctx, upgradeClientSet, nil, inFlightComponentUpgradeStatus, staticComponentUpgradeStatus)
 		if err != nil {
 			return err
 		}
@@ -621,7 +632,7 @@ func runUpgrade(upgradeClientSet *upgradeClientSet, partialUpgradeMap map[string
 			completeLog = fmt.Sprintf("[INFO] %s upgrade is partially completed.", component)
 		}
 		upgradeClientSet.PlanClient.Upgrade(&component)
-		err = waitUpgradeForStatus(upgradeClientSet, &component, pendingStatus, targetStatus)
+		err = waitUpgradeForStatus(ctx, upgradeClientSet, &component, pendingStatus, targetStatus)
 		if err != nil {
 			return err
 		}
@@ -704,21 +715,21 @@ func setUpgradeRunOutput(upgradeClientSet *upgradeClientSet, d *schema.ResourceD
 	return nil
 }
 
-func resourceNsxtUpgradeRunRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUpgradeRunRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
-	connector := getPolicyConnector(m)
+	connector := getContextPolicyConnector(ctx, m)
 	upgradeClientSet := newUpgradeClientSet(connector, d)
 	err := setUpgradeRunOutput(upgradeClientSet, d)
 	if err != nil {
-		return handleReadError(d, "NsxtUpgradeRun", id, err)
+		return handleContextError(ctx, "Upgrade run read", handleReadError(d, "NsxtUpgradeRun", id, err))
 	}
 	return nil
 }
 
-func resourceNsxtUpgradeRunUpdate(d *schema.ResourceData, m interface{}) error {
-	return upgradeRunCreateOrUpdate(d, m)
+func resourceNsxtUpgradeRunUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return upgradeRunCreateOrUpdate(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
 }
 
-func resourceNsxtUpgradeRunDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUpgradeRunDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
@@ -73,7 +73,7 @@ func resourceNsxtVlanLogicalSwitchCreate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	addressBindings := getAddressBindingsFromSchema(d)
 	adminState := d.Get("admin_state").(string)
 	ipPoolID := d.Get("ip_pool_id").(string)
@@ -141,7 +141,7 @@ func resourceNsxtVlanLogicalSwitchRead(d *schema.ResourceData, m interface{}) er
 	d.Set("revision", logicalSwitch.Revision)
 	d.Set("description", logicalSwitch.Description)
 	d.Set("display_name", logicalSwitch.DisplayName)
-	setTagsInSchema(d, logicalSwitch.Tags)
+	setTagsInSchema(d, logicalSwitch.Tags, m)
 	err = setAddressBindingsInSchema(d, logicalSwitch.AddressBindings)
 	if err != nil {
 		return fmt.Errorf("Error during logical switch address bindings set in schema: %v", err)
@@ -172,7 +172,7 @@ func resourceNsxtVlanLogicalSwitchUpdate(d *schema.ResourceData, m interface{})
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	addressBindings := getAddressBindingsFromSchema(d)
 	adminState := d.Get("admin_state").(string)
 	ipPoolID := d.Get("ip_pool_id").(string)
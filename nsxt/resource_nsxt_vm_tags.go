@@ -220,7 +220,7 @@ func resourceNsxtVMTagsCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during VM retrieval: %v", err)
 	}
 
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	if len(tags) > 0 || d.HasChange("tag") {
 		err = updateTags(nsxClient, vm.ExternalId, tags)
 		if err != nil {
@@ -228,7 +228,7 @@ func resourceNsxtVMTagsCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	portTags := getCustomizedTagsFromSchema(d, "logical_port_tag")
+	portTags := getCustomizedTagsFromSchema(d, "logical_port_tag", m)
 	if len(portTags) > 0 || d.HasChange("logical_port_tag") {
 		err = updatePortTags(nsxClient, vm.ExternalId, portTags)
 		if err != nil {
@@ -262,11 +262,11 @@ func resourceNsxtVMTagsRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during logical port retrieval: %v", err)
 	}
 
-	setTagsInSchema(d, vm.Tags)
+	setTagsInSchema(d, vm.Tags, m)
 	// assuming all ports have same tags
 	// note - more flexible implementation will be provided with policy resource
 	if len(ports) > 0 {
-		setCustomizedTagsInSchema(d, ports[0].Tags, "logical_port_tag")
+		setCustomizedTagsInSchema(d, ports[0].Tags, "logical_port_tag", m)
 	} else {
 		// assign empty list
 		var tagList []map[string]string
@@ -294,7 +294,7 @@ func resourceNsxtVMTagsDelete(d *schema.ResourceData, m interface{}) error {
 	}
 
 	noTags := make([]common.Tag, 0)
-	vmTags := getTagsFromSchema(d)
+	vmTags := getTagsFromSchema(d, m)
 	if len(vmTags) > 0 {
 		// Update tags only if they were configured by the provider
 		err = updateTags(nsxClient, vm.ExternalId, noTags)
@@ -303,7 +303,7 @@ func resourceNsxtVMTagsDelete(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	portTags := getCustomizedTagsFromSchema(d, "logical_port_tag")
+	portTags := getCustomizedTagsFromSchema(d, "logical_port_tag", m)
 	if len(portTags) > 0 {
 		// Update port tags only if they were configured by the provider
 		err := updatePortTags(nsxClient, vm.ExternalId, noTags)
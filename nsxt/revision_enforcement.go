@@ -0,0 +1,292 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// Update fails, and state of the resource is left as is
+	revisionConflictError = "error"
+	// Update fails, and state of the resource is refreshed with the current
+	// NSX object, so that the next plan is computed against it
+	revisionConflictRefresh = "refresh"
+)
+
+var revisionConflictValues = []string{revisionConflictError, revisionConflictRefresh}
+
+type expectedRevisionKey struct{}
+
+// expectedRevision holds revision of the object captured at refresh, which
+// is sent with the update and checked by NSX
+type expectedRevision struct {
+	path     string
+	revision int64
+
+	mutex     sync.Mutex
+	applied   bool
+	conflicts bool
+}
+
+func withExpectedRevision(ctx context.Context, path string, revision int64) (context.Context, *expectedRevision) {
+	expected := &expectedRevision{path: path, revision: revision}
+	return context.WithValue(ctx, expectedRevisionKey{}, expected), expected
+}
+
+func getExpectedRevision(ctx context.Context) *expectedRevision {
+	expected, _ := ctx.Value(expectedRevisionKey{}).(*expectedRevision)
+	return expected
+}
+
+// matches checks whether request URL points to the object, i.e.
+// /policy/api/v1/infra/domains/default/groups/g1 for /infra/domains/default/groups/g1
+func (e *expectedRevision) matches(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, "/api/v1"+e.path)
+}
+
+// take returns true for the first write of the object only, since a single
+// update may write the object more than once
+func (e *expectedRevision) take() bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.applied {
+		return false
+	}
+	e.applied = true
+	return true
+}
+
+// markApplied records that revision is enforced by hierarchical API call
+func (e *expectedRevision) markApplied() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.applied = true
+}
+
+func (e *expectedRevision) isApplied() bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.applied
+}
+
+func (e *expectedRevision) setConflict() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.conflicts = true
+}
+
+func (e *expectedRevision) hasConflict() bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.conflicts
+}
+
+// revisionRequestProcessor adds revision captured at refresh to update of the
+// object, unless the resource already provides one. Hierarchical API calls
+// are sent with revision check enforced for all children.
+type revisionRequestProcessor struct{}
+
+func newRevisionRequestProcessor() *revisionRequestProcessor {
+	return &revisionRequestProcessor{}
+}
+
+func (processor revisionRequestProcessor) Process(req *http.Request) error {
+	expected := getExpectedRevision(req.Context())
+	if expected == nil || (req.Method != http.MethodPatch && req.Method != http.MethodPut) {
+		return nil
+	}
+
+	if isPolicyInfraRequest(req) {
+		expected.markApplied()
+		query := req.URL.Query()
+		if query.Get("enforce_revision_check") != "true" {
+			query.Set("enforce_revision_check", "true")
+			req.URL.RawQuery = query.Encode()
+		}
+		return nil
+	}
+
+	if !expected.matches(req) || !expected.take() {
+		return nil
+	}
+
+	body := readBody(&req.Body)
+	var obj map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err != nil || obj == nil {
+		return nil
+	}
+	if _, ok := obj["_revision"]; ok {
+		return nil
+	}
+
+	obj["_revision"] = expected.revision
+	var updated bytes.Buffer
+	encoder := json.NewEncoder(&updated)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(obj); err != nil {
+		return nil
+	}
+
+	content := updated.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(content))
+	req.ContentLength = int64(len(content))
+	req.Header.Set("Content-Length", strconv.Itoa(len(content)))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+	log.Printf("[DEBUG]: Enforcing revision %d on update of %s", expected.revision, expected.path)
+	return nil
+}
+
+func isPolicyInfraRequest(req *http.Request) bool {
+	for _, prefix := range []string{"/policy/api/v1", "/global-manager/api/v1"} {
+		if path := strings.TrimPrefix(req.URL.Path, prefix); path != req.URL.Path && isPolicyInfraPath(path) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPolicyInfraPath(path string) bool {
+	if path == "/infra" || path == "/global-infra" {
+		return true
+	}
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	return len(segments) == 5 && segments[0] == "orgs" && segments[2] == "projects" && segments[4] == "infra"
+}
+
+// revisionResponseAcceptor marks the update as conflicting if NSX rejected
+// it due to revision mismatch
+type revisionResponseAcceptor struct{}
+
+func newRevisionResponseAcceptor() *revisionResponseAcceptor {
+	return &revisionResponseAcceptor{}
+}
+
+func (acceptor revisionResponseAcceptor) Accept(res *http.Response) {
+	if res.StatusCode != http.StatusPreconditionFailed || res.Request == nil {
+		return
+	}
+	if expected := getExpectedRevision(res.Request.Context()); expected != nil {
+		expected.setConflict()
+	}
+}
+
+func isRevisionEnforcementSupported(resource *schema.Resource) bool {
+	if _, ok := resource.Schema["revision"]; !ok {
+		return false
+	}
+	_, ok := resource.Schema["path"]
+	return ok && (resource.Update != nil || resource.UpdateContext != nil)
+}
+
+// updateWithRevisionEnforcement runs update with revision captured at refresh.
+// If NSX rejects the update due to revision mismatch, conflict diagnostic is
+// returned, and state is either kept or refreshed, depending on configuration.
+func updateWithRevisionEnforcement(ctx context.Context, d *schema.ResourceData, m interface{}, update schema.UpdateContextFunc, read schema.ReadContextFunc) diag.Diagnostics {
+	c := m.(nsxtClients)
+	path := d.Get("path").(string)
+	if !c.CommonConfig.EnforceRevision || path == "" {
+		return update(ctx, d, m)
+	}
+
+	revisionCtx, expected := withExpectedRevision(ctx, path, int64(d.Get("revision").(int)))
+	c.PolicyConnector = newContextConnector(revisionCtx, getPolicyConnector(m))
+	diags := update(revisionCtx, d, c)
+	if !diags.HasError() || !expected.hasConflict() {
+		if !diags.HasError() && !expected.isApplied() {
+			log.Printf("[WARNING]: Revision of %s was not enforced, since update did not write the object via Policy API", path)
+		}
+		return diags
+	}
+
+	conflict := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Object %s changed outside Terraform", path),
+		Detail:   fmt.Sprintf("NSX object was modified after it was last refreshed (revision %d in state), and the update was rejected to avoid overwriting the change.", expected.revision),
+	}
+	if c.CommonConfig.RevisionConflict == revisionConflictRefresh && read != nil {
+		readDiags := read(ctx, d, m)
+		if readDiags.HasError() {
+			return append(diag.Diagnostics{conflict}, readDiags...)
+		}
+		conflict.Detail += " State was refreshed with the current object, review the new plan and apply again."
+		return diag.Diagnostics{conflict}
+	}
+
+	// Keep the state as it was before the update
+	d.Partial(true)
+	conflict.Detail += " Run plan to review the changes made outside Terraform, and apply again."
+	return append(diag.Diagnostics{conflict}, diags...)
+}
+
+func wrapUpdateWithRevisionEnforcement(resource *schema.Resource) {
+	if resource.UpdateContext != nil {
+		update := resource.UpdateContext
+		read := resource.ReadContext
+		resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return updateWithRevisionEnforcement(ctx, d, m, update, read)
+		}
+		return
+	}
+
+	update := resource.Update
+	updateContext := func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.FromErr(update(d, m))
+	}
+	var readContext schema.ReadContextFunc
+	if read := resource.Read; read != nil {
+		readContext = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return diag.FromErr(read(d, m))
+		}
+	}
+	resource.Update = func(d *schema.ResourceData, m interface{}) error {
+		return getDiagnosticsError(updateWithRevisionEnforcement(context.Background(), d, m, updateContext, readContext))
+	}
+}
+
+// getDiagnosticsError converts error diagnostics back to error, for resources
+// that do not report diagnostics
+func getDiagnosticsError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, diagnostic := range diags {
+		if diagnostic.Severity != diag.Error {
+			continue
+		}
+		if diagnostic.Detail == "" {
+			messages = append(messages, diagnostic.Summary)
+		} else {
+			messages = append(messages, fmt.Sprintf("%s: %s", diagnostic.Summary, diagnostic.Detail))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "\n"))
+}
+
+// addRevisionEnforcementToResources makes update of Policy resources send
+// revision captured at refresh, if enforce_revision is configured
+func addRevisionEnforcementToResources(resources map[string]*schema.Resource) {
+	for _, resource := range resources {
+		if isRevisionEnforcementSupported(resource) {
+			wrapUpdateWithRevisionEnforcement(resource)
+		}
+	}
+}
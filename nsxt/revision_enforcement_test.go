@@ -0,0 +1,127 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestRevisionEnforcementLegacyUpdate(t *testing.T) {
+	updated := false
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path":     getPathSchema(),
+			"revision": getRevisionSchema(),
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			updated = true
+			return nil
+		},
+	}
+	addRevisionEnforcementToResources(map[string]*schema.Resource{"test": resource})
+	if resource.Update == nil || resource.UpdateContext != nil {
+		t.Fatalf("Expected legacy update to be wrapped in place")
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	if err := resource.Update(d, nsxtClients{}); err != nil || !updated {
+		t.Errorf("Expected update to run without enforce_revision, got %v", err)
+	}
+}
+
+func TestRevisionRequestProcessorApplied(t *testing.T) {
+	processor := newRevisionRequestProcessor()
+	cases := []struct {
+		url     string
+		applied bool
+	}{
+		{"https://nsx/policy/api/v1/infra/domains/default/groups/g1", true},
+		{"https://nsx/policy/api/v1/infra", true},
+		{"https://nsx/policy/api/v1/infra/domains/default/groups/g2", false},
+	}
+	for _, tc := range cases {
+		ctx, expected := withExpectedRevision(context.Background(), "/infra/domains/default/groups/g1", 3)
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPatch, tc.url, strings.NewReader(`{"display_name": "g1"}`))
+		if err := processor.Process(req); err != nil {
+			t.Fatalf("Failed to process request to %s: %v", tc.url, err)
+		}
+		if expected.isApplied() != tc.applied {
+			t.Errorf("Expected revision applied %v for request to %s", tc.applied, tc.url)
+		}
+	}
+}
+
+func TestProviderEnforceRevision(t *testing.T) {
+	for _, conflictMode := range []string{"", revisionConflictError, revisionConflictRefresh} {
+		sim := simulator.NewServer()
+		config := map[string]interface{}{}
+		if conflictMode != "" {
+			config["enforce_revision"] = true
+			config["revision_conflict"] = conflictMode
+		}
+		provider := testConfigureSimulatorProvider(t, sim, config)
+		res := provider.ResourcesMap["nsxt_policy_group"]
+		d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"display_name": "tf-group"})
+		if diags := testResourceCreate(res, d, provider.Meta()); diags.HasError() {
+			t.Fatalf("Failed to create group: %v", diags)
+		}
+		path := d.Get("path").(string)
+
+		// Update without conflict succeeds
+		d.Set("description", "updated by terraform")
+		if diags := testResourceUpdate(res, d, provider.Meta()); diags.HasError() {
+			t.Fatalf("Failed to update group with %q revision conflict mode: %v", conflictMode, diags)
+		}
+
+		// Change made outside Terraform
+		obj, _ := sim.Get(path)
+		obj["display_name"] = "ui-group"
+		sim.Put(path, obj)
+
+		d.Set("description", "updated again by terraform")
+		diags := testResourceUpdate(res, d, provider.Meta())
+		obj, _ = sim.Get(path)
+		switch conflictMode {
+		case "":
+			if diags.HasError() || obj["display_name"] != "tf-group" {
+				t.Errorf("Expected update to overwrite the change without enforce_revision, got %v", diags)
+			}
+		case revisionConflictError:
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, "changed outside Terraform") {
+				t.Errorf("Expected revision conflict error, got %v", diags)
+			}
+			if obj["display_name"] != "ui-group" {
+				t.Errorf("Expected change made outside Terraform to be preserved, got %v", obj["display_name"])
+			}
+		case revisionConflictRefresh:
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, "changed outside Terraform") {
+				t.Errorf("Expected revision conflict error, got %v", diags)
+			}
+			if d.Get("display_name") != "ui-group" || int64(d.Get("revision").(int)) != revisionOfObject(obj) {
+				t.Errorf("Expected state to be refreshed with current object, got %v revision %v", d.Get("display_name"), d.Get("revision"))
+			}
+		}
+		sim.Close()
+	}
+}
+
+func revisionOfObject(obj simulator.Object) int64 {
+	switch value := obj["_revision"].(type) {
+	case float64:
+		return int64(value)
+	case int64:
+		return value
+	}
+	return -1
+}
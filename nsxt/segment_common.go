@@ -10,7 +10,6 @@ import (
 	"github.com/vmware/terraform-provider-nsxt/api/infra/segments"
 	tier1s "github.com/vmware/terraform-provider-nsxt/api/infra/tier_1s"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
-	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -258,6 +257,22 @@ func getPolicySegmentSecurityProfilesSchema() *schema.Resource {
 	}
 }
 
+// Minimum NSX versions of segment attributes, shared by all segment resources
+var (
+	policySegmentReplicationModeGate  = newAttributeVersionGate("3.0.0", getPolicySegmentGateAttributes("replication_mode", "dhcp_config_path"))
+	policySegmentSubnetDhcpConfigGate = newAttributeVersionGate("3.0.0", getPolicySegmentGateAttributes("subnet.dhcp_v4_config", "subnet.dhcp_v6_config"))
+	policySegmentAdvancedConfigGate   = newAttributeVersionGate("3.0.0", getPolicySegmentGateAttributes("advanced_config.uplink_teaming_policy", "advanced_config.address_pool_path"))
+	policySegmentUrpfModeGate         = newAttributeVersionGate("3.1.0", getPolicySegmentGateAttributes("advanced_config.urpf_mode"))
+)
+
+func getPolicySegmentGateAttributes(attributes ...string) map[string][]string {
+	result := make(map[string][]string)
+	for _, resourceName := range []string{"nsxt_policy_segment", "nsxt_policy_vlan_segment", "nsxt_policy_fixed_segment"} {
+		result[resourceName] = attributes
+	}
+	return result
+}
+
 func getPolicyCommonSegmentSchema(vlanRequired bool, isFixed bool) map[string]*schema.Schema {
 	schema := map[string]*schema.Schema{
 		"nsx_id":       getNsxIDSchema(),
@@ -491,7 +506,7 @@ func getDhcpOptsFromMap(dhcpConfig map[string]interface{}) *model.DhcpV4Options
 }
 
 func getSegmentSubnetDhcpConfigFromSchema(schemaConfig map[string]interface{}) (*data.StructValue, error) {
-	if util.NsxVersionLower("3.0.0") {
+	if !policySegmentSubnetDhcpConfigGate.isSupported() {
 		return nil, nil
 	}
 
@@ -692,13 +707,13 @@ func nsxtPolicySegmentAddGatewayToInfraStruct(d *schema.ResourceData, dataValue
 	return dataValue1.(*data.StructValue), nil
 }
 
-func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d *schema.ResourceData, isVlan bool, isFixed bool) (model.Infra, error) {
+func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d *schema.ResourceData, isVlan bool, isFixed bool, m interface{}) (model.Infra, error) {
 	// Read the rest of the configured parameters
 	var infraChildren []*data.StructValue
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	domainName := d.Get("domain_name").(string)
 	tzPath := d.Get("transport_zone_path").(string)
 	replicationMode := d.Get("replication_mode").(string)
@@ -727,7 +742,7 @@ func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d
 	if tzPath != "" {
 		obj.TransportZonePath = &tzPath
 	}
-	if util.NsxVersionHigherOrEqual("3.0.0") {
+	if policySegmentReplicationModeGate.isSupported() {
 		obj.ReplicationMode = &replicationMode
 		if dhcpConfigPath != "" {
 			obj.DhcpConfigPath = &dhcpConfigPath
@@ -807,7 +822,7 @@ func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d
 			advConfigStruct.Connectivity = &connectivity
 		}
 
-		if util.NsxVersionHigherOrEqual("3.0.0") {
+		if policySegmentAdvancedConfigGate.isSupported() {
 			teamingPolicy := advConfigMap["uplink_teaming_policy"].(string)
 			if teamingPolicy != "" {
 				advConfigStruct.UplinkTeamingPolicyName = &teamingPolicy
@@ -818,7 +833,7 @@ func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d
 				advConfigStruct.AddressPoolPaths = append(advConfigStruct.AddressPoolPaths, poolPath)
 			}
 
-			if util.NsxVersionHigherOrEqual("3.1.0") {
+			if policySegmentUrpfModeGate.isSupported() {
 				urpfMode := advConfigMap["urpf_mode"].(string)
 				advConfigStruct.UrpfMode = &urpfMode
 			}
@@ -1350,7 +1365,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -1370,7 +1385,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 		}
 	}
 
-	if util.NsxVersionHigherOrEqual("3.0.0") {
+	if policySegmentReplicationModeGate.isSupported() {
 		d.Set("replication_mode", obj.ReplicationMode)
 	}
 
@@ -1389,7 +1404,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 		if obj.AdvancedConfig.UrpfMode != nil {
 			advConfig["urpf_mode"] = *obj.AdvancedConfig.UrpfMode
 		} else {
-			if util.NsxVersionLower("3.1.0") {
+			if !policySegmentUrpfModeGate.isSupported() {
 				// set to default in early versions
 				advConfig["urpf_mode"] = model.SegmentAdvancedConfig_URPF_MODE_STRICT
 			}
@@ -1455,7 +1470,7 @@ func nsxtPolicySegmentCreate(d *schema.ResourceData, m interface{}, isVlan bool,
 		return err
 	}
 
-	obj, err := policySegmentResourceToInfraStruct(getSessionContext(d, m), id, d, isVlan, isFixed)
+	obj, err := policySegmentResourceToInfraStruct(getSessionContext(d, m), id, d, isVlan, isFixed, m)
 	if err != nil {
 		return err
 	}
@@ -1478,7 +1493,7 @@ func nsxtPolicySegmentUpdate(d *schema.ResourceData, m interface{}, isVlan bool,
 		return fmt.Errorf("Error obtaining Segment ID")
 	}
 
-	obj, err := policySegmentResourceToInfraStruct(getSessionContext(d, m), id, d, isVlan, isFixed)
+	obj, err := policySegmentResourceToInfraStruct(getSessionContext(d, m), id, d, isVlan, isFixed, m)
 	if err != nil {
 		return err
 	}
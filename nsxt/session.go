@@ -0,0 +1,229 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// NSX replies with 403 and this error code when session is no longer valid
+var sessionExpiredErrorCodes = []int{403}
+
+var sessionCookieRegexp = regexp.MustCompile("JSESSIONID=.*?;")
+
+// nsxtSession holds NSX session headers shared between Policy and MP clients,
+// and re-creates the session when NSX reports it as expired
+type nsxtSession struct {
+	mutex      sync.RWMutex
+	cookie     string
+	xsrf       string
+	host       string
+	username   string
+	password   string
+	remoteAuth bool
+	// transport without session handling, used to issue session/create
+	transport http.RoundTripper
+}
+
+func newNsxtSession(host string, username string, password string, remoteAuth bool, transport http.RoundTripper) *nsxtSession {
+	return &nsxtSession{
+		host:       strings.TrimPrefix(host, "https://"),
+		username:   username,
+		password:   password,
+		remoteAuth: remoteAuth,
+		transport:  transport,
+	}
+}
+
+func (s *nsxtSession) getHeaders() (string, string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.cookie, s.xsrf
+}
+
+func (s *nsxtSession) setHeaders(cookie string, xsrf string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cookie = cookie
+	s.xsrf = xsrf
+}
+
+func (s *nsxtSession) isActive() bool {
+	cookie, _ := s.getHeaders()
+	return len(cookie) > 0
+}
+
+// renew re-creates the session, unless it was already renewed by another
+// request since expiredCookie was issued
+func (s *nsxtSession) renew(expiredCookie string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.cookie != expiredCookie {
+		log.Printf("[DEBUG]: NSX session was already renewed")
+		return nil
+	}
+
+	log.Printf("[INFO]: NSX session expired, re-creating session")
+	cookie, xsrf, err := s.create()
+	if err != nil {
+		return err
+	}
+	s.cookie = cookie
+	s.xsrf = xsrf
+	return nil
+}
+
+func (s *nsxtSession) create() (string, string, error) {
+	// username and password are escaped same way as in initial session creation
+	body := fmt.Sprintf("j_username=%s&j_password=%s", url.QueryEscape(s.username), url.QueryEscape(s.password))
+	req, err := http.NewRequest("POST", fmt.Sprintf("https://%s/api/session/create", s.host), strings.NewReader(body))
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if s.remoteAuth {
+		auth := base64.StdEncoding.EncodeToString([]byte(s.username + ":" + s.password))
+		req.Header.Set("Authorization", "Remote "+auth)
+	}
+
+	res, err := s.transport.RoundTrip(req)
+	if err != nil {
+		return "", "", fmt.Errorf("Failed to create session: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("Failed to create session: status code %d", res.StatusCode)
+	}
+
+	cookie := ""
+	for _, value := range res.Header.Values("Set-Cookie") {
+		if match := sessionCookieRegexp.FindString(value); match != "" {
+			cookie = match
+		}
+	}
+	if cookie == "" {
+		return "", "", fmt.Errorf("Failed to create session: no session cookie in response")
+	}
+	return cookie, res.Header.Get("X-XSRF-TOKEN"), nil
+}
+
+// destroy invalidates session with given headers on NSX
+func (s *nsxtSession) destroy(cookie string, xsrf string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("https://%s/api/session/destroy", s.host), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Cookie", cookie)
+	req.Header.Set("X-XSRF-TOKEN", xsrf)
+
+	res, err := s.transport.RoundTrip(req)
+	if err != nil {
+		return fmt.Errorf("Failed to destroy session: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("Failed to destroy session: status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+// isSessionExpiredResponse inspects response for session expiry indication.
+// Response body is consumed and replaced with in-memory copy.
+func isSessionExpiredResponse(res *http.Response) bool {
+	if res.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	if res.StatusCode != http.StatusForbidden || res.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var apiError struct {
+		ErrorCode int `json:"error_code"`
+	}
+	if err := json.Unmarshal(body, &apiError); err != nil {
+		return false
+	}
+	for _, code := range sessionExpiredErrorCodes {
+		if apiError.ErrorCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionRoundTripper re-creates expired NSX session and replays the failed
+// request with new session headers. Shared by Policy and MP HTTP clients.
+type sessionRoundTripper struct {
+	session   *nsxtSession
+	transport http.RoundTripper
+}
+
+func newSessionRoundTripper(session *nsxtSession, transport http.RoundTripper) *sessionRoundTripper {
+	return &sessionRoundTripper{
+		session:   session,
+		transport: transport,
+	}
+}
+
+func (rt *sessionRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !rt.session.isActive() || req.Header.Get("Cookie") == "" {
+		// Session is not used for this request
+		return rt.transport.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cookie, xsrf := rt.session.getHeaders()
+	res, err := rt.transport.RoundTrip(rt.withSession(req, body, cookie, xsrf))
+	if err != nil || !isSessionExpiredResponse(res) {
+		return res, err
+	}
+
+	if err := rt.session.renew(cookie); err != nil {
+		log.Printf("[ERROR]: Failed to re-create NSX session: %v", err)
+		return res, nil
+	}
+	res.Body.Close()
+
+	log.Printf("[DEBUG]: Replaying %s %s with renewed session", req.Method, req.URL)
+	cookie, xsrf = rt.session.getHeaders()
+	return rt.transport.RoundTrip(rt.withSession(req, body, cookie, xsrf))
+}
+
+func (rt *sessionRoundTripper) withSession(req *http.Request, body []byte, cookie string, xsrf string) *http.Request {
+	newReq := req.Clone(req.Context())
+	if body != nil {
+		newReq.Body = io.NopCloser(bytes.NewReader(body))
+		newReq.ContentLength = int64(len(body))
+	}
+	newReq.Header.Set("Cookie", cookie)
+	newReq.Header.Set("X-XSRF-TOKEN", xsrf)
+	return newReq
+}
@@ -0,0 +1,50 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestProviderSessionRenewal(t *testing.T) {
+	sim := simulator.NewServer()
+	defer sim.Close()
+
+	provider := testConfigureSimulatorProvider(t, sim, map[string]interface{}{"session_auth": true})
+	clients := provider.Meta().(nsxtClients)
+	if clients.Session == nil || !clients.Session.isActive() {
+		t.Fatalf("Expected session to be created on provider configure")
+	}
+	cookie, _ := clients.Session.getHeaders()
+
+	res := provider.ResourcesMap["nsxt_policy_group"]
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"display_name": "session-group"})
+	if diags := testResourceCreate(res, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("Failed to create group: %v", diags)
+	}
+
+	sim.ExpireSessions()
+	if diags := testResourceRead(res, d, provider.Meta()); diags.HasError() || d.Get("display_name") != "session-group" {
+		t.Errorf("Failed to read group after session expiry: %v", diags)
+	}
+	renewed, _ := clients.Session.getHeaders()
+	if renewed == cookie {
+		t.Errorf("Expected session cookie to be renewed")
+	}
+
+	sim.ExpireSessions()
+	_, resp, err := clients.NsxtClient.LicensingApi.GetLicenses(clients.NsxtClient.Context)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("Failed to list licenses with MP client after session expiry: %v", err)
+	}
+
+	if diags := testResourceDelete(res, d, provider.Meta()); diags.HasError() {
+		t.Errorf("Failed to delete group: %v", diags)
+	}
+}
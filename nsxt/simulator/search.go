@@ -0,0 +1,254 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// searchTerm is a single key:value condition of NSX search query
+type searchTerm struct {
+	key    string
+	value  string
+	negate bool
+}
+
+// searchClause is a disjunction of terms; query is a conjunction of clauses
+type searchClause []searchTerm
+
+func (s *Server) serveSearch(w http.ResponseWriter, r *http.Request) {
+	clauses := parseSearchQuery(r.URL.Query().Get("query"))
+
+	s.mutex.Lock()
+	var results []interface{}
+	for _, path := range s.sortedPaths() {
+		obj := s.objects[path]
+		if matchesQuery(obj, clauses) {
+			results = append(results, s.render(path, obj))
+		}
+	}
+	s.mutex.Unlock()
+
+	writeJSON(w, http.StatusOK, s.page(r, results))
+}
+
+// parseSearchQuery parses the subset of NSX search syntax used by the provider:
+// key:value terms joined by AND, optionally grouped with parenthesis and OR,
+// with trailing wildcards, quoted phrases and backslash escaping of special
+// characters
+func parseSearchQuery(query string) []searchClause {
+	var clauses []searchClause
+	for _, part := range splitUnescaped(query, " AND ") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		var clause searchClause
+		inner := part
+		if strings.HasPrefix(inner, "(") && strings.HasSuffix(inner, ")") {
+			inner = inner[1 : len(inner)-1]
+		}
+		for _, option := range splitUnescaped(inner, " OR ") {
+			clause = append(clause, parseSearchTerm(strings.TrimSpace(option)))
+		}
+		clauses = append(clauses, clause)
+	}
+	return clauses
+}
+
+func parseSearchTerm(term string) searchTerm {
+	result := searchTerm{}
+	if strings.HasPrefix(term, "NOT ") {
+		result.negate = true
+		term = strings.TrimPrefix(term, "NOT ")
+	} else if strings.HasPrefix(term, "!") {
+		result.negate = true
+		term = strings.TrimPrefix(term, "!")
+	}
+
+	separator := indexUnescaped(term, ':')
+	if separator < 0 {
+		// free text search
+		result.value = unescape(term)
+		return result
+	}
+	result.key = term[:separator]
+	value := term[separator+1:]
+	if len(value) >= 2 && strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"") {
+		// quoted phrase
+		value = value[1 : len(value)-1]
+	} else if end := indexUnescaped(value, ' '); end >= 0 {
+		// unquoted value ends at whitespace, the rest is free text which
+		// is not matched here
+		value = value[:end]
+	}
+	result.value = unescape(value)
+	if strings.HasSuffix(value, "\\*") {
+		// escaped wildcard is a literal
+		result.value += "\x00"
+	}
+	return result
+}
+
+func matchesQuery(obj Object, clauses []searchClause) bool {
+	for _, clause := range clauses {
+		matched := false
+		for _, term := range clause {
+			if matchesTerm(obj, term) != term.negate {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesTerm(obj Object, term searchTerm) bool {
+	pattern := strings.ToLower(term.value)
+	literal := strings.HasSuffix(pattern, "\x00")
+	pattern = strings.TrimSuffix(pattern, "\x00")
+
+	var candidates []string
+	if term.key == "" {
+		for _, value := range obj {
+			candidates = append(candidates, flattenValues(value)...)
+		}
+	} else {
+		candidates = fieldValues(obj, strings.Split(term.key, "."))
+	}
+
+	for _, candidate := range candidates {
+		candidate = strings.ToLower(candidate)
+		if !literal && strings.HasSuffix(pattern, "*") {
+			prefix := strings.TrimSuffix(pattern, "*")
+			if strings.HasPrefix(prefix, "*") {
+				if strings.Contains(candidate, strings.TrimPrefix(prefix, "*")) {
+					return true
+				}
+			} else if strings.HasPrefix(candidate, prefix) {
+				return true
+			}
+			continue
+		}
+		if !literal && strings.HasPrefix(pattern, "*") {
+			if strings.HasSuffix(candidate, strings.TrimPrefix(pattern, "*")) {
+				return true
+			}
+			continue
+		}
+		if candidate == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldValues resolves dotted field name in object, descending into lists
+func fieldValues(value interface{}, keys []string) []string {
+	if len(keys) == 0 {
+		return flattenValues(value)
+	}
+	switch typed := value.(type) {
+	case Object:
+		return fieldValues(map[string]interface{}(typed), keys)
+	case map[string]interface{}:
+		child, ok := typed[keys[0]]
+		if !ok {
+			if keys[0] == "marked_for_delete" {
+				return []string{"false"}
+			}
+			return nil
+		}
+		return fieldValues(child, keys[1:])
+	case []interface{}:
+		var result []string
+		for _, item := range typed {
+			result = append(result, fieldValues(item, keys)...)
+		}
+		return result
+	}
+	return nil
+}
+
+func flattenValues(value interface{}) []string {
+	switch typed := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		var result []string
+		for _, item := range typed {
+			result = append(result, flattenValues(item)...)
+		}
+		return result
+	case []string:
+		return typed
+	case map[string]interface{}, Object:
+		return nil
+	case float64:
+		if typed == float64(int64(typed)) {
+			return []string{fmt.Sprintf("%d", int64(typed))}
+		}
+	}
+	return []string{fmt.Sprintf("%v", value)}
+}
+
+func indexUnescaped(str string, target byte) int {
+	for i := 0; i < len(str); i++ {
+		if str[i] == '\\' {
+			i++
+			continue
+		}
+		if str[i] == target {
+			return i
+		}
+	}
+	return -1
+}
+
+func splitUnescaped(str string, separator string) []string {
+	var result []string
+	depth := 0
+	start := 0
+	quoted := false
+	for i := 0; i < len(str); i++ {
+		switch str[i] {
+		case '\\':
+			i++
+			continue
+		case '"':
+			quoted = !quoted
+		}
+		if quoted {
+			continue
+		}
+		switch str[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth == 0 && strings.HasPrefix(str[i:], separator) {
+			result = append(result, str[start:i])
+			start = i + len(separator)
+			i += len(separator) - 1
+		}
+	}
+	return append(result, str[start:])
+}
+
+func unescape(str string) string {
+	var sb strings.Builder
+	for i := 0; i < len(str); i++ {
+		if str[i] == '\\' && i+1 < len(str) {
+			i++
+		}
+		sb.WriteByte(str[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,831 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// Package simulator implements an in-memory stand-in for the NSX Policy and
+// Manager REST APIs, so that provider code can be exercised without a live
+// NSX manager. Objects are kept as plain JSON maps keyed by their policy path.
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultVersion is the NSX version reported by the simulator unless overridden
+const DefaultVersion = "4.1.2.0.0"
+
+// Default names of pre-created objects, matching acceptance test defaults
+const (
+	DefaultOverlayTransportZoneName = "1-transportzone"
+	DefaultVlanTransportZoneName    = "transportzone2"
+	DefaultEdgeClusterName          = "EDGECLUSTER1"
+	DefaultTier0Name                = "PLR-1 LogicalRouterTier0"
+)
+
+var apiPrefixes = []string{"/policy/api/v1", "/global-manager/api/v1", "/api/v1"}
+
+// Object is a single NSX object in its JSON form
+type Object map[string]interface{}
+
+// Server is the NSX API simulator
+type Server struct {
+	// Version reported by node/version API
+	Version string
+	// PageSize limits number of results in list and search responses
+	PageSize int
+	// RealizationState is reported for every existing intent object
+	RealizationState string
+	// Latency delays every API response
+	Latency time.Duration
+
+	server  *httptest.Server
+	mutex   sync.Mutex
+	objects map[string]Object
+	// NSX assigns unique numeric ID to every firewall rule
+	lastRuleID int64
+	// Session cookies issued by session/create that are still valid
+	sessions map[string]bool
+	// Number of client connections accepted so far
+	connections int64
+	// Number of following requests to reject with 429, and Retry-After value
+	// sent with them
+	throttled  int
+	retryAfter string
+	// Number of API requests served, per HTTP method
+	requests map[string]int
+	// Paths of objects whose configuration is rejected on write
+	rejected map[string]bool
+}
+
+// NewServer starts a new TLS simulator pre-populated with default objects
+func NewServer() *Server {
+	s := &Server{
+		Version:          DefaultVersion,
+		PageSize:         1000,
+		RealizationState: "REALIZED",
+		objects:          make(map[string]Object),
+		sessions:         make(map[string]bool),
+		requests:         make(map[string]int),
+		rejected:         make(map[string]bool),
+	}
+	s.seed()
+	s.server = httptest.NewUnstartedServer(s)
+	s.server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(&s.connections, 1)
+		}
+	}
+	s.server.StartTLS()
+	return s
+}
+
+// URL returns base URL of the simulator, including scheme
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Host returns host:port of the simulator, in the format expected by provider host setting
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.server.URL, "https://")
+}
+
+// Close shuts down the simulator
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Put stores object at given policy path, filling in path-derived attributes
+func (s *Server) Put(path string, obj Object) Object {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.write(path, obj, false)
+}
+
+// Get retrieves copy of the object stored at given policy path
+func (s *Server) Get(path string) (Object, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	obj, ok := s.objects[path]
+	if !ok {
+		return nil, false
+	}
+	return s.render(path, obj), true
+}
+
+// Requests returns number of API requests with given HTTP method served so far
+func (s *Server) Requests(method string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.requests[method]
+}
+
+// Connections returns number of client connections accepted so far, which
+// allows tests to measure connection reuse
+func (s *Server) Connections() int64 {
+	return atomic.LoadInt64(&s.connections)
+}
+
+// ExpireSessions invalidates all sessions issued so far, so that following
+// requests using them are rejected same way NSX rejects expired sessions
+func (s *Server) ExpireSessions() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.sessions = make(map[string]bool)
+}
+
+// Throttle rejects following count requests with 429 Too Many Requests, same
+// way NSX does when API rate limit is exceeded. Non-empty retryAfter is sent
+// in Retry-After header.
+func (s *Server) Throttle(count int, retryAfter string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.throttled = count
+	s.retryAfter = retryAfter
+}
+
+// throttle consumes one throttled request, if any
+func (s *Server) throttle(w http.ResponseWriter) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.throttled == 0 {
+		return false
+	}
+	s.throttled--
+	if s.retryAfter != "" {
+		w.Header().Set("Retry-After", s.retryAfter)
+	}
+	return true
+}
+
+// Reject makes following writes of the object at path fail with 400 Bad
+// Request, same way NSX does when object configuration is invalid
+func (s *Server) Reject(path string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.rejected[path] = true
+}
+
+func (s *Server) checkRejected(path string) Object {
+	if !s.rejected[path] {
+		return nil
+	}
+	return apiError(http.StatusBadRequest, 500090, "Configuration of object %s is invalid", path)
+}
+
+// Count returns number of objects stored under given path prefix
+func (s *Server) Count(prefix string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	count := 0
+	for path := range s.objects {
+		if strings.HasPrefix(path, prefix) {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *Server) seed() {
+	for _, root := range []string{"/infra", "/global-infra"} {
+		s.write(root, Object{"resource_type": "Infra"}, false)
+		s.write(root+"/domains/default", Object{"resource_type": "Domain"}, false)
+	}
+	s.write("/orgs/default", Object{"resource_type": "Org"}, false)
+	s.write("/orgs/default/projects/default", Object{"resource_type": "Project", "short_id": "default"}, false)
+	s.write("/orgs/default/projects/default/infra", Object{"resource_type": "Infra"}, false)
+
+	epPath := "/infra/sites/default/enforcement-points/default"
+	s.write("/infra/sites/default", Object{"resource_type": "Site"}, false)
+	s.write(epPath, Object{"resource_type": "EnforcementPoint"}, false)
+	s.write(epPath+"/transport-zones/"+uuid.NewString(), Object{
+		"resource_type": "PolicyTransportZone",
+		"display_name":  DefaultOverlayTransportZoneName,
+		"tz_type":       "OVERLAY_STANDARD",
+		"is_default":    true,
+	}, false)
+	s.write(epPath+"/transport-zones/"+uuid.NewString(), Object{
+		"resource_type": "PolicyTransportZone",
+		"display_name":  DefaultVlanTransportZoneName,
+		"tz_type":       "VLAN_BACKED",
+		"is_default":    true,
+	}, false)
+	s.write(epPath+"/edge-clusters/"+uuid.NewString(), Object{
+		"resource_type": "PolicyEdgeCluster",
+		"display_name":  DefaultEdgeClusterName,
+	}, false)
+	s.write("/infra/tier-0s/"+uuid.NewString(), Object{
+		"resource_type": "Tier0",
+		"display_name":  DefaultTier0Name,
+		"ha_mode":       "ACTIVE_STANDBY",
+	}, false)
+}
+
+// apiError is the error body format NSX replies with
+func apiError(status int, code int, format string, args ...interface{}) Object {
+	return Object{
+		"httpStatus":    strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_")),
+		"error_code":    code,
+		"module_name":   "simulator",
+		"error_message": fmt.Sprintf(format, args...),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			log.Printf("[ERROR] simulator failed to encode response: %v", err)
+		}
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code int, format string, args ...interface{}) {
+	writeJSON(w, status, apiError(status, code, format, args...))
+}
+
+// ServeHTTP dispatches a single API request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/session/create" {
+		s.serveSessionCreate(w, r)
+		return
+	}
+	if r.URL.Path == "/api/session/destroy" {
+		s.serveSessionDestroy(w, r)
+		return
+	}
+	time.Sleep(s.Latency)
+	if !s.validSession(r) {
+		writeError(w, http.StatusForbidden, 403, "The credentials were incorrect or the account specified has been locked.")
+		return
+	}
+	if s.throttle(w) {
+		writeError(w, http.StatusTooManyRequests, 102, "Client has exceeded the API rate limit.")
+		return
+	}
+
+	path := r.URL.Path
+	for _, prefix := range apiPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			path = strings.TrimPrefix(path, prefix)
+			break
+		}
+	}
+	path = strings.TrimSuffix(path, "/")
+
+	switch {
+	case path == "/node/version":
+		writeJSON(w, http.StatusOK, Object{"node_version": s.Version, "product_version": s.Version})
+		return
+	case path == "/search/query":
+		s.serveSearch(w, r)
+		return
+	case strings.HasSuffix(path, "/realized-state/realized-entities"):
+		s.serveRealizedEntities(w, r)
+		return
+	}
+
+	var body Object
+	if r.Body != nil && (r.Method == http.MethodPatch || r.Method == http.MethodPut || r.Method == http.MethodPost) {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, 1, "failed to read body: %v", err)
+			return
+		}
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &body); err != nil {
+				writeError(w, http.StatusBadRequest, 2, "invalid JSON body: %v", err)
+				return
+			}
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.requests[r.Method]++
+	switch r.Method {
+	case http.MethodGet:
+		s.serveGet(w, r, path)
+	case http.MethodPatch:
+		s.servePatch(w, r, path, body)
+	case http.MethodPut:
+		s.servePut(w, path, body)
+	case http.MethodPost:
+		s.servePost(w, r, path, body)
+	case http.MethodDelete:
+		s.serveDelete(w, path)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, 3, "method %s not supported", r.Method)
+	}
+}
+
+func (s *Server) serveSessionCreate(w http.ResponseWriter, r *http.Request) {
+	session := uuid.NewString()
+	s.mutex.Lock()
+	s.sessions[session] = true
+	s.mutex.Unlock()
+	http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: session, Path: "/"})
+	w.Header().Set("X-XSRF-TOKEN", uuid.NewString())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) serveSessionDestroy(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie("JSESSIONID"); err == nil {
+		s.mutex.Lock()
+		delete(s.sessions, cookie.Value)
+		s.mutex.Unlock()
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// validSession checks session cookie, if present. Requests without session
+// cookie are authenticated by other means and always accepted.
+func (s *Server) validSession(r *http.Request) bool {
+	cookie, err := r.Cookie("JSESSIONID")
+	if err != nil {
+		return true
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.sessions[cookie.Value]
+}
+
+func (s *Server) serveGet(w http.ResponseWriter, r *http.Request, path string) {
+	if strings.HasSuffix(path, "/state") {
+		if _, ok := s.objects[strings.TrimSuffix(path, "/state")]; ok {
+			writeJSON(w, http.StatusOK, Object{"state": "success", "details": []interface{}{}})
+			return
+		}
+	}
+
+	if isCollectionPath(path) {
+		s.serveList(w, r, path)
+		return
+	}
+
+	obj, ok := s.objects[path]
+	if !ok {
+		writeError(w, http.StatusNotFound, 500090, "The path=[%s] is invalid", path)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.render(path, obj))
+}
+
+func (s *Server) serveList(w http.ResponseWriter, r *http.Request, collectionPath string) {
+	var results []interface{}
+	for _, path := range s.sortedPaths() {
+		if parentOf(path) != collectionPath {
+			continue
+		}
+		obj := s.objects[path]
+		if deleted, _ := obj["marked_for_delete"].(bool); deleted {
+			continue
+		}
+		results = append(results, s.render(path, obj))
+	}
+
+	writeJSON(w, http.StatusOK, s.page(r, results))
+}
+
+// page cuts a single page out of results, honouring cursor and page_size
+func (s *Server) page(r *http.Request, results []interface{}) Object {
+	start := 0
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		start, _ = strconv.Atoi(cursor)
+	}
+	pageSize := s.PageSize
+	if size := r.URL.Query().Get("page_size"); size != "" {
+		if value, err := strconv.Atoi(size); err == nil && value > 0 && value < pageSize {
+			pageSize = value
+		}
+	}
+	if start > len(results) {
+		start = len(results)
+	}
+	end := start + pageSize
+	if end > len(results) {
+		end = len(results)
+	}
+
+	response := Object{
+		"results":      results[start:end],
+		"result_count": len(results),
+	}
+	if results == nil {
+		response["results"] = []interface{}{}
+	}
+	if end < len(results) {
+		response["cursor"] = strconv.Itoa(end)
+	}
+	return response
+}
+
+func (s *Server) servePatch(w http.ResponseWriter, r *http.Request, path string, body Object) {
+	if isCollectionPath(path) {
+		writeError(w, http.StatusBadRequest, 4, "PATCH is not supported on collection %s", path)
+		return
+	}
+
+	enforceRevision := r.URL.Query().Get("enforce_revision_check") == "true"
+	snapshot := s.snapshot()
+
+	children, hasChildren := body["children"].([]interface{})
+	delete(body, "children")
+
+	if !hasChildren || !isRootPath(path) || len(body) > 1 {
+		if err := s.checkRejected(path); err != nil {
+			writeJSON(w, http.StatusBadRequest, err)
+			return
+		}
+		if err := s.checkRevision(path, body, false); err != nil {
+			writeJSON(w, http.StatusPreconditionFailed, err)
+			return
+		}
+		s.write(path, body, true)
+	}
+
+	if hasChildren {
+		if err := s.patchChildren(path, children, enforceRevision); err != nil {
+			s.objects = snapshot
+			writeJSON(w, err.status(), err.body)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) servePut(w http.ResponseWriter, path string, body Object) {
+	if isCollectionPath(path) {
+		writeError(w, http.StatusBadRequest, 4, "PUT is not supported on collection %s", path)
+		return
+	}
+
+	if err := s.checkRevision(path, body, true); err != nil {
+		writeJSON(w, http.StatusPreconditionFailed, err)
+		return
+	}
+
+	// PUT replaces the object entirely
+	obj := s.write(path, body, false)
+	writeJSON(w, http.StatusOK, s.render(path, obj))
+}
+
+func (s *Server) servePost(w http.ResponseWriter, r *http.Request, path string, body Object) {
+	if action := r.URL.Query().Get("action"); action != "" {
+		// Actions are accepted, but have no effect on stored objects
+		if obj, ok := s.objects[path]; ok {
+			writeJSON(w, http.StatusOK, s.render(path, obj))
+			return
+		}
+		writeJSON(w, http.StatusOK, Object{})
+		return
+	}
+
+	if !isCollectionPath(path) {
+		writeError(w, http.StatusBadRequest, 5, "POST is not supported on object %s", path)
+		return
+	}
+
+	if body == nil {
+		body = Object{}
+	}
+	id, _ := body["id"].(string)
+	if id == "" {
+		id = uuid.NewString()
+	}
+	objPath := path + "/" + id
+	if _, exists := s.objects[objPath]; exists {
+		writeError(w, http.StatusBadRequest, 500012, "Object %s already exists", objPath)
+		return
+	}
+	obj := s.write(objPath, body, false)
+	writeJSON(w, http.StatusCreated, s.render(objPath, obj))
+}
+
+func (s *Server) serveDelete(w http.ResponseWriter, path string) {
+	s.remove(path)
+	w.WriteHeader(http.StatusOK)
+}
+
+// checkRevision returns error body in case revision provided does not match
+// the stored one. If strict is set, revision is required for existing objects.
+func (s *Server) checkRevision(path string, body Object, strict bool) Object {
+	existing, ok := s.objects[path]
+	if !ok {
+		return nil
+	}
+	requested, hasRevision := body["_revision"]
+	if !hasRevision {
+		if strict {
+			return apiError(http.StatusPreconditionFailed, 602, "The object %s requires _revision for update", path)
+		}
+		return nil
+	}
+
+	requestedNum, _ := requested.(float64)
+	if int64(requestedNum) != revisionOf(existing) {
+		return apiError(http.StatusPreconditionFailed, 604,
+			"The object AbstractPolicyResource [%s] was modified by somebody else. Revision %d does not match current revision %d",
+			path, int64(requestedNum), revisionOf(existing))
+	}
+	return nil
+}
+
+func revisionOf(obj Object) int64 {
+	switch value := obj["_revision"].(type) {
+	case float64:
+		return int64(value)
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	}
+	return 0
+}
+
+// write creates or updates object at path. When merge is set, attributes of
+// existing object that are not present in body are preserved (PATCH semantics).
+// Stored objects are never mutated in place, which keeps snapshots cheap.
+func (s *Server) write(path string, body Object, merge bool) Object {
+	obj := Object{}
+	existing, exists := s.objects[path]
+	if exists && merge {
+		for key, value := range existing {
+			obj[key] = value
+		}
+	}
+	for key, value := range body {
+		obj[key] = value
+	}
+
+	id := lastSegment(path)
+	obj["id"] = id
+	obj["path"] = path
+	obj["relative_path"] = id
+	obj["parent_path"] = parentObjectOf(path)
+	obj["marked_for_delete"] = false
+	if _, ok := obj["display_name"]; !ok {
+		obj["display_name"] = id
+	}
+	if _, ok := obj["resource_type"]; !ok {
+		obj["resource_type"] = resourceTypeForCollection(lastSegment(parentOf(path)))
+	}
+	if _, ok := obj["unique_id"]; !ok {
+		obj["unique_id"] = uuid.NewString()
+	}
+	if exists {
+		obj["_revision"] = revisionOf(existing) + 1
+		obj["_create_user"] = existing["_create_user"]
+	} else {
+		obj["_revision"] = int64(0)
+		obj["_create_user"] = "admin"
+	}
+	obj["_system_owned"] = false
+	if _, ok := obj["rule_id"]; !ok && obj["resource_type"] == "Rule" {
+		s.lastRuleID++
+		obj["rule_id"] = s.lastRuleID
+	}
+
+	// Embedded children are stored as separate objects, and rendered back on read
+	for field, collection := range embeddedCollections {
+		items, ok := obj[field].([]interface{})
+		if !ok || !embeddingTypes[field][fmt.Sprintf("%v", obj["resource_type"])] {
+			continue
+		}
+		delete(obj, field)
+		for _, item := range items {
+			child, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			childID, _ := child["id"].(string)
+			if childID == "" {
+				childID = uuid.NewString()
+			}
+			childPath := path + "/" + collection + "/" + childID
+			s.write(childPath, child, true)
+		}
+	}
+
+	s.objects[path] = obj
+	return obj
+}
+
+// render returns object as it is returned by NSX, with embedded children
+func (s *Server) render(path string, obj Object) Object {
+	result := Object{}
+	for key, value := range obj {
+		result[key] = value
+	}
+
+	for field, collection := range embeddedCollections {
+		if !embeddingTypes[field][fmt.Sprintf("%v", obj["resource_type"])] {
+			continue
+		}
+		var items []Object
+		collectionPath := path + "/" + collection
+		for childPath, child := range s.objects {
+			if parentOf(childPath) == collectionPath {
+				items = append(items, s.render(childPath, child))
+			}
+		}
+		sort.Slice(items, func(i, j int) bool {
+			si, _ := items[i]["sequence_number"].(float64)
+			sj, _ := items[j]["sequence_number"].(float64)
+			if si != sj {
+				return si < sj
+			}
+			return fmt.Sprintf("%v", items[i]["id"]) < fmt.Sprintf("%v", items[j]["id"])
+		})
+		list := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			list = append(list, item)
+		}
+		result[field] = list
+	}
+	return result
+}
+
+// remove deletes object together with all its descendants
+func (s *Server) remove(path string) {
+	delete(s.objects, path)
+	for childPath := range s.objects {
+		if strings.HasPrefix(childPath, path+"/") {
+			delete(s.objects, childPath)
+		}
+	}
+}
+
+func (s *Server) snapshot() map[string]Object {
+	copied := make(map[string]Object, len(s.objects))
+	for key, value := range s.objects {
+		copied[key] = value
+	}
+	return copied
+}
+
+func (s *Server) sortedPaths() []string {
+	paths := make([]string, 0, len(s.objects))
+	for path := range s.objects {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+type childError struct {
+	body Object
+}
+
+func (e *childError) status() int {
+	if code, ok := e.body["httpStatus"].(string); ok && code == "PRECONDITION_FAILED" {
+		return http.StatusPreconditionFailed
+	}
+	return http.StatusBadRequest
+}
+
+// patchChildren applies hierarchical API children under parent path
+func (s *Server) patchChildren(parentPath string, children []interface{}, enforceRevision bool) *childError {
+	for _, item := range children {
+		child, ok := item.(map[string]interface{})
+		if !ok {
+			return &childError{apiError(http.StatusBadRequest, 6, "Invalid child under %s", parentPath)}
+		}
+		childType, _ := child["resource_type"].(string)
+		markedForDelete, _ := child["marked_for_delete"].(bool)
+
+		if childType == "ChildResourceReference" {
+			targetType, _ := child["target_type"].(string)
+			id, _ := child["id"].(string)
+			refPath := parentPath + "/" + collectionForResourceType(targetType) + "/" + id
+			grandChildren, _ := child["children"].([]interface{})
+			if err := s.patchChildren(refPath, grandChildren, enforceRevision); err != nil {
+				return err
+			}
+			continue
+		}
+
+		wrapped := strings.TrimPrefix(childType, "Child")
+		inner, ok := child[wrapped].(map[string]interface{})
+		if !ok {
+			return &childError{apiError(http.StatusBadRequest, 7, "Child of type %s under %s is missing %s", childType, parentPath, wrapped)}
+		}
+		id, _ := inner["id"].(string)
+		if id == "" {
+			return &childError{apiError(http.StatusBadRequest, 8, "Child of type %s under %s is missing id", childType, parentPath)}
+		}
+		innerType, _ := inner["resource_type"].(string)
+		if innerType == "" {
+			innerType = wrapped
+		}
+		childPath := parentPath + "/" + collectionForResourceType(innerType) + "/" + id
+
+		if markedForDelete {
+			s.remove(childPath)
+			continue
+		}
+
+		body := Object{}
+		for key, value := range inner {
+			body[key] = value
+		}
+		grandChildren, _ := body["children"].([]interface{})
+		delete(body, "children")
+		if err := s.checkRejected(childPath); err != nil {
+			return &childError{err}
+		}
+		if enforceRevision {
+			if err := s.checkRevision(childPath, body, false); err != nil {
+				return &childError{err}
+			}
+		} else {
+			delete(body, "_revision")
+		}
+		s.write(childPath, body, true)
+
+		if err := s.patchChildren(childPath, grandChildren, enforceRevision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) serveRealizedEntities(w http.ResponseWriter, r *http.Request) {
+	intentPath := r.URL.Query().Get("intent_path")
+	var results []interface{}
+	if obj, ok := s.Get(intentPath); ok {
+		results = append(results, Object{
+			"resource_type":                   "GenericPolicyRealizedResource",
+			"id":                              obj["id"],
+			"display_name":                    obj["display_name"],
+			"entity_type":                     obj["resource_type"],
+			"intent_paths":                    []string{intentPath},
+			"state":                           s.RealizationState,
+			"runtime_status":                  "UNINITIALIZED",
+			"realization_specific_identifier": obj["unique_id"],
+			"path":                            intentPath,
+		})
+	}
+	writeJSON(w, http.StatusOK, s.page(r, results))
+}
+
+// isRootPath checks whether path points to one of the infra roots, where
+// hierarchical API is typically invoked
+func isRootPath(path string) bool {
+	return path == "/infra" || path == "/global-infra" || (strings.HasPrefix(path, "/orgs/") && strings.HasSuffix(path, "/infra"))
+}
+
+// relativeSegments returns path segments after the infra root, if any
+func relativeSegments(path string) []string {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	for i, seg := range segs {
+		if seg == "infra" || seg == "global-infra" {
+			return segs[i+1:]
+		}
+	}
+	return segs
+}
+
+// isCollectionPath determines whether path points to a collection rather than
+// an object. Beneath infra root, paths alternate between collection and object id.
+func isCollectionPath(path string) bool {
+	return len(relativeSegments(path))%2 == 1
+}
+
+func lastSegment(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+func parentOf(path string) string {
+	index := strings.LastIndex(path, "/")
+	if index <= 0 {
+		return ""
+	}
+	return path[:index]
+}
+
+// parentObjectOf returns path of the object that owns the collection this path belongs to
+func parentObjectOf(path string) string {
+	if isRootPath(path) {
+		return ""
+	}
+	if len(relativeSegments(path)) < 2 {
+		return parentOf(path)
+	}
+	return parentOf(parentOf(path))
+}
@@ -0,0 +1,252 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package simulator
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	nsx_policy "github.com/vmware/vsphere-automation-sdk-go/services/nsxt"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
+)
+
+func newTestConnector(s *Server) client.Connector {
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	return client.NewConnector(s.URL(), client.UsingRest(nil), client.WithHttpClient(httpClient))
+}
+
+func TestSimulatorPatchGetDelete(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	groupsClient := domains.NewGroupsClient(newTestConnector(s))
+
+	name := "test-group"
+	err := groupsClient.Patch("default", "g1", model.Group{DisplayName: &name})
+	if err != nil {
+		t.Fatalf("Failed to patch group: %v", err)
+	}
+
+	obj, err := groupsClient.Get("default", "g1")
+	if err != nil {
+		t.Fatalf("Failed to get group: %v", err)
+	}
+	if *obj.DisplayName != name || *obj.Path != "/infra/domains/default/groups/g1" || *obj.ResourceType != "Group" {
+		t.Errorf("Unexpected group contents: %v %v %v", *obj.DisplayName, *obj.Path, *obj.ResourceType)
+	}
+	if *obj.Revision != 0 {
+		t.Errorf("Expected revision 0 on create, got %d", *obj.Revision)
+	}
+
+	description := "updated"
+	err = groupsClient.Patch("default", "g1", model.Group{Description: &description})
+	if err != nil {
+		t.Fatalf("Failed to patch group: %v", err)
+	}
+	obj, _ = groupsClient.Get("default", "g1")
+	if *obj.DisplayName != name || *obj.Description != description || *obj.Revision != 1 {
+		t.Errorf("PATCH did not merge attributes: %v %v %d", *obj.DisplayName, *obj.Description, *obj.Revision)
+	}
+
+	list, err := groupsClient.List("default", nil, nil, nil, nil, nil, nil, nil)
+	if err != nil || *list.ResultCount != 1 {
+		t.Errorf("Expected single group in list, got %v (%v)", list.ResultCount, err)
+	}
+
+	err = groupsClient.Delete("default", "g1", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to delete group: %v", err)
+	}
+	_, err = groupsClient.Get("default", "g1")
+	if _, ok := err.(errors.NotFound); !ok {
+		t.Errorf("Expected NotFound error after delete, got %v", err)
+	}
+}
+
+func TestSimulatorRevision(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	groupsClient := domains.NewGroupsClient(newTestConnector(s))
+
+	name := "test-group"
+	obj, err := groupsClient.Update("default", "g1", model.Group{DisplayName: &name})
+	if err != nil {
+		t.Fatalf("Failed to create group with PUT: %v", err)
+	}
+
+	stale := *obj.Revision
+	obj, err = groupsClient.Update("default", "g1", obj)
+	if err != nil {
+		t.Fatalf("Failed to update group with current revision: %v", err)
+	}
+
+	obj.Revision = &stale
+	_, err = groupsClient.Update("default", "g1", obj)
+	if _, ok := err.(errors.InvalidRequest); !ok {
+		t.Errorf("Expected InvalidRequest for stale revision, got %v", err)
+	}
+}
+
+func TestSimulatorHierarchicalAPI(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	connector := newTestConnector(s)
+	converter := bindings.NewTypeConverter()
+
+	policyID := "p1"
+	policyType := "SecurityPolicy"
+	category := "Application"
+	var ruleChildren []*data.StructValue
+	for i, ruleID := range []string{"r2", "r1"} {
+		id := ruleID
+		ruleType := "Rule"
+		sequence := int64(10 - i)
+		childRule := model.ChildRule{
+			ResourceType: "ChildRule",
+			Rule:         &model.Rule{Id: &id, ResourceType: &ruleType, SequenceNumber: &sequence},
+		}
+		value, errs := converter.ConvertToVapi(childRule, model.ChildRuleBindingType())
+		if errs != nil {
+			t.Fatal(errs[0])
+		}
+		ruleChildren = append(ruleChildren, value.(*data.StructValue))
+	}
+	childPolicy := model.ChildSecurityPolicy{
+		ResourceType: "ChildSecurityPolicy",
+		SecurityPolicy: &model.SecurityPolicy{
+			Id:           &policyID,
+			ResourceType: &policyType,
+			Category:     &category,
+			Children:     ruleChildren,
+		},
+	}
+	policyValue, errs := converter.ConvertToVapi(childPolicy, model.ChildSecurityPolicyBindingType())
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	targetType := "Domain"
+	domainID := "default"
+	domainRef := model.ChildResourceReference{
+		ResourceType: "ChildResourceReference",
+		Id:           &domainID,
+		TargetType:   &targetType,
+		Children:     []*data.StructValue{policyValue.(*data.StructValue)},
+	}
+	domainValue, errs := converter.ConvertToVapi(domainRef, model.ChildResourceReferenceBindingType())
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+
+	infraType := "Infra"
+	infraClient := nsx_policy.NewInfraClient(connector)
+	err := infraClient.Patch(model.Infra{ResourceType: &infraType, Children: []*data.StructValue{domainValue.(*data.StructValue)}}, nil)
+	if err != nil {
+		t.Fatalf("Failed to patch infra: %v", err)
+	}
+
+	policy, err := domains.NewSecurityPoliciesClient(connector).Get("default", policyID)
+	if err != nil {
+		t.Fatalf("Failed to get security policy: %v", err)
+	}
+	if len(policy.Rules) != 2 || *policy.Rules[0].Id != "r1" {
+		t.Errorf("Expected two embedded rules ordered by sequence number, got %v", policy.Rules)
+	}
+
+	// Stale revision under enforce_revision_check fails the whole transaction
+	staleRevision := int64(5)
+	childPolicy.SecurityPolicy.Revision = &staleRevision
+	childPolicy.SecurityPolicy.Children = nil
+	newID := "p2"
+	otherPolicy := model.ChildSecurityPolicy{
+		ResourceType:   "ChildSecurityPolicy",
+		SecurityPolicy: &model.SecurityPolicy{Id: &newID, ResourceType: &policyType, Category: &category},
+	}
+	otherValue, _ := converter.ConvertToVapi(otherPolicy, model.ChildSecurityPolicyBindingType())
+	policyValue, _ = converter.ConvertToVapi(childPolicy, model.ChildSecurityPolicyBindingType())
+	domainRef.Children = []*data.StructValue{otherValue.(*data.StructValue), policyValue.(*data.StructValue)}
+	domainValue, _ = converter.ConvertToVapi(domainRef, model.ChildResourceReferenceBindingType())
+	enforce := true
+	err = infraClient.Patch(model.Infra{ResourceType: &infraType, Children: []*data.StructValue{domainValue.(*data.StructValue)}}, &enforce)
+	if err == nil {
+		t.Errorf("Expected failure on stale revision with enforce_revision_check")
+	}
+	if _, ok := s.Get("/infra/domains/default/security-policies/p2"); ok {
+		t.Errorf("Failed H-API transaction should not leave partial changes")
+	}
+
+	// Rejected object fails the whole transaction as well
+	s.Reject("/infra/domains/default/security-policies/p2")
+	domainRef.Children = []*data.StructValue{otherValue.(*data.StructValue)}
+	domainValue, _ = converter.ConvertToVapi(domainRef, model.ChildResourceReferenceBindingType())
+	err = infraClient.Patch(model.Infra{ResourceType: &infraType, Children: []*data.StructValue{domainValue.(*data.StructValue)}}, nil)
+	if _, ok := err.(errors.InvalidRequest); !ok {
+		t.Errorf("Expected invalid request error for rejected object, got %v", err)
+	}
+	if _, ok := s.Get("/infra/domains/default/security-policies/p2"); ok {
+		t.Errorf("Rejected object should not be created")
+	}
+}
+
+func TestSimulatorSearchAndRealization(t *testing.T) {
+	s := NewServer()
+	s.PageSize = 2
+	defer s.Close()
+	connector := newTestConnector(s)
+
+	for _, id := range []string{"seg-a", "seg-b", "seg-c"} {
+		s.Put("/infra/segments/"+id, Object{"display_name": "web-" + id, "tags": []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}}})
+	}
+	s.Put("/orgs/default/projects/default/infra/segments/seg-d", Object{"display_name": "web-seg-d"})
+
+	queryClient := search.NewQueryClient(connector)
+	response, err := queryClient.List("resource_type:Segment AND display_name:web* AND tags.tag:prod AND path:\\/infra*", nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if *response.ResultCount != 3 || len(response.Results) != 2 || response.Cursor == nil {
+		t.Fatalf("Unexpected first search page: count %v, results %d", *response.ResultCount, len(response.Results))
+	}
+	response, err = queryClient.List("resource_type:Segment AND display_name:web* AND tags.tag:prod AND path:\\/infra*", response.Cursor, nil, nil, nil, nil)
+	if err != nil || len(response.Results) != 1 {
+		t.Errorf("Unexpected second search page: %v", err)
+	}
+
+	response, _ = queryClient.List("(id:seg-a OR id:seg-d) AND path:\\/orgs\\/default\\/projects\\/default*", nil, nil, nil, nil, nil)
+	if *response.ResultCount != 1 {
+		t.Errorf("Expected single project segment, got %d", *response.ResultCount)
+	}
+
+	response, _ = queryClient.List("resource_type:Segment AND parent_path:*\\/infra", nil, nil, nil, nil, nil)
+	if *response.ResultCount != 4 {
+		t.Errorf("Expected leading wildcard to match all segments, got %d", *response.ResultCount)
+	}
+
+	realizationClient := realized_state.NewRealizedEntitiesClient(connector)
+	realization, err := realizationClient.List("/infra/segments/seg-a", nil)
+	if err != nil || len(realization.Results) != 1 || *realization.Results[0].State != "REALIZED" {
+		t.Errorf("Expected realized state for segment: %v", err)
+	}
+}
+
+func TestCollectionForResourceType(t *testing.T) {
+	cases := map[string]string{
+		"Tier1":                     "tier-1s",
+		"SecurityPolicy":            "security-policies",
+		"L4PortSetServiceEntry":     "service-entries",
+		"StaticRouteBfdPeer":        "static-route-bfd-peers",
+		"DhcpV4StaticBindingConfig": "dhcp-v4-static-binding-configs",
+	}
+	for resourceType, expected := range cases {
+		if collection := collectionForResourceType(resourceType); collection != expected {
+			t.Errorf("Expected collection %s for %s, got %s", expected, resourceType, collection)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package simulator
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Collection name in policy path per resource type, where it can not be
+// derived by the generic rule
+var resourceTypeCollections = map[string]string{
+	"Domain":                   "domains",
+	"Group":                    "groups",
+	"SecurityPolicy":           "security-policies",
+	"GatewayPolicy":            "gateway-policies",
+	"RedirectionPolicy":        "redirection-policies",
+	"IdsSecurityPolicy":        "intrusion-service-policies",
+	"IdsGatewayPolicy":         "intrusion-service-gateway-policies",
+	"Rule":                     "rules",
+	"IdsRule":                  "rules",
+	"Service":                  "services",
+	"Segment":                  "segments",
+	"SegmentPort":              "ports",
+	"Tier0":                    "tier-0s",
+	"Tier1":                    "tier-1s",
+	"LocaleServices":           "locale-services",
+	"Tier0Interface":           "interfaces",
+	"Tier1Interface":           "interfaces",
+	"PolicyNat":                "nat",
+	"PolicyNatRule":            "nat-rules",
+	"StaticRoutes":             "static-routes",
+	"BgpRoutingConfig":         "bgp",
+	"BgpNeighborConfig":        "neighbors",
+	"PolicyDnsForwarder":       "dns-forwarder",
+	"PolicyContextProfile":     "context-profiles",
+	"IpAddressPool":            "ip-pools",
+	"IpAddressBlock":           "ip-blocks",
+	"IpAddressPoolBlockSubnet": "ip-subnets",
+	"PolicyTransportZone":      "transport-zones",
+	"PolicyEdgeCluster":        "edge-clusters",
+	"PolicyEdgeNode":           "edge-nodes",
+	"Site":                     "sites",
+	"EnforcementPoint":         "enforcement-points",
+	"Project":                  "projects",
+	"Vpc":                      "vpcs",
+	"SegmentSecurityProfile":   "segment-security-profiles",
+	"MacDiscoveryProfile":      "mac-discovery-profiles",
+	"IPDiscoveryProfile":       "ip-discovery-profiles",
+	"QoSProfile":               "qos-profiles",
+	"SpoofGuardProfile":        "spoofguard-profiles",
+	"DhcpRelayConfig":          "dhcp-relay-configs",
+	"DhcpServerConfig":         "dhcp-server-configs",
+}
+
+// Attributes that NSX embeds in parent object on read, while storing them as
+// children in policy tree, together with resource types that embed them
+var embeddedCollections = map[string]string{
+	"rules":           "rules",
+	"service_entries": "service-entries",
+}
+
+var embeddingTypes = map[string]map[string]bool{
+	"rules": {
+		"SecurityPolicy":    true,
+		"GatewayPolicy":     true,
+		"RedirectionPolicy": true,
+		"IdsSecurityPolicy": true,
+		"IdsGatewayPolicy":  true,
+	},
+	"service_entries": {
+		"Service": true,
+	},
+}
+
+var collectionResourceTypes map[string]string
+
+func init() {
+	collectionResourceTypes = make(map[string]string)
+	for resourceType, collection := range resourceTypeCollections {
+		if _, exists := collectionResourceTypes[collection]; !exists {
+			collectionResourceTypes[collection] = resourceType
+		}
+	}
+	// Prefer the common types for ambiguous collections
+	collectionResourceTypes["rules"] = "Rule"
+	collectionResourceTypes["interfaces"] = "Tier1Interface"
+}
+
+// collectionForResourceType converts resource type to collection name, such
+// as Tier1 -> tier-1s or SegmentPort -> ports
+func collectionForResourceType(resourceType string) string {
+	if collection, ok := resourceTypeCollections[resourceType]; ok {
+		return collection
+	}
+	if strings.HasSuffix(resourceType, "ServiceEntry") {
+		return "service-entries"
+	}
+
+	var sb strings.Builder
+	for i, r := range resourceType {
+		if unicode.IsUpper(r) && i > 0 {
+			sb.WriteRune('-')
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	name := sb.String()
+	if strings.HasSuffix(name, "y") {
+		return strings.TrimSuffix(name, "y") + "ies"
+	}
+	if strings.HasSuffix(name, "s") {
+		return name
+	}
+	return name + "s"
+}
+
+// resourceTypeForCollection is the reverse of collectionForResourceType, used
+// when client does not specify resource type
+func resourceTypeForCollection(collection string) string {
+	if resourceType, ok := collectionResourceTypes[collection]; ok {
+		return resourceType
+	}
+
+	var sb strings.Builder
+	upper := true
+	for _, r := range strings.TrimSuffix(collection, "s") {
+		if r == '-' {
+			upper = true
+			continue
+		}
+		if upper {
+			sb.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,94 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// stateUpgradeFunc converts raw state of one schema version, as decoded from
+// JSON, to the shape of the next version in place
+type stateUpgradeFunc func(rawState map[string]interface{}) error
+
+// getPolicyStateUpgrader returns upgrader of resource state from given schema
+// version to the next one. oldSchema describes state shape of given version,
+// while newSchema is the schema of the next version.
+// Before resource specific upgrade, attributes of the next version that have
+// default value and are missing in old state are filled in. Otherwise, first
+// plan after the upgrade would show a diff, or even re-create the object for
+// ForceNew attributes.
+// Attributes no longer present in the schema are removed from upgraded state
+// by the SDK.
+func getPolicyStateUpgrader(version int, oldSchema map[string]*schema.Schema, newSchema map[string]*schema.Schema, upgrade stateUpgradeFunc) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    (&schema.Resource{Schema: oldSchema}).CoreConfigSchema().ImpliedType(),
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			if rawState == nil {
+				return rawState, nil
+			}
+			log.Printf("[INFO] Upgrading state of %s from schema version %d", rawState["id"], version)
+			if upgrade != nil {
+				if err := upgrade(rawState); err != nil {
+					return nil, fmt.Errorf("failed to upgrade state from schema version %d: %v", version, err)
+				}
+			}
+			setStateDefaults(rawState, newSchema)
+			return rawState, nil
+		},
+	}
+}
+
+// setStateDefaults fills in default values of attributes missing in raw state,
+// including attributes of nested blocks
+func setStateDefaults(rawState map[string]interface{}, resourceSchema map[string]*schema.Schema) {
+	for key, attrSchema := range resourceSchema {
+		value, ok := rawState[key]
+		if !ok || value == nil {
+			if attrSchema.Default != nil {
+				rawState[key] = attrSchema.Default
+			}
+			continue
+		}
+
+		elem, ok := attrSchema.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+		for _, block := range getStateBlocks(rawState, key) {
+			setStateDefaults(block, elem.Schema)
+		}
+	}
+}
+
+// getStateBlocks returns nested blocks stored in raw state under given key
+func getStateBlocks(rawState map[string]interface{}, key string) []map[string]interface{} {
+	var blocks []map[string]interface{}
+	values, ok := rawState[key].([]interface{})
+	if !ok {
+		return blocks
+	}
+	for _, value := range values {
+		if block, ok := value.(map[string]interface{}); ok {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// getStateIDFromPath returns ID of the object as the last segment of its
+// policy path stored in raw state
+func getStateIDFromPath(rawState map[string]interface{}) string {
+	path, ok := rawState["path"].(string)
+	if !ok {
+		return ""
+	}
+	segments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	return segments[len(segments)-1]
+}
@@ -0,0 +1,203 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testUpgradeResourceState feeds old state JSON through the provider, same as
+// Terraform does on refresh, and returns upgraded state
+func testUpgradeResourceState(t *testing.T, resourceType string, version int64, stateJSON string) map[string]interface{} {
+	t.Helper()
+	provider := Provider()
+	resp, err := provider.GRPCProvider().UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: resourceType,
+		Version:  version,
+		RawState: &tfprotov5.RawState{JSON: []byte(stateJSON)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("Failed to upgrade %s state: %s: %s", resourceType, diag.Summary, diag.Detail)
+		}
+	}
+
+	stateType := provider.ResourcesMap[resourceType].CoreConfigSchema().ImpliedType()
+	value, err := ctymsgpack.Unmarshal(resp.UpgradedState.MsgPack, stateType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	upgradedJSON, err := ctyjson.Marshal(value, stateType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var upgraded map[string]interface{}
+	if err := json.Unmarshal(upgradedJSON, &upgraded); err != nil {
+		t.Fatal(err)
+	}
+	return upgraded
+}
+
+func testCheckStateValue(t *testing.T, state map[string]interface{}, expected interface{}, keys ...interface{}) {
+	t.Helper()
+	var value interface{} = state
+	for _, key := range keys {
+		switch k := key.(type) {
+		case string:
+			value = value.(map[string]interface{})[k]
+		case int:
+			value = value.([]interface{})[k]
+		}
+	}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("Expected %v to be %#v, got %#v", keys, expected, value)
+	}
+}
+
+// State of security policy with inline rules, written by provider release
+// that did not store rule nsx_id
+const testPolicySecurityPolicyStateV0 = `{
+	"category": "Application",
+	"comments": "",
+	"description": "",
+	"display_name": "policy1",
+	"domain": "default",
+	"id": "policy1",
+	"locked": false,
+	"nsx_id": "policy1",
+	"path": "/infra/domains/default/security-policies/policy1",
+	"revision": 1,
+	"rule": [
+		{
+			"action": "ALLOW",
+			"description": "",
+			"destination_groups": ["/infra/domains/default/groups/web"],
+			"destinations_excluded": false,
+			"direction": "IN_OUT",
+			"disabled": false,
+			"display_name": "rule1",
+			"ip_version": "IPV4_IPV6",
+			"log_label": "",
+			"logged": false,
+			"notes": "",
+			"path": "/infra/domains/default/security-policies/policy1/rules/4b6a2ac0-0f6c-11ee-be56-0242ac120002",
+			"profiles": [],
+			"revision": 0,
+			"rule_id": 1025,
+			"scope": ["/infra/domains/default/groups/edge"],
+			"sequence_number": 1,
+			"services": ["/infra/services/HTTPS"],
+			"source_groups": [],
+			"sources_excluded": false,
+			"tag": []
+		},
+		{
+			"action": "DROP",
+			"description": "",
+			"destination_groups": [],
+			"destinations_excluded": false,
+			"direction": "IN_OUT",
+			"disabled": false,
+			"display_name": "rule2",
+			"ip_version": "IPV4_IPV6",
+			"log_label": "",
+			"logged": true,
+			"notes": "",
+			"nsx_id": "custom",
+			"path": "/infra/domains/default/security-policies/policy1/rules/custom",
+			"profiles": [],
+			"revision": 0,
+			"rule_id": 1026,
+			"scope": ["/infra/domains/default/groups/edge"],
+			"sequence_number": 2,
+			"services": [],
+			"source_groups": [],
+			"sources_excluded": false,
+			"tag": []
+		}
+	],
+	"scope": [],
+	"sequence_number": 0,
+	"stateful": true,
+	"tag": [],
+	"tcp_strict": false
+}`
+
+func TestPolicySecurityPolicyStateUpgradeV0(t *testing.T) {
+	for _, resourceType := range []string{"nsxt_policy_security_policy", "nsxt_policy_gateway_policy"} {
+		t.Run(resourceType, func(t *testing.T) {
+			state := testUpgradeResourceState(t, resourceType, 0, testPolicySecurityPolicyStateV0)
+
+			testCheckStateValue(t, state, "4b6a2ac0-0f6c-11ee-be56-0242ac120002", "rule", 0, "nsx_id")
+			testCheckStateValue(t, state, "custom", "rule", 1, "nsx_id")
+			testCheckStateValue(t, state, float64(1025), "rule", 0, "rule_id")
+			testCheckStateValue(t, state, "DROP", "rule", 1, "action")
+			testCheckStateValue(t, state, []interface{}{"/infra/domains/default/groups/edge"}, "rule", 1, "scope")
+		})
+	}
+}
+
+func TestPolicyTier0GatewayStateUpgradeV0(t *testing.T) {
+	state := testUpgradeResourceState(t, "nsxt_policy_tier0_gateway", 0, `{
+		"id": "t0",
+		"display_name": "t0",
+		"locale_service": [{"edge_cluster_path": "/infra/sites/default/enforcement-points/default/edge-clusters/ec", "redistribution_config": [{"enabled": true}]}]
+	}`)
+	testCheckStateValue(t, state, true, "redistribution_set")
+	testCheckStateValue(t, state, "ACTIVE_ACTIVE", "ha_mode")
+
+	state = testUpgradeResourceState(t, "nsxt_policy_tier0_gateway", 0, `{"id": "t0", "display_name": "t0"}`)
+	testCheckStateValue(t, state, false, "redistribution_set")
+
+	state = testUpgradeResourceState(t, "nsxt_policy_tier0_gateway", 0, `{"id": "t0", "redistribution_set": true}`)
+	testCheckStateValue(t, state, true, "redistribution_set")
+}
+
+func TestPolicyStateUpgradeCurrentVersion(t *testing.T) {
+	// State of current version is not passed through upgraders
+	state := testUpgradeResourceState(t, "nsxt_policy_tier0_gateway", 1, `{"id": "t0", "display_name": "t0"}`)
+	testCheckStateValue(t, state, nil, "redistribution_set")
+	testCheckStateValue(t, state, nil, "ha_mode")
+}
+
+func TestSetStateDefaults(t *testing.T) {
+	testSchema := map[string]*schema.Schema{
+		"name":    {Type: schema.TypeString, Optional: true, Default: "default"},
+		"enabled": {Type: schema.TypeBool, Optional: true, Default: true},
+		"block": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"count": {Type: schema.TypeInt, Optional: true, Default: 5},
+				},
+			},
+		},
+	}
+	rawState := map[string]interface{}{
+		"name":  "configured",
+		"block": []interface{}{map[string]interface{}{}, map[string]interface{}{"count": 3}},
+	}
+	setStateDefaults(rawState, testSchema)
+
+	expected := map[string]interface{}{
+		"name":    "configured",
+		"enabled": true,
+		"block":   []interface{}{map[string]interface{}{"count": 5}, map[string]interface{}{"count": 3}},
+	}
+	if !reflect.DeepEqual(rawState, expected) {
+		t.Errorf("Expected %v, got %v", expected, rawState)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"log"
+	"time"
 
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
@@ -161,8 +162,8 @@ func getTagsSchemaForceNew() *schema.Schema {
 	return getTagsSchemaInternal(false, true)
 }
 
-func getCustomizedTagsFromSchema(d *schema.ResourceData, schemaName string) []common.Tag {
-	tags := d.Get(schemaName).(*schema.Set).List()
+func getCustomizedTagsFromSchema(d *schema.ResourceData, schemaName string, m interface{}) []common.Tag {
+	tags := getTagsWithDefaultsFromSchema(d, schemaName, m)
 	tagList := make([]common.Tag, 0)
 	for _, tag := range tags {
 		data := tag.(map[string]interface{})
@@ -175,26 +176,26 @@ func getCustomizedTagsFromSchema(d *schema.ResourceData, schemaName string) []co
 	return tagList
 }
 
-func setCustomizedTagsInSchema(d *schema.ResourceData, tags []common.Tag, schemaName string) {
-	var tagList []map[string]string
+func setCustomizedTagsInSchema(d *schema.ResourceData, tags []common.Tag, schemaName string, m interface{}) {
+	var tagList []map[string]interface{}
 	for _, tag := range tags {
-		elem := make(map[string]string)
+		elem := make(map[string]interface{})
 		elem["scope"] = tag.Scope
 		elem["tag"] = tag.Tag
 		tagList = append(tagList, elem)
 	}
-	err := d.Set(schemaName, tagList)
+	err := setTagsWithDefaultsInSchema(d, tagList, schemaName, m)
 	if err != nil {
 		log.Printf("[WARNING] Failed to set tag in schema: %v", err)
 	}
 }
 
-func getTagsFromSchema(d *schema.ResourceData) []common.Tag {
-	return getCustomizedTagsFromSchema(d, "tag")
+func getTagsFromSchema(d *schema.ResourceData, m interface{}) []common.Tag {
+	return getCustomizedTagsFromSchema(d, "tag", m)
 }
 
-func setTagsInSchema(d *schema.ResourceData, tags []common.Tag) {
-	setCustomizedTagsInSchema(d, tags, "tag")
+func setTagsInSchema(d *schema.ResourceData, tags []common.Tag, m interface{}) {
+	setCustomizedTagsInSchema(d, tags, "tag", m)
 }
 
 // utilities to define & handle switching profiles
@@ -680,6 +681,31 @@ func handlePagination(lister func(*paginationInfo) error) (int64, error) {
 	return total, nil
 }
 
+// getWaitTimeout returns timeout for a single wait loop within resource operation.
+// The wait is bounded by operation deadline, derived from resource timeouts, and
+// further limited by optional per-wait timeout in seconds, if configured.
+func getWaitTimeout(deadline time.Time, timeout int) time.Duration {
+	waitTimeout := time.Duration(timeout) * time.Second
+	if deadline.IsZero() {
+		return waitTimeout
+	}
+	remaining := time.Until(deadline)
+	if timeout <= 0 || remaining < waitTimeout {
+		return remaining
+	}
+	return waitTimeout
+}
+
+// isResourceDiffConfigKnown checks whether configuration of the attribute,
+// including all nested attributes, is known at plan time
+func isResourceDiffConfigKnown(d *schema.ResourceDiff, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return d.NewValueKnown(key)
+	}
+	return rawConfig.GetAttr(key).IsWhollyKnown()
+}
+
 func getContextSchema(isRequired, isComputed bool) *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
@@ -693,18 +719,18 @@ func getContextSchema(isRequired, isComputed bool) *schema.Schema {
 			Schema: map[string]*schema.Schema{
 				"project_id": {
 					Type:         schema.TypeString,
-					Description:  "Id of the project which the resource belongs to.",
+					Description:  "Id of the project which the resource belongs to. Empty value places the resource outside of provider default context.",
 					Required:     true,
 					ForceNew:     true,
-					ValidateFunc: validation.StringIsNotWhiteSpace,
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.StringIsNotWhiteSpace),
 				},
 			},
 		},
 	}
 }
 
-func getCustomizedMPTagsFromSchema(d *schema.ResourceData, schemaName string) []mp_model.Tag {
-	tags := d.Get(schemaName).(*schema.Set).List()
+func getCustomizedMPTagsFromSchema(d *schema.ResourceData, schemaName string, m interface{}) []mp_model.Tag {
+	tags := getTagsWithDefaultsFromSchema(d, schemaName, m)
 	tagList := make([]mp_model.Tag, 0)
 	for _, tag := range tags {
 		data := tag.(map[string]interface{})
@@ -719,30 +745,30 @@ func getCustomizedMPTagsFromSchema(d *schema.ResourceData, schemaName string) []
 	return tagList
 }
 
-func setCustomizedMPTagsInSchema(d *schema.ResourceData, tags []mp_model.Tag, schemaName string) {
-	var tagList []map[string]string
+func setCustomizedMPTagsInSchema(d *schema.ResourceData, tags []mp_model.Tag, schemaName string, m interface{}) {
+	var tagList []map[string]interface{}
 	for _, tag := range tags {
-		elem := make(map[string]string)
+		elem := make(map[string]interface{})
 		elem["scope"] = *tag.Scope
 		elem["tag"] = *tag.Tag
 		tagList = append(tagList, elem)
 	}
-	err := d.Set(schemaName, tagList)
+	err := setTagsWithDefaultsInSchema(d, tagList, schemaName, m)
 	if err != nil {
 		log.Printf("[WARNING] Failed to set tag in schema: %v", err)
 	}
 }
 
-func getMPTagsFromSchema(d *schema.ResourceData) []mp_model.Tag {
-	return getCustomizedMPTagsFromSchema(d, "tag")
+func getMPTagsFromSchema(d *schema.ResourceData, m interface{}) []mp_model.Tag {
+	return getCustomizedMPTagsFromSchema(d, "tag", m)
 }
 
-func setMPTagsInSchema(d *schema.ResourceData, tags []mp_model.Tag) {
-	setCustomizedMPTagsInSchema(d, tags, "tag")
+func setMPTagsInSchema(d *schema.ResourceData, tags []mp_model.Tag, m interface{}) {
+	setCustomizedMPTagsInSchema(d, tags, "tag", m)
 }
 
-func getCustomizedGMTagsFromSchema(d *schema.ResourceData, schemaName string) []gm_model.Tag {
-	tags := d.Get(schemaName).(*schema.Set).List()
+func getCustomizedGMTagsFromSchema(d *schema.ResourceData, schemaName string, m interface{}) []gm_model.Tag {
+	tags := getTagsWithDefaultsFromSchema(d, schemaName, m)
 	tagList := make([]gm_model.Tag, 0)
 	for _, tag := range tags {
 		data := tag.(map[string]interface{})
@@ -757,24 +783,24 @@ func getCustomizedGMTagsFromSchema(d *schema.ResourceData, schemaName string) []
 	return tagList
 }
 
-func setCustomizedGMTagsInSchema(d *schema.ResourceData, tags []gm_model.Tag, schemaName string) {
-	var tagList []map[string]string
+func setCustomizedGMTagsInSchema(d *schema.ResourceData, tags []gm_model.Tag, schemaName string, m interface{}) {
+	var tagList []map[string]interface{}
 	for _, tag := range tags {
-		elem := make(map[string]string)
+		elem := make(map[string]interface{})
 		elem["scope"] = *tag.Scope
 		elem["tag"] = *tag.Tag
 		tagList = append(tagList, elem)
 	}
-	err := d.Set(schemaName, tagList)
+	err := setTagsWithDefaultsInSchema(d, tagList, schemaName, m)
 	if err != nil {
 		log.Printf("[WARNING] Failed to set tag in schema: %v", err)
 	}
 }
 
-func getGMTagsFromSchema(d *schema.ResourceData) []gm_model.Tag {
-	return getCustomizedGMTagsFromSchema(d, "tag")
+func getGMTagsFromSchema(d *schema.ResourceData, m interface{}) []gm_model.Tag {
+	return getCustomizedGMTagsFromSchema(d, "tag", m)
 }
 
-func setGMTagsInSchema(d *schema.ResourceData, tags []gm_model.Tag) {
-	setCustomizedGMTagsInSchema(d, tags, "tag")
+func setGMTagsInSchema(d *schema.ResourceData, tags []gm_model.Tag, m interface{}) {
+	setCustomizedGMTagsInSchema(d, tags, "tag", m)
 }
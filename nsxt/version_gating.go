@@ -0,0 +1,215 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+)
+
+const (
+	versionCheckError  = "error"
+	versionCheckIgnore = "ignore"
+)
+
+var versionCheckValues = []string{versionCheckError, versionCheckIgnore}
+
+// attributeVersionGate declares minimum NSX version for a group of attributes,
+// keyed by resource name. Nested attributes are separated by ".". Resources
+// check the gate before sending attributes to NSX, and plan-time validation
+// is derived from same declaration, so that the two can not drift apart.
+// Metadata-driven resources declare this with IntroducedInVersion instead.
+type attributeVersionGate struct {
+	minVersion string
+	attributes map[string][]string
+}
+
+// All declared gates, populated during package initialization
+var attributeVersionGates []*attributeVersionGate
+
+func newAttributeVersionGate(minVersion string, attributes map[string][]string) *attributeVersionGate {
+	gate := &attributeVersionGate{
+		minVersion: minVersion,
+		attributes: attributes,
+	}
+	attributeVersionGates = append(attributeVersionGates, gate)
+	return gate
+}
+
+// isSupported checks whether connected NSX supports attributes of the gate
+func (g *attributeVersionGate) isSupported() bool {
+	return util.NsxVersionHigherOrEqual(g.minVersion)
+}
+
+// getResourceAttributeMinVersions returns minimum NSX version of gated
+// attributes, keyed by resource name
+func getResourceAttributeMinVersions() map[string]map[string]string {
+	result := make(map[string]map[string]string)
+	for _, gate := range attributeVersionGates {
+		for resourceName, attributes := range gate.attributes {
+			if result[resourceName] == nil {
+				result[resourceName] = make(map[string]string)
+			}
+			for _, attribute := range attributes {
+				result[resourceName][attribute] = gate.minVersion
+			}
+		}
+	}
+	return result
+}
+
+func getVersionCheck(m interface{}) string {
+	clients, ok := m.(nsxtClients)
+	if !ok {
+		return versionCheckError
+	}
+	return clients.CommonConfig.VersionCheck
+}
+
+// isAttributeConfigured checks whether attribute is set in configuration.
+// When raw configuration is not available, attribute is considered configured
+// if its value differs from default.
+func isAttributeConfigured(d *schema.ResourceDiff, sch map[string]*schema.Schema, path []string) bool {
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.IsNull() && rawConfig.IsKnown() {
+		return isRawValueConfigured(rawConfig, path)
+	}
+
+	return isValueConfigured(d.Get(path[0]), sch[path[0]], path[1:])
+}
+
+func isRawValueConfigured(value cty.Value, path []string) bool {
+	if value.IsNull() {
+		return false
+	}
+	if !value.IsKnown() || len(path) == 0 {
+		return true
+	}
+
+	valueType := value.Type()
+	if valueType.IsListType() || valueType.IsSetType() {
+		for it := value.ElementIterator(); it.Next(); {
+			_, elem := it.Element()
+			if isRawValueConfigured(elem, path) {
+				return true
+			}
+		}
+		return false
+	}
+	if valueType.IsObjectType() && valueType.HasAttribute(path[0]) {
+		return isRawValueConfigured(value.GetAttr(path[0]), path[1:])
+	}
+	return false
+}
+
+func isValueConfigured(value interface{}, sch *schema.Schema, path []string) bool {
+	if sch == nil || value == nil {
+		return false
+	}
+
+	if len(path) == 0 {
+		if set, ok := value.(*schema.Set); ok {
+			return set.Len() > 0
+		}
+		if list, ok := value.([]interface{}); ok {
+			return len(list) > 0
+		}
+		if sch.Default != nil {
+			return !reflect.DeepEqual(value, sch.Default)
+		}
+		return !reflect.ValueOf(value).IsZero()
+	}
+
+	elem, ok := sch.Elem.(*schema.Resource)
+	if !ok {
+		return false
+	}
+	var items []interface{}
+	if set, ok := value.(*schema.Set); ok {
+		items = set.List()
+	} else if list, ok := value.([]interface{}); ok {
+		items = list
+	}
+	for _, item := range items {
+		data, ok := item.(map[string]interface{})
+		if ok && isValueConfigured(data[path[0]], elem.Schema[path[0]], path[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// getVersionGatingCustomizeDiff fails the plan, unless version check is set to
+// ignore, if attribute that requires newer NSX version is configured
+func getVersionGatingCustomizeDiff(sch map[string]*schema.Schema, minVersions map[string]string) schema.CustomizeDiffFunc {
+	if len(minVersions) == 0 {
+		return nil
+	}
+
+	return func(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if m == nil {
+			// Provider is not configured yet
+			return nil
+		}
+
+		var keys []string
+		for key := range minVersions {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var errors []string
+		for _, key := range keys {
+			if !isAttributeConfigured(d, sch, strings.Split(key, ".")) {
+				continue
+			}
+
+			// Make sure NSX version is initialized
+			getPolicyConnector(m)
+			if util.NsxVersion == "" || !util.NsxVersionLower(minVersions[key]) {
+				continue
+			}
+
+			msg := fmt.Sprintf("Attribute %s requires NSX version %s or higher, detected NSX version is %s", key, minVersions[key], util.NsxVersion)
+			if getVersionCheck(m) == versionCheckIgnore {
+				log.Printf("[WARNING]: %s, the attribute will be ignored", msg)
+				continue
+			}
+			errors = append(errors, msg)
+		}
+
+		if len(errors) > 0 {
+			return fmt.Errorf("%s", strings.Join(errors, "\n"))
+		}
+		return nil
+	}
+}
+
+// addVersionGatingToResources validates at plan time that configured attributes
+// are supported by NSX
+func addVersionGatingToResources(resources map[string]*schema.Resource) {
+	for name, minVersions := range getResourceAttributeMinVersions() {
+		resource, ok := resources[name]
+		if !ok {
+			continue
+		}
+		addResourceCustomizeDiff(resource, getVersionGatingCustomizeDiff(resource.Schema, minVersions))
+	}
+}
+
+// getExtendedSchemaVersionGatingCustomizeDiff validates attributes of
+// metadata-driven resource against IntroducedInVersion of its extended schema
+func getExtendedSchemaVersionGatingCustomizeDiff(ext map[string]*metadata.ExtendedSchema) schema.CustomizeDiffFunc {
+	return getVersionGatingCustomizeDiff(metadata.GetSchemaFromExtendedSchema(ext), metadata.GetIntroducedInVersions(ext))
+}
@@ -0,0 +1,158 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+)
+
+// Version checks with literal version that do not gate attributes, but rather
+// attribute values, API selection or bug workarounds
+var versionCheckExemptions = map[string]bool{
+	"dataSourceNsxtPolicyEdgeNodeRead: NsxVersionHigherOrEqual(3.2.0)":          true,
+	"resourceNsxtFirewallSectionUpdate: NsxVersionLower(2.2.0)":                 true,
+	"resourceNsxtNatRuleCreate: NsxVersionHigherOrEqual(3.0.0)":                 true,
+	"resourceNsxtNatRuleUpdate: NsxVersionHigherOrEqual(3.0.0)":                 true,
+	"resourceNsxtPolicyBgpNeighborResourceDataToStruct: NsxVersionLower(3.0.0)": true,
+	"resourceNsxtPolicyLBServiceCreate: NsxVersionLower(3.0.0)":                 true,
+	"resourceNsxtPolicyLBServiceUpdate: NsxVersionLower(3.0.0)":                 true,
+	"policyTier1GatewayResourceToInfraStruct: NsxVersionLower(4.0.0)":           true,
+	"findNsxtPolicyVMByNamePrefix: NsxVersionHigherOrEqual(4.1.2)":              true,
+	"findNsxtPolicyVMByID: NsxVersionHigherOrEqual(4.1.2)":                      true,
+	"updateNsxtPolicyVMTags: NsxVersionHigherOrEqual(4.1.1)":                    true,
+	"precheckBundleCompatibilityCheck: NsxVersionLower(4.1.1)":                  true,
+	"precheckBundleCompatibilityCheck: NsxVersionHigherOrEqual(4.1.1)":          true,
+	"uploadUpgradeBundle: NsxVersionHigherOrEqual(4.1.1)":                       true,
+}
+
+// TestVersionChecksUseAttributeGates makes sure attributes are not gated with
+// literal version, since such gates would not be validated at plan time
+func TestVersionChecksUseAttributeGates(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	found := make(map[string]bool)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			ast.Inspect(fn, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) != 1 {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || (sel.Sel.Name != "NsxVersionHigherOrEqual" && sel.Sel.Name != "NsxVersionLower") {
+					return true
+				}
+				lit, ok := call.Args[0].(*ast.BasicLit)
+				if !ok {
+					return true
+				}
+				key := fmt.Sprintf("%s: %s(%s)", fn.Name.Name, sel.Sel.Name, strings.Trim(lit.Value, `"`))
+				found[key] = true
+				if !versionCheckExemptions[key] {
+					t.Errorf("%s: version check %s should use attribute version gate", fset.Position(call.Pos()), key)
+				}
+				return true
+			})
+		}
+	}
+
+	for key := range versionCheckExemptions {
+		if !found[key] {
+			t.Errorf("Stale version check exemption %s", key)
+		}
+	}
+}
+
+func testGetNestedSchema(sch map[string]*schema.Schema, path []string) *schema.Schema {
+	attr, ok := sch[path[0]]
+	if !ok || len(path) == 1 {
+		return attr
+	}
+	elem, ok := attr.Elem.(*schema.Resource)
+	if !ok {
+		return nil
+	}
+	return testGetNestedSchema(elem.Schema, path[1:])
+}
+
+func TestAttributeVersionGatesSchema(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for resourceName, minVersions := range getResourceAttributeMinVersions() {
+		resource, ok := resources[resourceName]
+		if !ok {
+			t.Errorf("Version gate declared for unknown resource %s", resourceName)
+			continue
+		}
+		for attribute := range minVersions {
+			if testGetNestedSchema(resource.Schema, strings.Split(attribute, ".")) == nil {
+				t.Errorf("Version gate declared for unknown attribute %s of resource %s", attribute, resourceName)
+			}
+		}
+		if resource.CustomizeDiff == nil {
+			t.Errorf("Expected plan-time version validation for resource %s", resourceName)
+		}
+	}
+}
+
+func TestProviderVersionGating(t *testing.T) {
+	savedVersion := util.NsxVersion
+	defer func() { util.NsxVersion = savedVersion }()
+
+	sim := simulator.NewServer()
+	defer sim.Close()
+	sim.Version = "3.1.0"
+
+	cases := []struct {
+		name         string
+		versionCheck string
+		config       map[string]interface{}
+		expectedErr  string
+	}{
+		{"unsupported attribute", versionCheckError, map[string]interface{}{"display_name": "g1", "group_type": "IPAddress"},
+			"Attribute group_type requires NSX version 3.2.0 or higher, detected NSX version is 3.1.0"},
+		{"unsupported attribute ignored", versionCheckIgnore, map[string]interface{}{"display_name": "g1", "group_type": "IPAddress"}, ""},
+		{"supported attributes", versionCheckError, map[string]interface{}{"display_name": "g1"}, ""},
+	}
+
+	for _, tc := range cases {
+		util.NsxVersion = ""
+		provider := testConfigureSimulatorProvider(t, sim, map[string]interface{}{"version_check": tc.versionCheck})
+		res := provider.ResourcesMap["nsxt_policy_group"]
+		_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), provider.Meta())
+		if tc.expectedErr == "" && err != nil {
+			t.Errorf("%s: expected valid plan, got %v", tc.name, err)
+		}
+		if tc.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedErr)) {
+			t.Errorf("%s: expected plan error containing %q, got %v", tc.name, tc.expectedErr, err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Index that matches any element of a list in write-only validation paths
+var anyListIndex = cty.UnknownVal(cty.Number)
+
+// getWriteOnlySchema returns write-only counterpart of a sensitive attribute.
+// Value of write-only attribute is sent to NSX, but never stored in plan or
+// state.
+func getWriteOnlySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: description,
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+	}
+}
+
+// getWriteOnlyVersionSchema returns version attribute of write-only attribute.
+// Since write-only value is not stored in state, changing it alone does not
+// produce a diff, hence the version is bumped to update the value on NSX.
+func getWriteOnlyVersionSchema(attrName string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: fmt.Sprintf("Version of %s, change to update the value on NSX", attrName),
+		Optional:    true,
+	}
+}
+
+// getWriteOnlyString returns value of write-only attribute from configuration
+func getWriteOnlyString(d *schema.ResourceData, path cty.Path) string {
+	value, diags := d.GetRawConfigAt(path)
+	if diags.HasError() || !value.IsKnown() || value.IsNull() || !value.Type().Equals(cty.String) {
+		return ""
+	}
+	return value.AsString()
+}
+
+// getWriteOnlyOrString returns value of write-only attribute if configured,
+// and the value of its sensitive counterpart otherwise
+func getWriteOnlyOrString(d *schema.ResourceData, path cty.Path, value string) string {
+	if writeOnlyValue := getWriteOnlyString(d, path); writeOnlyValue != "" {
+		return writeOnlyValue
+	}
+	return value
+}